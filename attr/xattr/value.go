@@ -0,0 +1,25 @@
+package xattr
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+)
+
+// ValueWithDerivedType extends the attr.Value interface for value types whose
+// attr.Type is fully determined by their Go type, such as the generic
+// collection value types in the basetypes package.
+//
+// When a model struct field has a value type implementing this interface,
+// but the schema attribute type produces a different value type with the
+// same Terraform type, the field is populated using the derived type instead
+// of returning an error. This allows these value types to be used without a
+// custom type in the schema.
+type ValueWithDerivedType interface {
+	attr.Value
+
+	// DerivedType returns the attr.Type of all values of the Go type. It
+	// must not depend on the contents of the receiver, as it is called on
+	// the zero value.
+	DerivedType(context.Context) attr.Type
+}
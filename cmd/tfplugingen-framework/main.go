@@ -0,0 +1,120 @@
+// The tfplugingen-framework command generates framework schema definitions and
+// tfsdk-tagged model structs from provider schema information.
+//
+// Usage:
+//
+//	terraform providers schema -json > schemas.json
+//	tfplugingen-framework -input schemas.json -provider registry.terraform.io/examplecorp/examplecloud -output ./internal/provider
+//
+// The -input-format flag selects between the Terraform CLI provider schema JSON
+// format (terraform) and the framework-specific specification format (spec).
+// Existing files in the output directory with the same name are overwritten.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/codegen"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:]))
+}
+
+func run(args []string) int {
+	flags := flag.NewFlagSet("tfplugingen-framework", flag.ContinueOnError)
+
+	input := flags.String("input", "", "path to the input file, or - for standard input")
+	inputFormat := flags.String("input-format", "terraform", "input format: terraform (terraform providers schema -json output) or spec")
+	output := flags.String("output", ".", "directory to write generated files")
+	pkg := flags.String("package", "", "Go package name of the generated code, overriding any spec package")
+	providerAddress := flags.String("provider", "", "provider source address for terraform input containing multiple providers")
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	if *input == "" {
+		fmt.Fprintln(os.Stderr, "missing required -input flag")
+		flags.Usage()
+
+		return 2
+	}
+
+	var data []byte
+	var err error
+
+	if *input == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(*input)
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "unable to read input: %s\n", err)
+
+		return 1
+	}
+
+	var spec codegen.Spec
+
+	switch *inputFormat {
+	case "spec":
+		spec, err = codegen.ParseSpec(data)
+	case "terraform":
+		spec, err = codegen.ParseProviderSchemas(data, *providerAddress)
+	default:
+		err = fmt.Errorf("unknown input format %q", *inputFormat)
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+
+		return 1
+	}
+
+	if *pkg != "" {
+		spec.Package = *pkg
+	}
+
+	files, err := codegen.Generate(spec)
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "unable to generate code: %s\n", err)
+
+		return 1
+	}
+
+	if err := os.MkdirAll(*output, 0o755); err != nil {
+		fmt.Fprintf(os.Stderr, "unable to create output directory: %s\n", err)
+
+		return 1
+	}
+
+	fileNames := make([]string, 0, len(files))
+
+	for fileName := range files {
+		fileNames = append(fileNames, fileName)
+	}
+
+	sort.Strings(fileNames)
+
+	for _, fileName := range fileNames {
+		filePath := filepath.Join(*output, fileName)
+
+		if err := os.WriteFile(filePath, files[fileName], 0o644); err != nil {
+			fmt.Fprintf(os.Stderr, "unable to write %s: %s\n", filePath, err)
+
+			return 1
+		}
+
+		fmt.Println(filePath)
+	}
+
+	return 0
+}
@@ -0,0 +1,164 @@
+// The tfschemadiff command compares the schemas of two provider versions and
+// reports differences which can break existing practitioner configurations or
+// saved resource state.
+//
+// Usage:
+//
+//	terraform providers schema -json > current.json
+//	tfschemadiff -prior prior.json -current current.json -provider registry.terraform.io/examplecorp/examplecloud
+//
+// Both inputs use the Terraform CLI provider schema JSON format, which can
+// also be generated with the providerserver.ProviderSchemasJSON function.
+// Resources with changes which affect saved state, such as type or nesting
+// mode changes, are reported when the schema version was not increased.
+// Resource state upgraders are not available from the JSON format, so use the
+// providerserver.ValidateSchemaChanges function in provider unit tests for
+// the complete checks.
+//
+// The exit status is 0 if there are no breaking changes, 1 if there are
+// breaking changes, and 2 if there was an error.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadiff"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout))
+}
+
+func run(args []string, w io.Writer) int {
+	flags := flag.NewFlagSet("tfschemadiff", flag.ContinueOnError)
+
+	prior := flags.String("prior", "", "path to the prior provider schemas JSON")
+	current := flags.String("current", "", "path to the current provider schemas JSON")
+	providerAddress := flags.String("provider", "", "provider source address for inputs containing multiple providers")
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	if *prior == "" || *current == "" {
+		fmt.Fprintln(os.Stderr, "missing required -prior or -current flag")
+		flags.Usage()
+
+		return 2
+	}
+
+	priorSchemas, err := readProviderSchemas(*prior, *providerAddress)
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+
+		return 2
+	}
+
+	currentSchemas, err := readProviderSchemas(*current, *providerAddress)
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+
+		return 2
+	}
+
+	changes := fwschemadiff.Providers(context.Background(), priorSchemas, currentSchemas)
+
+	report(w, changes)
+
+	if changes.Breaking() || changes.StateUpgradeDiagnostics.HasError() {
+		return 1
+	}
+
+	return 0
+}
+
+// readProviderSchemas returns the provider schemas of a JSON file.
+func readProviderSchemas(filePath string, address string) (fwschemadiff.ProviderSchemas, error) {
+	data, err := os.ReadFile(filePath)
+
+	if err != nil {
+		return fwschemadiff.ProviderSchemas{}, fmt.Errorf("unable to read %s: %w", filePath, err)
+	}
+
+	schemas, err := fwschemadiff.ParseProviderSchemas(data, address)
+
+	if err != nil {
+		return fwschemadiff.ProviderSchemas{}, fmt.Errorf("%s: %w", filePath, err)
+	}
+
+	return schemas, nil
+}
+
+// report writes the human readable differences.
+func report(w io.Writer, changes fwschemadiff.ProviderChanges) {
+	writeChanges(w, "provider", changes.Provider)
+
+	for _, typeName := range changes.RemovedResources {
+		fmt.Fprintf(w, "resource %s: removed [breaking]\n", typeName)
+	}
+
+	for _, typeName := range sortedKeys(changes.Resources) {
+		writeChanges(w, "resource "+typeName, changes.Resources[typeName])
+	}
+
+	for _, typeName := range changes.RemovedDataSources {
+		fmt.Fprintf(w, "data source %s: removed [breaking]\n", typeName)
+	}
+
+	for _, typeName := range sortedKeys(changes.DataSources) {
+		writeChanges(w, "data source "+typeName, changes.DataSources[typeName])
+	}
+
+	for _, d := range changes.StateUpgradeDiagnostics {
+		severity := "Warning"
+
+		if d.Severity() == diag.SeverityError {
+			severity = "Error"
+		}
+
+		fmt.Fprintf(w, "\n%s: %s\n\n%s\n", severity, d.Summary(), d.Detail())
+	}
+}
+
+// writeChanges writes the human readable changes of a schema.
+func writeChanges(w io.Writer, heading string, changes fwschemadiff.Changes) {
+	if len(changes) == 0 {
+		return
+	}
+
+	fmt.Fprintf(w, "%s:\n", heading)
+
+	for _, change := range changes {
+		var markers string
+
+		switch {
+		case change.StateAffecting():
+			markers = " [breaking, state]"
+		case change.Breaking():
+			markers = " [breaking]"
+		}
+
+		fmt.Fprintf(w, "  - %s%s\n", change, markers)
+	}
+}
+
+// sortedKeys returns the sorted keys of the given changes.
+func sortedKeys(m map[string]fwschemadiff.Changes) []string {
+	result := make([]string, 0, len(m))
+
+	for key := range m {
+		result = append(result, key)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
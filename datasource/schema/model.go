@@ -0,0 +1,278 @@
+package schema
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemamodel"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// ModelOption customizes the schema derived by FromModel.
+type ModelOption func(*modelOptions)
+
+// modelOptions contains the FromModel customizations.
+type modelOptions struct {
+	fwschemamodel.Options
+
+	// attributes contains the ModelAttribute replacements by attribute
+	// path string.
+	attributes map[string]Attribute
+}
+
+// ModelAttribute replaces the attribute derived from the model struct field at
+// the given path, such as to add validators or plan modifiers, or to define
+// the element type of a types.List field. The struct field type is not
+// inspected, however ValidateModel can verify it afterwards.
+func ModelAttribute(p path.Path, attribute Attribute) ModelOption {
+	return func(o *modelOptions) {
+		if o.attributes == nil {
+			o.attributes = make(map[string]Attribute)
+		}
+
+		o.attributes[fwschemamodel.AttributePath(p).String()] = attribute
+		o.FieldOptions(p, func(f *fwschemamodel.FieldOptions) { f.Overridden = true })
+	}
+}
+
+// ModelComputed marks the attribute at the given path as Computed, similar
+// to the "computed" struct tag option.
+func ModelComputed(p path.Path) ModelOption {
+	return func(o *modelOptions) {
+		o.FieldOptions(p, func(f *fwschemamodel.FieldOptions) { f.Computed = true })
+	}
+}
+
+// ModelDescription sets the Description of the attribute at the given path.
+func ModelDescription(p path.Path, description string) ModelOption {
+	return func(o *modelOptions) {
+		o.FieldOptions(p, func(f *fwschemamodel.FieldOptions) { f.Description = description })
+	}
+}
+
+// ModelMarkdownDescription sets the MarkdownDescription of the attribute at
+// the given path.
+func ModelMarkdownDescription(p path.Path, description string) ModelOption {
+	return func(o *modelOptions) {
+		o.FieldOptions(p, func(f *fwschemamodel.FieldOptions) { f.MarkdownDescription = description })
+	}
+}
+
+// ModelOptional marks the attribute at the given path as Optional, similar
+// to the "optional" struct tag option.
+func ModelOptional(p path.Path) ModelOption {
+	return func(o *modelOptions) {
+		o.FieldOptions(p, func(f *fwschemamodel.FieldOptions) { f.Optional = true })
+	}
+}
+
+// ModelRequired marks the attribute at the given path as Required, similar
+// to the "required" struct tag option.
+func ModelRequired(p path.Path) ModelOption {
+	return func(o *modelOptions) {
+		o.FieldOptions(p, func(f *fwschemamodel.FieldOptions) { f.Required = true })
+	}
+}
+
+// ModelSensitive marks the attribute at the given path as Sensitive, similar
+// to the "sensitive" struct tag option.
+func ModelSensitive(p path.Path) ModelOption {
+	return func(o *modelOptions) {
+		o.FieldOptions(p, func(f *fwschemamodel.FieldOptions) { f.Sensitive = true })
+	}
+}
+
+// FromModel returns a schema with the attributes derived from the "tfsdk"
+// tagged fields of the given model struct, or pointer to struct. Tag options
+// after the attribute name mark the attribute as required, optional,
+// computed, or sensitive, and the set option derives a set rather than a list
+// attribute from a slice field, for example:
+//
+//	type exampleDataSourceModel struct {
+//		ID       types.String `tfsdk:"id,computed"`
+//		Name     string       `tfsdk:"name,required"`
+//		Password string       `tfsdk:"password,optional,sensitive"`
+//		Tags     []string     `tfsdk:"tags,optional,set"`
+//	}
+//
+// Nested struct, slice of struct, and map of struct fields derive nested
+// attributes. Collection and object types, such as types.List, do not contain
+// their element or attribute types and must be replaced with ModelAttribute.
+// Blocks are not derived, but can be added to the returned schema.
+func FromModel(ctx context.Context, model any, opts ...ModelOption) (Schema, diag.Diagnostics) {
+	options := modelOptions{}
+
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	attributes, diags := fwschemamodel.FromModel(ctx, model, options.Options, options.attributes, modelConstructors)
+
+	if diags.HasError() {
+		return Schema{}, diags
+	}
+
+	return Schema{Attributes: attributes}, diags
+}
+
+// ValidateModel returns error diagnostics if the given model struct, or
+// pointer to struct, cannot hold the data of the schema, such as missing
+// struct fields or incompatible field types. This is intended to be called
+// in unit testing or provider startup to catch disagreements between a
+// schema and its model before practitioners encounter them.
+func (s Schema) ValidateModel(ctx context.Context, model any) diag.Diagnostics {
+	return fwschemamodel.ValidateModel(ctx, s.Type(), model)
+}
+
+// modelConstructors create the attributes derived by FromModel.
+var modelConstructors = fwschemamodel.Constructors[Attribute]{
+	Bool: func(a fwschemamodel.Attribute, customType basetypes.BoolTypable) Attribute {
+		return BoolAttribute{
+			CustomType:          customType,
+			Computed:            a.Computed,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+			Optional:            a.Optional,
+			Required:            a.Required,
+			Sensitive:           a.Sensitive,
+		}
+	},
+	Float64: func(a fwschemamodel.Attribute, customType basetypes.Float64Typable) Attribute {
+		return Float64Attribute{
+			CustomType:          customType,
+			Computed:            a.Computed,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+			Optional:            a.Optional,
+			Required:            a.Required,
+			Sensitive:           a.Sensitive,
+		}
+	},
+	Int64: func(a fwschemamodel.Attribute, customType basetypes.Int64Typable) Attribute {
+		return Int64Attribute{
+			CustomType:          customType,
+			Computed:            a.Computed,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+			Optional:            a.Optional,
+			Required:            a.Required,
+			Sensitive:           a.Sensitive,
+		}
+	},
+	Number: func(a fwschemamodel.Attribute, customType basetypes.NumberTypable) Attribute {
+		return NumberAttribute{
+			CustomType:          customType,
+			Computed:            a.Computed,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+			Optional:            a.Optional,
+			Required:            a.Required,
+			Sensitive:           a.Sensitive,
+		}
+	},
+	String: func(a fwschemamodel.Attribute, customType basetypes.StringTypable) Attribute {
+		return StringAttribute{
+			CustomType:          customType,
+			Computed:            a.Computed,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+			Optional:            a.Optional,
+			Required:            a.Required,
+			Sensitive:           a.Sensitive,
+		}
+	},
+	List: func(a fwschemamodel.Attribute, elementType attr.Type, customType basetypes.ListTypable) Attribute {
+		return ListAttribute{
+			ElementType:         elementType,
+			CustomType:          customType,
+			Computed:            a.Computed,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+			Optional:            a.Optional,
+			Required:            a.Required,
+			Sensitive:           a.Sensitive,
+		}
+	},
+	Map: func(a fwschemamodel.Attribute, elementType attr.Type, customType basetypes.MapTypable) Attribute {
+		return MapAttribute{
+			ElementType:         elementType,
+			CustomType:          customType,
+			Computed:            a.Computed,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+			Optional:            a.Optional,
+			Required:            a.Required,
+			Sensitive:           a.Sensitive,
+		}
+	},
+	Set: func(a fwschemamodel.Attribute, elementType attr.Type, customType basetypes.SetTypable) Attribute {
+		return SetAttribute{
+			ElementType:         elementType,
+			CustomType:          customType,
+			Computed:            a.Computed,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+			Optional:            a.Optional,
+			Required:            a.Required,
+			Sensitive:           a.Sensitive,
+		}
+	},
+	Object: func(a fwschemamodel.Attribute, attributeTypes map[string]attr.Type, customType basetypes.ObjectTypable) Attribute {
+		return ObjectAttribute{
+			AttributeTypes:      attributeTypes,
+			CustomType:          customType,
+			Computed:            a.Computed,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+			Optional:            a.Optional,
+			Required:            a.Required,
+			Sensitive:           a.Sensitive,
+		}
+	},
+	ListNested: func(a fwschemamodel.Attribute, attributes map[string]Attribute) Attribute {
+		return ListNestedAttribute{
+			NestedObject:        NestedAttributeObject{Attributes: attributes},
+			Computed:            a.Computed,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+			Optional:            a.Optional,
+			Required:            a.Required,
+			Sensitive:           a.Sensitive,
+		}
+	},
+	MapNested: func(a fwschemamodel.Attribute, attributes map[string]Attribute) Attribute {
+		return MapNestedAttribute{
+			NestedObject:        NestedAttributeObject{Attributes: attributes},
+			Computed:            a.Computed,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+			Optional:            a.Optional,
+			Required:            a.Required,
+			Sensitive:           a.Sensitive,
+		}
+	},
+	SetNested: func(a fwschemamodel.Attribute, attributes map[string]Attribute) Attribute {
+		return SetNestedAttribute{
+			NestedObject:        NestedAttributeObject{Attributes: attributes},
+			Computed:            a.Computed,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+			Optional:            a.Optional,
+			Required:            a.Required,
+			Sensitive:           a.Sensitive,
+		}
+	},
+	SingleNested: func(a fwschemamodel.Attribute, attributes map[string]Attribute) Attribute {
+		return SingleNestedAttribute{
+			Attributes:          attributes,
+			Computed:            a.Computed,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+			Optional:            a.Optional,
+			Required:            a.Required,
+			Sensitive:           a.Sensitive,
+		}
+	},
+}
@@ -0,0 +1,157 @@
+package schema_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFromModel(t *testing.T) {
+	t.Parallel()
+
+	type nestedModel struct {
+		Value types.Int64 `tfsdk:"value,computed"`
+	}
+
+	testCases := map[string]struct {
+		model         any
+		opts          []schema.ModelOption
+		expected      schema.Schema
+		expectedDiags diag.Diagnostics
+	}{
+		"attributes": {
+			model: &struct {
+				ID     types.String  `tfsdk:"id,computed"`
+				Name   string        `tfsdk:"name,required"`
+				Token  string        `tfsdk:"token,computed,sensitive"`
+				Tags   []string      `tfsdk:"tags,optional,set"`
+				Items  []nestedModel `tfsdk:"items,computed"`
+				Filter types.List    `tfsdk:"filter"`
+			}{},
+			opts: []schema.ModelOption{
+				schema.ModelDescription(path.Root("name"), "Name to look up."),
+				schema.ModelAttribute(path.Root("filter"), schema.ListAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+				}),
+			},
+			expected: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":    schema.StringAttribute{Computed: true},
+					"name":  schema.StringAttribute{Required: true, Description: "Name to look up."},
+					"token": schema.StringAttribute{Computed: true, Sensitive: true},
+					"tags":  schema.SetAttribute{ElementType: types.StringType, Optional: true},
+					"items": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"value": schema.Int64Attribute{Computed: true},
+							},
+						},
+						Computed: true,
+					},
+					"filter": schema.ListAttribute{ElementType: types.StringType, Optional: true},
+				},
+			},
+		},
+		"invalid": {
+			model: struct {
+				Name string `tfsdk:"name,required,set"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Model",
+					"Unable to derive the schema from the model type. This is always an error in the provider. "+
+						"Please report the following to the provider developer:\n\n"+
+						"name: set struct tag option requires a slice, got string",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := schema.FromModel(context.Background(), testCase.model, testCase.opts...)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSchemaValidateModel(t *testing.T) {
+	t.Parallel()
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":   schema.StringAttribute{Computed: true},
+			"tags": schema.SetAttribute{ElementType: types.StringType, Optional: true},
+		},
+	}
+
+	testCases := map[string]struct {
+		model    any
+		expected diag.Diagnostics
+	}{
+		"valid": {
+			model: &struct {
+				ID   string    `tfsdk:"id"`
+				Tags types.Set `tfsdk:"tags"`
+			}{},
+		},
+		"invalid": {
+			model: struct {
+				ID   bool   `tfsdk:"id"`
+				Name string `tfsdk:"name"`
+			}{},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Schema Model Mismatch",
+					"The schema and its Go model type do not agree. This is always an error in the provider. "+
+						"Please report the following to the provider developer:\n\n"+
+						"name: model field Name has no schema attribute",
+				),
+				diag.NewErrorDiagnostic(
+					"Schema Model Mismatch",
+					"The schema and its Go model type do not agree. This is always an error in the provider. "+
+						"Please report the following to the provider developer:\n\n"+
+						"id: model type bool is incompatible with schema type tftypes.String",
+				),
+				diag.NewErrorDiagnostic(
+					"Schema Model Mismatch",
+					"The schema and its Go model type do not agree. This is always an error in the provider. "+
+						"Please report the following to the provider developer:\n\n"+
+						"tags: schema attribute has no model field",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := s.ValidateModel(context.Background(), testCase.model)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
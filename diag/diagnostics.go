@@ -25,6 +25,11 @@ func (diags *Diagnostics) AddError(summary string, detail string) {
 	diags.Append(NewErrorDiagnostic(summary, detail))
 }
 
+// AddResourceNotFound adds a resource not found diagnostic to the collection.
+func (diags *Diagnostics) AddResourceNotFound(detail string) {
+	diags.Append(NewResourceNotFoundDiagnostic(detail))
+}
+
 // AddWarning adds a generic warning diagnostic to the collection.
 func (diags *Diagnostics) AddWarning(summary string, detail string) {
 	diags.Append(NewWarningDiagnostic(summary, detail))
@@ -87,6 +92,18 @@ func (diags Diagnostics) HasError() bool {
 	return false
 }
 
+// HasResourceNotFound returns true if the collection has a
+// ResourceNotFoundDiagnostic.
+func (diags Diagnostics) HasResourceNotFound() bool {
+	for _, diag := range diags {
+		if _, ok := diag.(ResourceNotFoundDiagnostic); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
 // ErrorsCount returns the number of Diagnostic in Diagnostics that are SeverityError.
 func (diags Diagnostics) ErrorsCount() int {
 	return len(diags.Errors())
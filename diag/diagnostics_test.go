@@ -191,6 +191,56 @@ func TestDiagnosticsAddError(t *testing.T) {
 	}
 }
 
+func TestDiagnosticsAddResourceNotFound(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diags    diag.Diagnostics
+		detail   string
+		expected diag.Diagnostics
+	}{
+		"nil-add": {
+			diags:  nil,
+			detail: "one detail",
+			expected: diag.Diagnostics{
+				diag.NewResourceNotFoundDiagnostic("one detail"),
+			},
+		},
+		"add": {
+			diags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("one summary", "one detail"),
+			},
+			detail: "two detail",
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic("one summary", "one detail"),
+				diag.NewResourceNotFoundDiagnostic("two detail"),
+			},
+		},
+		"duplicate": {
+			diags: diag.Diagnostics{
+				diag.NewResourceNotFoundDiagnostic("one detail"),
+			},
+			detail: "one detail",
+			expected: diag.Diagnostics{
+				diag.NewResourceNotFoundDiagnostic("one detail"),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tc.diags.AddResourceNotFound(tc.detail)
+
+			if diff := cmp.Diff(tc.diags, tc.expected); diff != "" {
+				t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestDiagnosticsAddWarning(t *testing.T) {
 	t.Parallel()
 
@@ -647,6 +697,46 @@ func TestDiagnosticsHasError(t *testing.T) {
 	}
 }
 
+func TestDiagnosticsHasResourceNotFound(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diags    diag.Diagnostics
+		expected bool
+	}{
+		"matching": {
+			diags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("one summary", "one detail"),
+				diag.NewResourceNotFoundDiagnostic("two detail"),
+			},
+			expected: true,
+		},
+		"nil-diagnostics": {
+			diags:    nil,
+			expected: false,
+		},
+		"error": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Resource Not Found", "one detail"),
+			},
+			expected: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.diags.HasResourceNotFound()
+
+			if got != tc.expected {
+				t.Errorf("Unexpected response: got: %t, wanted: %t", got, tc.expected)
+			}
+		})
+	}
+}
+
 func TestDiagnosticsErrorsCount(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,54 @@
+package diag
+
+var _ Diagnostic = ResourceNotFoundDiagnostic{}
+
+// ResourceNotFoundDiagnostic is an error severity diagnostic which signals
+// that the remote object of a managed resource was not found, such as when an
+// API returns an HTTP 404 response.
+//
+// The framework handles this diagnostic when returned by the resource Read
+// method by removing the resource from the Terraform state and returning a
+// warning diagnostic instead, so Terraform can plan to create the resource
+// again. When returned by the resource Delete method, the deletion is treated
+// as successful. When returned by the resource Read method called after
+// Create or Update, such as with resource.ResourceWithReadAfterWrite, it is
+// replaced by a single "Resource Not Found" error and the state returned by
+// Create or Update is saved. In other operations, it is returned as an error.
+type ResourceNotFoundDiagnostic struct {
+	detail string
+}
+
+// Detail returns the diagnostic detail.
+func (d ResourceNotFoundDiagnostic) Detail() string {
+	return d.detail
+}
+
+// Equal returns true if the other diagnostic is wholly equivalent.
+func (d ResourceNotFoundDiagnostic) Equal(other Diagnostic) bool {
+	nfd, ok := other.(ResourceNotFoundDiagnostic)
+
+	if !ok {
+		return false
+	}
+
+	return nfd.Detail() == d.Detail()
+}
+
+// Severity returns the diagnostic severity.
+func (d ResourceNotFoundDiagnostic) Severity() Severity {
+	return SeverityError
+}
+
+// Summary returns the diagnostic summary.
+func (d ResourceNotFoundDiagnostic) Summary() string {
+	return "Resource Not Found"
+}
+
+// NewResourceNotFoundDiagnostic returns a new resource not found diagnostic
+// with the given detail, such as the remote object identifier and API
+// response.
+func NewResourceNotFoundDiagnostic(detail string) ResourceNotFoundDiagnostic {
+	return ResourceNotFoundDiagnostic{
+		detail: detail,
+	}
+}
@@ -0,0 +1,51 @@
+package diag_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestResourceNotFoundDiagnosticEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diag     diag.ResourceNotFoundDiagnostic
+		other    diag.Diagnostic
+		expected bool
+	}{
+		"matching": {
+			diag:     diag.NewResourceNotFoundDiagnostic("test detail"),
+			other:    diag.NewResourceNotFoundDiagnostic("test detail"),
+			expected: true,
+		},
+		"nil": {
+			diag:     diag.NewResourceNotFoundDiagnostic("test detail"),
+			other:    nil,
+			expected: false,
+		},
+		"different-detail": {
+			diag:     diag.NewResourceNotFoundDiagnostic("test detail"),
+			other:    diag.NewResourceNotFoundDiagnostic("different detail"),
+			expected: false,
+		},
+		"different-type": {
+			diag:     diag.NewResourceNotFoundDiagnostic("test detail"),
+			other:    diag.NewErrorDiagnostic("Resource Not Found", "test detail"),
+			expected: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.diag.Equal(tc.other)
+
+			if got != tc.expected {
+				t.Errorf("Unexpected response: got: %t, wanted: %t", got, tc.expected)
+			}
+		})
+	}
+}
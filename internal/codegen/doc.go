@@ -0,0 +1,7 @@
+// Package codegen implements Go code generation of framework schema
+// definitions and tfsdk-tagged model structs from the Terraform CLI provider
+// schema JSON format or the framework-specific Spec format.
+//
+// The cmd/tfplugingen-framework command is the entry point for this
+// functionality.
+package codegen
@@ -0,0 +1,575 @@
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/tfjson"
+)
+
+const (
+	importAttr  = "github.com/hashicorp/terraform-plugin-framework/attr"
+	importTypes = "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// schemaKind contains the differences between generated provider, resource,
+// and data source code.
+type schemaKind struct {
+	// Description is the human friendly name, such as data source.
+	Description string
+
+	// FilePrefix is the generated file name prefix, such as data_source_.
+	FilePrefix string
+
+	// Identifier is the Go identifier suffix, such as DataSource.
+	Identifier string
+
+	// ImportPath is the schema package import path.
+	ImportPath string
+
+	// SupportsComputed is true if the schema package attributes support the
+	// Computed field.
+	SupportsComputed bool
+
+	// SupportsVersion is true if the schema package Schema supports the
+	// Version field.
+	SupportsVersion bool
+}
+
+var (
+	schemaKindDataSource = schemaKind{
+		Description:      "data source",
+		FilePrefix:       "data_source_",
+		Identifier:       "DataSource",
+		ImportPath:       "github.com/hashicorp/terraform-plugin-framework/datasource/schema",
+		SupportsComputed: true,
+	}
+	schemaKindProvider = schemaKind{
+		Description: "provider",
+		FilePrefix:  "provider",
+		Identifier:  "Provider",
+		ImportPath:  "github.com/hashicorp/terraform-plugin-framework/provider/schema",
+	}
+	schemaKindResource = schemaKind{
+		Description:      "resource",
+		FilePrefix:       "resource_",
+		Identifier:       "Resource",
+		ImportPath:       "github.com/hashicorp/terraform-plugin-framework/resource/schema",
+		SupportsComputed: true,
+		SupportsVersion:  true,
+	}
+)
+
+// Generate returns the generated Go source code of the Spec, keyed by file
+// name. Each provider, resource, and data source schema is generated into a
+// separate file containing a schema function and a model struct for the
+// schema data along with any nested object model structs.
+func Generate(spec Spec) (map[string][]byte, error) {
+	pkg := spec.Package
+
+	if pkg == "" {
+		pkg = DefaultPackage
+	}
+
+	files := make(map[string][]byte)
+
+	if spec.Provider.Schema != nil {
+		src, err := generateFile(pkg, schemaKindProvider, spec.Provider.Name, goIdentifier(spec.Provider.Name), spec.Provider.Schema)
+
+		if err != nil {
+			return nil, fmt.Errorf("provider: %w", err)
+		}
+
+		files[schemaKindProvider.FilePrefix+"_gen.go"] = src
+	}
+
+	for _, kind := range []struct {
+		schemaKind
+		schemas []SpecSchema
+	}{
+		{schemaKind: schemaKindResource, schemas: spec.Resources},
+		{schemaKind: schemaKindDataSource, schemas: spec.DataSources},
+	} {
+		for _, specSchema := range kind.schemas {
+			fileName := kind.FilePrefix + strings.TrimPrefix(specSchema.Name, spec.Provider.Name+"_") + "_gen.go"
+
+			if _, ok := files[fileName]; ok {
+				return nil, fmt.Errorf("%s %s: duplicate generated file name %s", kind.Description, specSchema.Name, fileName)
+			}
+
+			src, err := generateFile(pkg, kind.schemaKind, specSchema.Name, typeNameIdentifier(spec.Provider.Name, specSchema.Name), specSchema.Schema)
+
+			if err != nil {
+				return nil, fmt.Errorf("%s %s: %w", kind.Description, specSchema.Name, err)
+			}
+
+			files[fileName] = src
+		}
+	}
+
+	return files, nil
+}
+
+// generator contains the state of generating a single file.
+type generator struct {
+	kind schemaKind
+
+	// imports contains the import paths used in the generated code, other
+	// than the always imported schema package.
+	imports map[string]struct{}
+
+	// models contains all model structs in generation order.
+	models []*model
+
+	// modelNames contains all model struct names to prevent duplicates.
+	modelNames map[string]struct{}
+}
+
+// model is a generated model struct.
+type model struct {
+	// Name is the Go type name.
+	Name string
+
+	// Description is the human friendly description used in the type comment.
+	Description string
+
+	// Fields are the struct fields in schema name order.
+	Fields []modelField
+
+	// ObjectType is the object type for nested object models, used to
+	// generate the AttrTypes method. Root models do not set this.
+	ObjectType *tftypes.Object
+}
+
+// modelField is a generated model struct field.
+type modelField struct {
+	// Name is the Go field name.
+	Name string
+
+	// Tag is the tfsdk tag value.
+	Tag string
+
+	// ValueType is the Go value type, such as types.String.
+	ValueType string
+}
+
+// generateFile returns the formatted Go source code of a single schema.
+func generateFile(pkg string, kind schemaKind, name string, identifier string, schema *tfjson.Schema) ([]byte, error) {
+	if schema == nil || schema.Block == nil {
+		return nil, fmt.Errorf("missing schema")
+	}
+
+	g := &generator{
+		kind:       kind,
+		imports:    make(map[string]struct{}),
+		modelNames: make(map[string]struct{}),
+	}
+
+	prefix := identifier + kind.Identifier
+
+	rootModel, err := g.newModel(prefix+"Model", fmt.Sprintf("describes the %s %s data model.", name, kind.Description), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var body bytes.Buffer
+
+	fmt.Fprintf(&body, "// %sSchema returns the schema of the %s %s.\n", prefix, name, kind.Description)
+	fmt.Fprintf(&body, "func %sSchema() schema.Schema {\n", prefix)
+	body.WriteString("return schema.Schema{\n")
+
+	if err := g.writeBlockContents(&body, schema.Block, rootModel, prefix); err != nil {
+		return nil, err
+	}
+
+	writeDescription(&body, schema.Block.Description, schema.Block.DescriptionKind)
+
+	if schema.Block.Deprecated {
+		fmt.Fprintf(&body, "DeprecationMessage: %q,\n", "This "+kind.Description+" is deprecated.")
+	}
+
+	if kind.SupportsVersion && schema.Version != 0 {
+		fmt.Fprintf(&body, "Version: %d,\n", schema.Version)
+	}
+
+	body.WriteString("}\n}\n")
+
+	for _, m := range g.models {
+		if err := g.writeModel(&body, m); err != nil {
+			return nil, err
+		}
+	}
+
+	var src bytes.Buffer
+
+	src.WriteString("// Code generated by tfplugingen-framework. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&src, "package %s\n\n", pkg)
+
+	imports := []string{kind.ImportPath}
+
+	for importPath := range g.imports {
+		imports = append(imports, importPath)
+	}
+
+	sort.Strings(imports)
+
+	src.WriteString("import (\n")
+
+	for _, importPath := range imports {
+		fmt.Fprintf(&src, "%q\n", importPath)
+	}
+
+	src.WriteString(")\n\n")
+	src.Write(body.Bytes())
+
+	formatted, err := format.Source(src.Bytes())
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to format generated code: %w", err)
+	}
+
+	return formatted, nil
+}
+
+// newModel registers a new model struct.
+func (g *generator) newModel(name string, description string, objectType *tftypes.Object) (*model, error) {
+	if _, ok := g.modelNames[name]; ok {
+		return nil, fmt.Errorf("duplicate model name %s", name)
+	}
+
+	m := &model{
+		Name:        name,
+		Description: description,
+		ObjectType:  objectType,
+	}
+
+	g.modelNames[name] = struct{}{}
+	g.models = append(g.models, m)
+
+	return m, nil
+}
+
+// writeModel writes the model struct and, for nested object models, the
+// AttrTypes method.
+func (g *generator) writeModel(w *bytes.Buffer, m *model) error {
+	fmt.Fprintf(w, "\n// %s %s\n", m.Name, m.Description)
+	fmt.Fprintf(w, "type %s struct {\n", m.Name)
+
+	for _, field := range m.Fields {
+		fmt.Fprintf(w, "%s %s `tfsdk:%q`\n", field.Name, field.ValueType, field.Tag)
+	}
+
+	w.WriteString("}\n")
+
+	if m.ObjectType == nil {
+		return nil
+	}
+
+	attrTypes, err := g.attrTypesExpr(*m.ObjectType)
+
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "\n// AttrTypes returns the attribute types of the %s object.\n", m.Name)
+	fmt.Fprintf(w, "func (%s) AttrTypes() map[string]attr.Type {\n", m.Name)
+	fmt.Fprintf(w, "return %s\n", attrTypes)
+	w.WriteString("}\n")
+
+	return nil
+}
+
+// addField adds a field to the model, preventing duplicate Go field names.
+func (g *generator) addField(m *model, tag string, valueType string) error {
+	name := goIdentifier(tag)
+
+	for _, field := range m.Fields {
+		if field.Name == name {
+			return fmt.Errorf("%s: duplicate field name %s for %s and %s", m.Name, name, field.Tag, tag)
+		}
+	}
+
+	m.Fields = append(m.Fields, modelField{
+		Name:      name,
+		Tag:       tag,
+		ValueType: valueType,
+	})
+
+	g.imports[importTypes] = struct{}{}
+
+	return nil
+}
+
+// writeBlockContents writes the Attributes and Blocks fields of a schema,
+// nested block object, or single nested block.
+func (g *generator) writeBlockContents(w *bytes.Buffer, block *tfjson.SchemaBlock, m *model, prefix string) error {
+	if err := g.writeAttributes(w, block.Attributes, m, prefix); err != nil {
+		return err
+	}
+
+	if len(block.NestedBlocks) == 0 {
+		return nil
+	}
+
+	w.WriteString("Blocks: map[string]schema.Block{\n")
+
+	for _, name := range sortedKeys(block.NestedBlocks) {
+		fmt.Fprintf(w, "%q: ", name)
+
+		if err := g.writeBlock(w, name, block.NestedBlocks[name], m, prefix); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+
+		w.WriteString(",\n")
+	}
+
+	w.WriteString("},\n")
+
+	return nil
+}
+
+// writeAttributes writes the Attributes field.
+func (g *generator) writeAttributes(w *bytes.Buffer, attributes map[string]*tfjson.SchemaAttribute, m *model, prefix string) error {
+	if len(attributes) == 0 {
+		return nil
+	}
+
+	w.WriteString("Attributes: map[string]schema.Attribute{\n")
+
+	for _, name := range sortedKeys(attributes) {
+		fmt.Fprintf(w, "%q: ", name)
+
+		if err := g.writeAttribute(w, name, attributes[name], m, prefix); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+
+		w.WriteString(",\n")
+	}
+
+	w.WriteString("},\n")
+
+	return nil
+}
+
+// writeAttribute writes a single attribute definition and adds the
+// associated model field.
+func (g *generator) writeAttribute(w *bytes.Buffer, name string, a *tfjson.SchemaAttribute, m *model, prefix string) error {
+	if a == nil {
+		return fmt.Errorf("missing attribute")
+	}
+
+	if a.AttributeNestedType == nil {
+		if a.AttributeType == nil || a.AttributeType.Type == nil {
+			return fmt.Errorf("missing type")
+		}
+
+		kind, err := g.attributeKindFor(a.AttributeType.Type)
+
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(w, "schema.%s{\n", kind.SchemaType)
+
+		if kind.TypeField != "" {
+			fmt.Fprintf(w, "%s: %s,\n", kind.TypeField, kind.TypeFieldValue)
+		}
+
+		if err := g.writeAttributeFields(w, a); err != nil {
+			return err
+		}
+
+		w.WriteString("}")
+
+		return g.addField(m, name, kind.ValueType)
+	}
+
+	nestedType := a.AttributeNestedType
+	nestedPrefix := prefix + goIdentifier(name)
+
+	objectType, err := attributesTerraformType(nestedType.Attributes)
+
+	if err != nil {
+		return err
+	}
+
+	nestedModel, err := g.newModel(nestedPrefix+"Model", fmt.Sprintf("describes the %s nested attribute object data model.", name), &objectType)
+
+	if err != nil {
+		return err
+	}
+
+	var schemaType, valueType string
+
+	switch nestedType.NestingMode {
+	case tfjson.SchemaNestingModeList:
+		schemaType, valueType = "ListNestedAttribute", "types.List"
+	case tfjson.SchemaNestingModeMap:
+		schemaType, valueType = "MapNestedAttribute", "types.Map"
+	case tfjson.SchemaNestingModeSet:
+		schemaType, valueType = "SetNestedAttribute", "types.Set"
+	case tfjson.SchemaNestingModeSingle:
+		schemaType, valueType = "SingleNestedAttribute", "types.Object"
+	default:
+		return fmt.Errorf("unsupported nesting mode %q", nestedType.NestingMode)
+	}
+
+	fmt.Fprintf(w, "schema.%s{\n", schemaType)
+
+	if nestedType.NestingMode == tfjson.SchemaNestingModeSingle {
+		if err := g.writeAttributes(w, nestedType.Attributes, nestedModel, nestedPrefix); err != nil {
+			return err
+		}
+	} else {
+		w.WriteString("NestedObject: schema.NestedAttributeObject{\n")
+
+		if err := g.writeAttributes(w, nestedType.Attributes, nestedModel, nestedPrefix); err != nil {
+			return err
+		}
+
+		w.WriteString("},\n")
+	}
+
+	if err := g.writeAttributeFields(w, a); err != nil {
+		return err
+	}
+
+	w.WriteString("}")
+
+	return g.addField(m, name, valueType)
+}
+
+// writeAttributeFields writes the requiredness, sensitivity, description, and
+// deprecation fields of an attribute.
+func (g *generator) writeAttributeFields(w *bytes.Buffer, a *tfjson.SchemaAttribute) error {
+	if a.Required {
+		w.WriteString("Required: true,\n")
+	}
+
+	if a.Optional {
+		w.WriteString("Optional: true,\n")
+	}
+
+	if a.Computed {
+		switch {
+		case g.kind.SupportsComputed:
+			w.WriteString("Computed: true,\n")
+		case !a.Optional && !a.Required:
+			return fmt.Errorf("computed-only attributes are not supported in %s schemas", g.kind.Description)
+		}
+	}
+
+	if a.Sensitive {
+		w.WriteString("Sensitive: true,\n")
+	}
+
+	writeDescription(w, a.Description, a.DescriptionKind)
+
+	if a.Deprecated {
+		fmt.Fprintf(w, "DeprecationMessage: %q,\n", "This attribute is deprecated.")
+	}
+
+	return nil
+}
+
+// writeBlock writes a single block definition and adds the associated model
+// field.
+func (g *generator) writeBlock(w *bytes.Buffer, name string, b *tfjson.SchemaBlockType, m *model, prefix string) error {
+	if b == nil {
+		return fmt.Errorf("missing block")
+	}
+
+	block := b.Block
+
+	if block == nil {
+		block = &tfjson.SchemaBlock{}
+	}
+
+	nestedPrefix := prefix + goIdentifier(name)
+
+	objectType, err := blockObjectTerraformType(block)
+
+	if err != nil {
+		return err
+	}
+
+	nestedModel, err := g.newModel(nestedPrefix+"Model", fmt.Sprintf("describes the %s nested block object data model.", name), &objectType)
+
+	if err != nil {
+		return err
+	}
+
+	var schemaType, valueType string
+
+	switch b.NestingMode {
+	case tfjson.SchemaNestingModeList:
+		schemaType, valueType = "ListNestedBlock", "types.List"
+	case tfjson.SchemaNestingModeSet:
+		schemaType, valueType = "SetNestedBlock", "types.Set"
+	case tfjson.SchemaNestingModeSingle:
+		schemaType, valueType = "SingleNestedBlock", "types.Object"
+	default:
+		return fmt.Errorf("unsupported block nesting mode %q", b.NestingMode)
+	}
+
+	fmt.Fprintf(w, "schema.%s{\n", schemaType)
+
+	if b.NestingMode == tfjson.SchemaNestingModeSingle {
+		if err := g.writeBlockContents(w, block, nestedModel, nestedPrefix); err != nil {
+			return err
+		}
+	} else {
+		w.WriteString("NestedObject: schema.NestedBlockObject{\n")
+
+		if err := g.writeBlockContents(w, block, nestedModel, nestedPrefix); err != nil {
+			return err
+		}
+
+		w.WriteString("},\n")
+	}
+
+	writeDescription(w, block.Description, block.DescriptionKind)
+
+	if block.Deprecated {
+		fmt.Fprintf(w, "DeprecationMessage: %q,\n", "This block is deprecated.")
+	}
+
+	w.WriteString("}")
+
+	return g.addField(m, name, valueType)
+}
+
+// writeDescription writes the Description or MarkdownDescription field,
+// depending on the description kind.
+func writeDescription(w *bytes.Buffer, description string, kind tfjson.SchemaDescriptionKind) {
+	if description == "" {
+		return
+	}
+
+	if kind == tfjson.SchemaDescriptionKindMarkdown {
+		fmt.Fprintf(w, "MarkdownDescription: %q,\n", description)
+
+		return
+	}
+
+	fmt.Fprintf(w, "Description: %q,\n", description)
+}
+
+// sortedKeys returns the sorted keys of a map.
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,415 @@
+package codegen_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/codegen"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tfjson"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		spec          codegen.Spec
+		expected      map[string]string
+		expectedError string
+	}{
+		"empty": {
+			spec:     codegen.Spec{},
+			expected: map[string]string{},
+		},
+		"provider": {
+			spec: codegen.Spec{
+				Provider: codegen.SpecProvider{
+					Name: "examplecloud",
+					Schema: &tfjson.Schema{
+						Block: &tfjson.SchemaBlock{
+							Attributes: map[string]*tfjson.SchemaAttribute{
+								"api_token": {
+									AttributeType:   &tfjson.Type{Type: tftypes.String},
+									Description:     "API token.",
+									DescriptionKind: tfjson.SchemaDescriptionKindPlain,
+									Optional:        true,
+									Computed:        true,
+									Sensitive:       true,
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: map[string]string{
+				"provider_gen.go": `// Code generated by tfplugingen-framework. DO NOT EDIT.
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ExamplecloudProviderSchema returns the schema of the examplecloud provider.
+func ExamplecloudProviderSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"api_token": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "API token.",
+			},
+		},
+	}
+}
+
+// ExamplecloudProviderModel describes the examplecloud provider data model.
+type ExamplecloudProviderModel struct {
+	APIToken types.String ` + "`tfsdk:\"api_token\"`" + `
+}
+`,
+			},
+		},
+		"provider-computed-only": {
+			spec: codegen.Spec{
+				Provider: codegen.SpecProvider{
+					Name: "examplecloud",
+					Schema: &tfjson.Schema{
+						Block: &tfjson.SchemaBlock{
+							Attributes: map[string]*tfjson.SchemaAttribute{
+								"endpoint": {
+									AttributeType: &tfjson.Type{Type: tftypes.String},
+									Computed:      true,
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: "provider: endpoint: computed-only attributes are not supported in provider schemas",
+		},
+		"resource": {
+			spec: codegen.Spec{
+				Package: "example",
+				Provider: codegen.SpecProvider{
+					Name: "examplecloud",
+				},
+				Resources: []codegen.SpecSchema{
+					{
+						Name: "examplecloud_thing",
+						Schema: &tfjson.Schema{
+							Version: 2,
+							Block: &tfjson.SchemaBlock{
+								Attributes: map[string]*tfjson.SchemaAttribute{
+									"id": {
+										AttributeType: &tfjson.Type{Type: tftypes.String},
+										Computed:      true,
+									},
+									"ports": {
+										AttributeType: &tfjson.Type{Type: tftypes.Set{ElementType: tftypes.Number}},
+										Optional:      true,
+									},
+									"rule": {
+										AttributeNestedType: &tfjson.SchemaNestedAttributeType{
+											Attributes: map[string]*tfjson.SchemaAttribute{
+												"enabled": {
+													AttributeType: &tfjson.Type{Type: tftypes.Bool},
+													Required:      true,
+												},
+											},
+											NestingMode: tfjson.SchemaNestingModeSingle,
+										},
+										Deprecated:      true,
+										Description:     "The *rule*.",
+										DescriptionKind: tfjson.SchemaDescriptionKindMarkdown,
+										Optional:        true,
+									},
+								},
+								NestedBlocks: map[string]*tfjson.SchemaBlockType{
+									"setting": {
+										Block: &tfjson.SchemaBlock{
+											Attributes: map[string]*tfjson.SchemaAttribute{
+												"value": {
+													AttributeType: &tfjson.Type{Type: tftypes.Object{AttributeTypes: map[string]tftypes.Type{"a": tftypes.String}}},
+													Optional:      true,
+												},
+											},
+										},
+										NestingMode: tfjson.SchemaNestingModeList,
+									},
+								},
+								Description: "Manages a thing.",
+							},
+						},
+					},
+				},
+			},
+			expected: map[string]string{
+				"resource_thing_gen.go": `// Code generated by tfplugingen-framework. DO NOT EDIT.
+
+package example
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ThingResourceSchema returns the schema of the examplecloud_thing resource.
+func ThingResourceSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"ports": schema.SetAttribute{
+				ElementType: types.NumberType,
+				Optional:    true,
+			},
+			"rule": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Required: true,
+					},
+				},
+				Optional:            true,
+				MarkdownDescription: "The *rule*.",
+				DeprecationMessage:  "This attribute is deprecated.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"setting": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"value": schema.ObjectAttribute{
+							AttributeTypes: map[string]attr.Type{
+								"a": types.StringType,
+							},
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+		Description: "Manages a thing.",
+		Version:     2,
+	}
+}
+
+// ThingResourceModel describes the examplecloud_thing resource data model.
+type ThingResourceModel struct {
+	ID      types.String ` + "`tfsdk:\"id\"`" + `
+	Ports   types.Set    ` + "`tfsdk:\"ports\"`" + `
+	Rule    types.Object ` + "`tfsdk:\"rule\"`" + `
+	Setting types.List   ` + "`tfsdk:\"setting\"`" + `
+}
+
+// ThingResourceRuleModel describes the rule nested attribute object data model.
+type ThingResourceRuleModel struct {
+	Enabled types.Bool ` + "`tfsdk:\"enabled\"`" + `
+}
+
+// AttrTypes returns the attribute types of the ThingResourceRuleModel object.
+func (ThingResourceRuleModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"enabled": types.BoolType,
+	}
+}
+
+// ThingResourceSettingModel describes the setting nested block object data model.
+type ThingResourceSettingModel struct {
+	Value types.Object ` + "`tfsdk:\"value\"`" + `
+}
+
+// AttrTypes returns the attribute types of the ThingResourceSettingModel object.
+func (ThingResourceSettingModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"value": types.ObjectType{AttrTypes: map[string]attr.Type{
+			"a": types.StringType,
+		}},
+	}
+}
+`,
+			},
+		},
+		"data-source": {
+			spec: codegen.Spec{
+				Provider: codegen.SpecProvider{
+					Name: "examplecloud",
+				},
+				DataSources: []codegen.SpecSchema{
+					{
+						Name: "examplecloud_thing",
+						Schema: &tfjson.Schema{
+							Block: &tfjson.SchemaBlock{
+								Attributes: map[string]*tfjson.SchemaAttribute{
+									"items": {
+										AttributeNestedType: &tfjson.SchemaNestedAttributeType{
+											Attributes: map[string]*tfjson.SchemaAttribute{
+												"name": {
+													AttributeType: &tfjson.Type{Type: tftypes.String},
+													Computed:      true,
+												},
+											},
+											NestingMode: tfjson.SchemaNestingModeMap,
+										},
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: map[string]string{
+				"data_source_thing_gen.go": `// Code generated by tfplugingen-framework. DO NOT EDIT.
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ThingDataSourceSchema returns the schema of the examplecloud_thing data source.
+func ThingDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"items": schema.MapNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+// ThingDataSourceModel describes the examplecloud_thing data source data model.
+type ThingDataSourceModel struct {
+	Items types.Map ` + "`tfsdk:\"items\"`" + `
+}
+
+// ThingDataSourceItemsModel describes the items nested attribute object data model.
+type ThingDataSourceItemsModel struct {
+	Name types.String ` + "`tfsdk:\"name\"`" + `
+}
+
+// AttrTypes returns the attribute types of the ThingDataSourceItemsModel object.
+func (ThingDataSourceItemsModel) AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name": types.StringType,
+	}
+}
+`,
+			},
+		},
+		"unsupported-type": {
+			spec: codegen.Spec{
+				Resources: []codegen.SpecSchema{
+					{
+						Name: "examplecloud_thing",
+						Schema: &tfjson.Schema{
+							Block: &tfjson.SchemaBlock{
+								Attributes: map[string]*tfjson.SchemaAttribute{
+									"dynamic": {
+										AttributeType: &tfjson.Type{Type: tftypes.DynamicPseudoType},
+										Optional:      true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: "resource examplecloud_thing: dynamic: unsupported type tftypes.DynamicPseudoType",
+		},
+		"unsupported-block-nesting-mode": {
+			spec: codegen.Spec{
+				Resources: []codegen.SpecSchema{
+					{
+						Name: "examplecloud_thing",
+						Schema: &tfjson.Schema{
+							Block: &tfjson.SchemaBlock{
+								NestedBlocks: map[string]*tfjson.SchemaBlockType{
+									"group": {
+										Block:       &tfjson.SchemaBlock{},
+										NestingMode: tfjson.SchemaNestingModeGroup,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: `resource examplecloud_thing: group: unsupported block nesting mode "group"`,
+		},
+		"duplicate-field-name": {
+			spec: codegen.Spec{
+				Resources: []codegen.SpecSchema{
+					{
+						Name: "examplecloud_thing",
+						Schema: &tfjson.Schema{
+							Block: &tfjson.SchemaBlock{
+								Attributes: map[string]*tfjson.SchemaAttribute{
+									"a__b": {
+										AttributeType: &tfjson.Type{Type: tftypes.String},
+										Optional:      true,
+									},
+									"a_b": {
+										AttributeType: &tfjson.Type{Type: tftypes.String},
+										Optional:      true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedError: "resource examplecloud_thing: a_b: ExamplecloudThingResourceModel: duplicate field name AB for a__b and a_b",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := codegen.Generate(testCase.spec)
+
+			if err != nil {
+				if testCase.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if err.Error() != testCase.expectedError {
+					t.Fatalf("expected error %q, got %q", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("expected error %q, got none", testCase.expectedError)
+			}
+
+			gotStrings := make(map[string]string, len(got))
+
+			for fileName, src := range got {
+				gotStrings[fileName] = string(src)
+			}
+
+			if diff := cmp.Diff(gotStrings, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
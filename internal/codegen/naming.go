@@ -0,0 +1,87 @@
+package codegen
+
+import (
+	"strings"
+	"unicode"
+)
+
+// commonInitialisms are name parts which are fully uppercased in Go
+// identifiers, following the Go code review conventions.
+var commonInitialisms = map[string]struct{}{
+	"acl":   {},
+	"api":   {},
+	"arn":   {},
+	"ascii": {},
+	"cpu":   {},
+	"css":   {},
+	"dns":   {},
+	"eof":   {},
+	"guid":  {},
+	"html":  {},
+	"http":  {},
+	"https": {},
+	"id":    {},
+	"ip":    {},
+	"json":  {},
+	"lhs":   {},
+	"qps":   {},
+	"ram":   {},
+	"rhs":   {},
+	"rpc":   {},
+	"sla":   {},
+	"smtp":  {},
+	"sql":   {},
+	"ssh":   {},
+	"tcp":   {},
+	"tls":   {},
+	"ttl":   {},
+	"udp":   {},
+	"ui":    {},
+	"uid":   {},
+	"uri":   {},
+	"url":   {},
+	"utf8":  {},
+	"uuid":  {},
+	"vm":    {},
+	"xml":   {},
+	"xmpp":  {},
+	"xsrf":  {},
+	"xss":   {},
+}
+
+// goIdentifier returns the exported Go identifier for a Terraform snake case
+// name, such as ExampleID for example_id.
+func goIdentifier(name string) string {
+	var b strings.Builder
+
+	for _, part := range strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if _, ok := commonInitialisms[part]; ok {
+			b.WriteString(strings.ToUpper(part))
+			continue
+		}
+
+		runes := []rune(part)
+		runes[0] = unicode.ToUpper(runes[0])
+		b.WriteString(string(runes))
+	}
+
+	result := b.String()
+
+	if result == "" || unicode.IsDigit([]rune(result)[0]) {
+		result = "X" + result
+	}
+
+	return result
+}
+
+// typeNameIdentifier returns the Go identifier for a resource or data source
+// type name, removing the provider type name prefix if present.
+func typeNameIdentifier(providerName string, typeName string) string {
+	if providerName != "" && strings.HasPrefix(typeName, providerName+"_") {
+		typeName = strings.TrimPrefix(typeName, providerName+"_")
+	}
+
+	return goIdentifier(typeName)
+}
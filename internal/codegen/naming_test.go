@@ -0,0 +1,100 @@
+package codegen
+
+import (
+	"testing"
+)
+
+func TestGoIdentifier(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name     string
+		expected string
+	}{
+		"single": {
+			name:     "name",
+			expected: "Name",
+		},
+		"multiple": {
+			name:     "example_name",
+			expected: "ExampleName",
+		},
+		"initialism": {
+			name:     "id",
+			expected: "ID",
+		},
+		"initialism-multiple": {
+			name:     "api_url",
+			expected: "APIURL",
+		},
+		"initialism-suffix": {
+			name:     "vpc_id",
+			expected: "VpcID",
+		},
+		"number": {
+			name:     "ipv4_address",
+			expected: "Ipv4Address",
+		},
+		"leading-number": {
+			name:     "1st",
+			expected: "X1st",
+		},
+		"repeated-underscores": {
+			name:     "a__b",
+			expected: "AB",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := goIdentifier(testCase.name)
+
+			if got != testCase.expected {
+				t.Errorf("expected %q, got %q", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestTypeNameIdentifier(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		providerName string
+		typeName     string
+		expected     string
+	}{
+		"prefix": {
+			providerName: "examplecloud",
+			typeName:     "examplecloud_thing",
+			expected:     "Thing",
+		},
+		"no-prefix": {
+			providerName: "examplecloud",
+			typeName:     "other_thing",
+			expected:     "OtherThing",
+		},
+		"no-provider-name": {
+			typeName: "examplecloud_thing",
+			expected: "ExamplecloudThing",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := typeNameIdentifier(testCase.providerName, testCase.typeName)
+
+			if got != testCase.expected {
+				t.Errorf("expected %q, got %q", testCase.expected, got)
+			}
+		})
+	}
+}
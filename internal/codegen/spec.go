@@ -0,0 +1,136 @@
+package codegen
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/tfjson"
+)
+
+// DefaultPackage is the Go package name used when a Spec does not define one.
+const DefaultPackage = "provider"
+
+// Spec is the framework-specific code generation specification. Each schema
+// uses the same representation as the Terraform CLI provider schema JSON
+// format.
+//
+// An example specification:
+//
+//	{
+//	  "package": "provider",
+//	  "provider": {
+//	    "name": "examplecloud",
+//	    "schema": {"version": 0, "block": {"attributes": {...}}}
+//	  },
+//	  "resources": [
+//	    {
+//	      "name": "examplecloud_thing",
+//	      "schema": {"version": 0, "block": {"attributes": {...}}}
+//	    }
+//	  ]
+//	}
+type Spec struct {
+	// Package is the Go package name of the generated code. Defaults to
+	// DefaultPackage.
+	Package string `json:"package,omitempty"`
+
+	// Provider is the provider name and optional provider schema. The name
+	// is used to remove the provider prefix from resource and data source
+	// type names in generated Go identifiers.
+	Provider SpecProvider `json:"provider"`
+
+	// Resources are the managed resource schemas to generate.
+	Resources []SpecSchema `json:"resources,omitempty"`
+
+	// DataSources are the data source schemas to generate.
+	DataSources []SpecSchema `json:"data_sources,omitempty"`
+}
+
+// SpecProvider is the provider information of a Spec.
+type SpecProvider struct {
+	// Name is the provider type name, such as examplecloud.
+	Name string `json:"name"`
+
+	// Schema is the optional provider schema.
+	Schema *tfjson.Schema `json:"schema,omitempty"`
+}
+
+// SpecSchema is a resource or data source in a Spec.
+type SpecSchema struct {
+	// Name is the full type name, such as examplecloud_thing.
+	Name string `json:"name"`
+
+	// Schema is the schema of the resource or data source.
+	Schema *tfjson.Schema `json:"schema"`
+}
+
+// ParseSpec returns a Spec from its JSON representation.
+func ParseSpec(data []byte) (Spec, error) {
+	var spec Spec
+
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return spec, fmt.Errorf("unable to parse spec: %w", err)
+	}
+
+	return spec, nil
+}
+
+// ParseProviderSchemas returns a Spec from the output of the
+// `terraform providers schema -json` command. The address selects the
+// provider, such as registry.terraform.io/hashicorp/example, and can be
+// omitted when the output only contains a single provider.
+func ParseProviderSchemas(data []byte, address string) (Spec, error) {
+	var schemas tfjson.ProviderSchemas
+
+	if err := json.Unmarshal(data, &schemas); err != nil {
+		return Spec{}, fmt.Errorf("unable to parse provider schemas: %w", err)
+	}
+
+	return SpecFromProviderSchemas(schemas, address)
+}
+
+// SpecFromProviderSchemas returns a Spec from the given provider schemas. The
+// address selects the provider and can be omitted when there is only a single
+// provider.
+func SpecFromProviderSchemas(schemas tfjson.ProviderSchemas, address string) (Spec, error) {
+	address, providerSchema, err := schemas.ProviderSchema(address)
+
+	if err != nil {
+		return Spec{}, err
+	}
+
+	spec := Spec{
+		Provider: SpecProvider{
+			Name:   address[strings.LastIndex(address, "/")+1:],
+			Schema: providerSchema.ConfigSchema,
+		},
+		Resources:   specSchemas(providerSchema.ResourceSchemas),
+		DataSources: specSchemas(providerSchema.DataSourceSchemas),
+	}
+
+	return spec, nil
+}
+
+// specSchemas returns the sorted SpecSchema equivalent of the given schemas.
+func specSchemas(schemas map[string]*tfjson.Schema) []SpecSchema {
+	if len(schemas) == 0 {
+		return nil
+	}
+
+	result := make([]SpecSchema, 0, len(schemas))
+
+	for name, schema := range schemas {
+		result = append(result, SpecSchema{
+			Name:   name,
+			Schema: schema,
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result
+}
@@ -0,0 +1,180 @@
+package codegen_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/codegen"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tfjson"
+)
+
+func TestParseProviderSchemas(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		data          string
+		address       string
+		expected      codegen.Spec
+		expectedError string
+	}{
+		"single-provider": {
+			data: `{
+				"format_version": "1.0",
+				"provider_schemas": {
+					"registry.terraform.io/examplecorp/examplecloud": {
+						"provider": {"version": 0, "block": {"attributes": {"token": {"type": "string", "optional": true}}}},
+						"resource_schemas": {
+							"examplecloud_b": {"version": 0, "block": {}},
+							"examplecloud_a": {"version": 1, "block": {}}
+						},
+						"data_source_schemas": {
+							"examplecloud_c": {"version": 0, "block": {}}
+						}
+					}
+				}
+			}`,
+			expected: codegen.Spec{
+				Provider: codegen.SpecProvider{
+					Name: "examplecloud",
+					Schema: &tfjson.Schema{
+						Block: &tfjson.SchemaBlock{
+							Attributes: map[string]*tfjson.SchemaAttribute{
+								"token": {
+									AttributeType: &tfjson.Type{Type: tftypes.String},
+									Optional:      true,
+								},
+							},
+						},
+					},
+				},
+				Resources: []codegen.SpecSchema{
+					{
+						Name:   "examplecloud_a",
+						Schema: &tfjson.Schema{Version: 1, Block: &tfjson.SchemaBlock{}},
+					},
+					{
+						Name:   "examplecloud_b",
+						Schema: &tfjson.Schema{Block: &tfjson.SchemaBlock{}},
+					},
+				},
+				DataSources: []codegen.SpecSchema{
+					{
+						Name:   "examplecloud_c",
+						Schema: &tfjson.Schema{Block: &tfjson.SchemaBlock{}},
+					},
+				},
+			},
+		},
+		"multiple-providers-address": {
+			data: `{
+				"format_version": "1.0",
+				"provider_schemas": {
+					"registry.terraform.io/examplecorp/examplecloud": {},
+					"registry.terraform.io/examplecorp/other": {}
+				}
+			}`,
+			address: "registry.terraform.io/examplecorp/other",
+			expected: codegen.Spec{
+				Provider: codegen.SpecProvider{
+					Name: "other",
+				},
+			},
+		},
+		"multiple-providers-no-address": {
+			data: `{
+				"format_version": "1.0",
+				"provider_schemas": {
+					"registry.terraform.io/examplecorp/examplecloud": {},
+					"registry.terraform.io/examplecorp/other": {}
+				}
+			}`,
+			expectedError: "provider address must be given when provider schemas do not contain exactly one provider, found: registry.terraform.io/examplecorp/examplecloud, registry.terraform.io/examplecorp/other",
+		},
+		"address-not-found": {
+			data: `{
+				"format_version": "1.0",
+				"provider_schemas": {
+					"registry.terraform.io/examplecorp/examplecloud": {}
+				}
+			}`,
+			address:       "registry.terraform.io/examplecorp/other",
+			expectedError: `provider "registry.terraform.io/examplecorp/other" not found in provider schemas`,
+		},
+		"invalid-type": {
+			data: `{
+				"format_version": "1.0",
+				"provider_schemas": {
+					"registry.terraform.io/examplecorp/examplecloud": {
+						"provider": {"version": 0, "block": {"attributes": {"token": {"type": "invalid"}}}}
+					}
+				}
+			}`,
+			expectedError: `unable to parse provider schemas: invalid primitive type name "invalid"`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := codegen.ParseProviderSchemas([]byte(testCase.data), testCase.address)
+
+			if err != nil {
+				if testCase.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if err.Error() != testCase.expectedError {
+					t.Fatalf("expected error %q, got %q", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("expected error %q, got none", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestParseSpec(t *testing.T) {
+	t.Parallel()
+
+	got, err := codegen.ParseSpec([]byte(`{
+		"package": "example",
+		"provider": {"name": "examplecloud"},
+		"resources": [
+			{"name": "examplecloud_thing", "schema": {"version": 2, "block": {}}}
+		]
+	}`))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := codegen.Spec{
+		Package: "example",
+		Provider: codegen.SpecProvider{
+			Name: "examplecloud",
+		},
+		Resources: []codegen.SpecSchema{
+			{
+				Name:   "examplecloud_thing",
+				Schema: &tfjson.Schema{Version: 2, Block: &tfjson.SchemaBlock{}},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -0,0 +1,261 @@
+package codegen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/tfjson"
+)
+
+// attributeKind contains the generated code details for a non-nested
+// attribute of a given Terraform type.
+type attributeKind struct {
+	// SchemaType is the schema package attribute type name, such as
+	// StringAttribute.
+	SchemaType string
+
+	// ValueType is the types package value type name, such as types.String.
+	ValueType string
+
+	// TypeField is the optional attribute field which defines the underlying
+	// type, such as ElementType for collection attributes.
+	TypeField string
+
+	// TypeFieldValue is the Go expression of the TypeField value.
+	TypeFieldValue string
+}
+
+// attributeKindFor returns the attributeKind for a Terraform type.
+func (g *generator) attributeKindFor(typ tftypes.Type) (attributeKind, error) {
+	switch t := typ.(type) {
+	case tftypes.List:
+		elemType, err := g.typeExpr(t.ElementType)
+
+		if err != nil {
+			return attributeKind{}, err
+		}
+
+		return attributeKind{SchemaType: "ListAttribute", ValueType: "types.List", TypeField: "ElementType", TypeFieldValue: elemType}, nil
+	case tftypes.Map:
+		elemType, err := g.typeExpr(t.ElementType)
+
+		if err != nil {
+			return attributeKind{}, err
+		}
+
+		return attributeKind{SchemaType: "MapAttribute", ValueType: "types.Map", TypeField: "ElementType", TypeFieldValue: elemType}, nil
+	case tftypes.Object:
+		attrTypes, err := g.attrTypesExpr(t)
+
+		if err != nil {
+			return attributeKind{}, err
+		}
+
+		return attributeKind{SchemaType: "ObjectAttribute", ValueType: "types.Object", TypeField: "AttributeTypes", TypeFieldValue: attrTypes}, nil
+	case tftypes.Set:
+		elemType, err := g.typeExpr(t.ElementType)
+
+		if err != nil {
+			return attributeKind{}, err
+		}
+
+		return attributeKind{SchemaType: "SetAttribute", ValueType: "types.Set", TypeField: "ElementType", TypeFieldValue: elemType}, nil
+	}
+
+	switch {
+	case typ.Is(tftypes.Bool):
+		return attributeKind{SchemaType: "BoolAttribute", ValueType: "types.Bool"}, nil
+	case typ.Is(tftypes.Number):
+		return attributeKind{SchemaType: "NumberAttribute", ValueType: "types.Number"}, nil
+	case typ.Is(tftypes.String):
+		return attributeKind{SchemaType: "StringAttribute", ValueType: "types.String"}, nil
+	}
+
+	return attributeKind{}, fmt.Errorf("unsupported type %s", typ)
+}
+
+// typeExpr returns the Go expression of the framework type equivalent of a
+// Terraform type, such as types.ListType{ElemType: types.StringType}.
+func (g *generator) typeExpr(typ tftypes.Type) (string, error) {
+	switch t := typ.(type) {
+	case tftypes.List:
+		elemType, err := g.typeExpr(t.ElementType)
+
+		if err != nil {
+			return "", err
+		}
+
+		return "types.ListType{ElemType: " + elemType + "}", nil
+	case tftypes.Map:
+		elemType, err := g.typeExpr(t.ElementType)
+
+		if err != nil {
+			return "", err
+		}
+
+		return "types.MapType{ElemType: " + elemType + "}", nil
+	case tftypes.Object:
+		attrTypes, err := g.attrTypesExpr(t)
+
+		if err != nil {
+			return "", err
+		}
+
+		return "types.ObjectType{AttrTypes: " + attrTypes + "}", nil
+	case tftypes.Set:
+		elemType, err := g.typeExpr(t.ElementType)
+
+		if err != nil {
+			return "", err
+		}
+
+		return "types.SetType{ElemType: " + elemType + "}", nil
+	}
+
+	switch {
+	case typ.Is(tftypes.Bool):
+		return "types.BoolType", nil
+	case typ.Is(tftypes.Number):
+		return "types.NumberType", nil
+	case typ.Is(tftypes.String):
+		return "types.StringType", nil
+	}
+
+	return "", fmt.Errorf("unsupported type %s", typ)
+}
+
+// attrTypesExpr returns the Go expression of the framework attribute types
+// of a Terraform object type.
+func (g *generator) attrTypesExpr(typ tftypes.Object) (string, error) {
+	if len(typ.OptionalAttributes) > 0 {
+		return "", fmt.Errorf("unsupported object type with optional attributes %s", typ)
+	}
+
+	names := make([]string, 0, len(typ.AttributeTypes))
+
+	for name := range typ.AttributeTypes {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var b strings.Builder
+
+	b.WriteString("map[string]attr.Type{\n")
+
+	for _, name := range names {
+		attrType, err := g.typeExpr(typ.AttributeTypes[name])
+
+		if err != nil {
+			return "", err
+		}
+
+		fmt.Fprintf(&b, "%q: %s,\n", name, attrType)
+	}
+
+	b.WriteString("}")
+
+	g.imports[importAttr] = struct{}{}
+
+	return b.String(), nil
+}
+
+// attributeTerraformType returns the Terraform type of an attribute, including
+// nested attributes.
+func attributeTerraformType(a *tfjson.SchemaAttribute) (tftypes.Type, error) {
+	if a.AttributeNestedType == nil {
+		if a.AttributeType == nil || a.AttributeType.Type == nil {
+			return nil, fmt.Errorf("missing type")
+		}
+
+		return a.AttributeType.Type, nil
+	}
+
+	object, err := attributesTerraformType(a.AttributeNestedType.Attributes)
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch a.AttributeNestedType.NestingMode {
+	case tfjson.SchemaNestingModeList:
+		return tftypes.List{ElementType: object}, nil
+	case tfjson.SchemaNestingModeMap:
+		return tftypes.Map{ElementType: object}, nil
+	case tfjson.SchemaNestingModeSet:
+		return tftypes.Set{ElementType: object}, nil
+	case tfjson.SchemaNestingModeSingle:
+		return object, nil
+	default:
+		return nil, fmt.Errorf("unsupported nesting mode %q", a.AttributeNestedType.NestingMode)
+	}
+}
+
+// attributesTerraformType returns the Terraform object type of the given
+// attributes.
+func attributesTerraformType(attributes map[string]*tfjson.SchemaAttribute) (tftypes.Object, error) {
+	object := tftypes.Object{
+		AttributeTypes: make(map[string]tftypes.Type, len(attributes)),
+	}
+
+	for name, attribute := range attributes {
+		attrType, err := attributeTerraformType(attribute)
+
+		if err != nil {
+			return object, fmt.Errorf("%s: %w", name, err)
+		}
+
+		object.AttributeTypes[name] = attrType
+	}
+
+	return object, nil
+}
+
+// blockTerraformType returns the Terraform type of a nested block.
+func blockTerraformType(b *tfjson.SchemaBlockType) (tftypes.Type, error) {
+	object, err := blockObjectTerraformType(b.Block)
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch b.NestingMode {
+	case tfjson.SchemaNestingModeList:
+		return tftypes.List{ElementType: object}, nil
+	case tfjson.SchemaNestingModeSet:
+		return tftypes.Set{ElementType: object}, nil
+	case tfjson.SchemaNestingModeSingle:
+		return object, nil
+	default:
+		return nil, fmt.Errorf("unsupported block nesting mode %q", b.NestingMode)
+	}
+}
+
+// blockObjectTerraformType returns the Terraform object type of the
+// attributes and nested blocks of a block.
+func blockObjectTerraformType(b *tfjson.SchemaBlock) (tftypes.Object, error) {
+	if b == nil {
+		return tftypes.Object{AttributeTypes: map[string]tftypes.Type{}}, nil
+	}
+
+	object, err := attributesTerraformType(b.Attributes)
+
+	if err != nil {
+		return object, err
+	}
+
+	for name, block := range b.NestedBlocks {
+		blockType, err := blockTerraformType(block)
+
+		if err != nil {
+			return object, fmt.Errorf("%s: %w", name, err)
+		}
+
+		object.AttributeTypes[name] = blockType
+	}
+
+	return object, nil
+}
@@ -0,0 +1,122 @@
+package docgen
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
+)
+
+// describer is the common documentation interface of validators and plan
+// modifiers.
+type describer interface {
+	Description(context.Context) string
+	MarkdownDescription(context.Context) string
+}
+
+// descriptions returns the non-empty descriptions of the given validators or
+// plan modifiers, preferring Markdown descriptions.
+func descriptions[T describer](ctx context.Context, describers []T) []string {
+	var result []string
+
+	for _, d := range describers {
+		if description := preferMarkdown(d.Description(ctx), d.MarkdownDescription(ctx)); description != "" {
+			result = append(result, description)
+		}
+	}
+
+	return result
+}
+
+// attributeValidatorDescriptions returns the validator descriptions of an
+// attribute.
+func attributeValidatorDescriptions(ctx context.Context, a fwschema.Attribute) []string {
+	switch a := a.(type) {
+	case fwxschema.AttributeWithBoolValidators:
+		return descriptions(ctx, a.BoolValidators())
+	case fwxschema.AttributeWithFloat64Validators:
+		return descriptions(ctx, a.Float64Validators())
+	case fwxschema.AttributeWithInt64Validators:
+		return descriptions(ctx, a.Int64Validators())
+	case fwxschema.AttributeWithListValidators:
+		return descriptions(ctx, a.ListValidators())
+	case fwxschema.AttributeWithMapValidators:
+		return descriptions(ctx, a.MapValidators())
+	case fwxschema.AttributeWithNumberValidators:
+		return descriptions(ctx, a.NumberValidators())
+	case fwxschema.AttributeWithObjectValidators:
+		return descriptions(ctx, a.ObjectValidators())
+	case fwxschema.AttributeWithSetValidators:
+		return descriptions(ctx, a.SetValidators())
+	case fwxschema.AttributeWithStringValidators:
+		return descriptions(ctx, a.StringValidators())
+	default:
+		return nil
+	}
+}
+
+// attributePlanModifierDescriptions returns the plan modifier descriptions
+// of an attribute.
+func attributePlanModifierDescriptions(ctx context.Context, a fwschema.Attribute) []string {
+	switch a := a.(type) {
+	case fwxschema.AttributeWithBoolPlanModifiers:
+		return descriptions(ctx, a.BoolPlanModifiers())
+	case fwxschema.AttributeWithFloat64PlanModifiers:
+		return descriptions(ctx, a.Float64PlanModifiers())
+	case fwxschema.AttributeWithInt64PlanModifiers:
+		return descriptions(ctx, a.Int64PlanModifiers())
+	case fwxschema.AttributeWithListPlanModifiers:
+		return descriptions(ctx, a.ListPlanModifiers())
+	case fwxschema.AttributeWithMapPlanModifiers:
+		return descriptions(ctx, a.MapPlanModifiers())
+	case fwxschema.AttributeWithNumberPlanModifiers:
+		return descriptions(ctx, a.NumberPlanModifiers())
+	case fwxschema.AttributeWithObjectPlanModifiers:
+		return descriptions(ctx, a.ObjectPlanModifiers())
+	case fwxschema.AttributeWithSetPlanModifiers:
+		return descriptions(ctx, a.SetPlanModifiers())
+	case fwxschema.AttributeWithStringPlanModifiers:
+		return descriptions(ctx, a.StringPlanModifiers())
+	default:
+		return nil
+	}
+}
+
+// blockValidatorDescriptions returns the validator descriptions of a block.
+func blockValidatorDescriptions(ctx context.Context, b fwschema.Block) []string {
+	switch b := b.(type) {
+	case fwxschema.BlockWithListValidators:
+		return descriptions(ctx, b.ListValidators())
+	case fwxschema.BlockWithObjectValidators:
+		return descriptions(ctx, b.ObjectValidators())
+	case fwxschema.BlockWithSetValidators:
+		return descriptions(ctx, b.SetValidators())
+	default:
+		return nil
+	}
+}
+
+// blockPlanModifierDescriptions returns the plan modifier descriptions of a
+// block.
+func blockPlanModifierDescriptions(ctx context.Context, b fwschema.Block) []string {
+	switch b := b.(type) {
+	case fwxschema.BlockWithListPlanModifiers:
+		return descriptions(ctx, b.ListPlanModifiers())
+	case fwxschema.BlockWithObjectPlanModifiers:
+		return descriptions(ctx, b.ObjectPlanModifiers())
+	case fwxschema.BlockWithSetPlanModifiers:
+		return descriptions(ctx, b.SetPlanModifiers())
+	default:
+		return nil
+	}
+}
+
+// preferMarkdown returns the Markdown description if set, otherwise the
+// plaintext description.
+func preferMarkdown(plain string, markdown string) string {
+	if markdown != "" {
+		return markdown
+	}
+
+	return plain
+}
@@ -0,0 +1,3 @@
+// Package docgen implements the generation of Terraform Registry-style
+// Markdown documentation from framework schemas.
+package docgen
@@ -0,0 +1,228 @@
+package docgen
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+)
+
+const (
+	// KindProvider is the PageData Kind of the provider page.
+	KindProvider = "Provider"
+
+	// KindResource is the PageData Kind of resource pages.
+	KindResource = "Resource"
+
+	// KindDataSource is the PageData Kind of data source pages.
+	KindDataSource = "Data Source"
+)
+
+// Schemas contains the schemas to document.
+type Schemas struct {
+	// ProviderName is the provider type name, such as examplecloud.
+	ProviderName string
+
+	// Provider is the provider schema, if any.
+	Provider fwschema.Schema
+
+	// Resources is the resource schemas by type name.
+	Resources map[string]fwschema.Schema
+
+	// DataSources is the data source schemas by type name.
+	DataSources map[string]fwschema.Schema
+}
+
+// Options configures the documentation generation.
+type Options struct {
+	// Templates optionally contains text/template page templates which
+	// override the default templates, using the file names:
+	//
+	//   - index.md.tmpl: provider page
+	//   - resources/<name>.md.tmpl: page of a single resource
+	//   - resources.md.tmpl: pages of all other resources
+	//   - data-sources/<name>.md.tmpl: page of a single data source
+	//   - data-sources.md.tmpl: pages of all other data sources
+	//
+	// Where <name> is the type name without the provider name prefix.
+	// Templates are executed with PageData.
+	Templates fs.FS
+
+	// Examples optionally contains example files, which are included in the
+	// default templates when they exist, using the file names:
+	//
+	//   - provider/provider.tf: provider example
+	//   - resources/<type name>/resource.tf: resource example
+	//   - resources/<type name>/import.sh: resource import example
+	//   - data-sources/<type name>/data-source.tf: data source example
+	//
+	// Templates can include any of the files with the codefile and tffile
+	// functions.
+	Examples fs.FS
+}
+
+// PageData is the data available to page templates.
+type PageData struct {
+	// Kind is KindProvider, KindResource, or KindDataSource.
+	Kind string
+
+	// Name is the provider name or the resource or data source type name.
+	Name string
+
+	// ShortName is the Name without the provider name prefix.
+	ShortName string
+
+	// ProviderName is the provider type name.
+	ProviderName string
+
+	// Description is the schema description, preferring the Markdown
+	// description.
+	Description string
+
+	// DeprecationMessage is the schema deprecation message, if any.
+	DeprecationMessage string
+
+	// SchemaMarkdown is the SchemaMarkdown output of the schema.
+	SchemaMarkdown string
+
+	// ExampleFile is the path of the example file in the examples, if it
+	// exists.
+	ExampleFile string
+
+	// ImportFile is the path of the resource import example file in the
+	// examples, if it exists.
+	ImportFile string
+}
+
+// Generate returns the Terraform Registry-style Markdown documentation pages
+// of the given schemas, keyed by file path relative to the documentation
+// directory: index.md, resources/<name>.md, and data-sources/<name>.md.
+func Generate(ctx context.Context, schemas Schemas, opts Options) (map[string][]byte, error) {
+	files := make(map[string][]byte)
+
+	if schemas.Provider != nil {
+		data := pageData(ctx, KindProvider, schemas.ProviderName, schemas.ProviderName, schemas.Provider)
+		data.ExampleFile = exampleFile(opts.Examples, "provider/provider.tf")
+
+		content, err := page(opts, data, defaultProviderTemplate, "index.md.tmpl")
+
+		if err != nil {
+			return nil, fmt.Errorf("provider: %w", err)
+		}
+
+		files["index.md"] = content
+	}
+
+	for _, typeName := range sortedTypeNames(schemas.Resources) {
+		data := pageData(ctx, KindResource, typeName, schemas.ProviderName, schemas.Resources[typeName])
+		data.ExampleFile = exampleFile(opts.Examples, path.Join("resources", typeName, "resource.tf"))
+		data.ImportFile = exampleFile(opts.Examples, path.Join("resources", typeName, "import.sh"))
+
+		content, err := page(opts, data, defaultTemplate, path.Join("resources", data.ShortName+".md.tmpl"), "resources.md.tmpl")
+
+		if err != nil {
+			return nil, fmt.Errorf("resource %s: %w", typeName, err)
+		}
+
+		files[path.Join("resources", data.ShortName+".md")] = content
+	}
+
+	for _, typeName := range sortedTypeNames(schemas.DataSources) {
+		data := pageData(ctx, KindDataSource, typeName, schemas.ProviderName, schemas.DataSources[typeName])
+		data.ExampleFile = exampleFile(opts.Examples, path.Join("data-sources", typeName, "data-source.tf"))
+
+		content, err := page(opts, data, defaultTemplate, path.Join("data-sources", data.ShortName+".md.tmpl"), "data-sources.md.tmpl")
+
+		if err != nil {
+			return nil, fmt.Errorf("data source %s: %w", typeName, err)
+		}
+
+		files[path.Join("data-sources", data.ShortName+".md")] = content
+	}
+
+	return files, nil
+}
+
+// pageData returns the PageData of a schema without example files.
+func pageData(ctx context.Context, kind string, name string, providerName string, s fwschema.Schema) PageData {
+	return PageData{
+		Kind:               kind,
+		Name:               name,
+		ShortName:          strings.TrimPrefix(name, providerName+"_"),
+		ProviderName:       providerName,
+		Description:        preferMarkdown(s.GetDescription(), s.GetMarkdownDescription()),
+		DeprecationMessage: s.GetDeprecationMessage(),
+		SchemaMarkdown:     SchemaMarkdown(ctx, s),
+	}
+}
+
+// page returns the executed template of the first found template override
+// name, otherwise the default template.
+func page(opts Options, data PageData, defaultText string, templateNames ...string) ([]byte, error) {
+	text := defaultText
+
+	if opts.Templates != nil {
+		for _, templateName := range templateNames {
+			override, err := fs.ReadFile(opts.Templates, templateName)
+
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+
+			if err != nil {
+				return nil, fmt.Errorf("unable to read template %s: %w", templateName, err)
+			}
+
+			text = string(override)
+
+			break
+		}
+	}
+
+	tmpl, err := template.New("page").Funcs(templateFuncs(opts.Examples)).Parse(text)
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse template: %w", err)
+	}
+
+	var b bytes.Buffer
+
+	if err := tmpl.Execute(&b, data); err != nil {
+		return nil, fmt.Errorf("unable to execute template: %w", err)
+	}
+
+	return b.Bytes(), nil
+}
+
+// exampleFile returns the name if the file exists in the examples.
+func exampleFile(examples fs.FS, name string) string {
+	if examples == nil {
+		return ""
+	}
+
+	if _, err := fs.Stat(examples, name); err != nil {
+		return ""
+	}
+
+	return name
+}
+
+// sortedTypeNames returns the sorted keys of a map keyed by type name.
+func sortedTypeNames(m map[string]fwschema.Schema) []string {
+	result := make([]string, 0, len(m))
+
+	for typeName := range m {
+		result = append(result, typeName)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
@@ -0,0 +1,228 @@
+package docgen_test
+
+import (
+	"context"
+	"testing"
+	"testing/fstest"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/docgen"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	thingSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		MarkdownDescription: "Manages a *thing*.\nSecond line.",
+	}
+
+	schemas := docgen.Schemas{
+		ProviderName: "examplecloud",
+		Provider: schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"token": schema.StringAttribute{
+					Optional:  true,
+					Sensitive: true,
+				},
+			},
+		},
+		Resources: map[string]fwschema.Schema{
+			"examplecloud_thing": thingSchema,
+		},
+		DataSources: map[string]fwschema.Schema{
+			"examplecloud_thing": schema.Schema{
+				Attributes:         thingSchema.Attributes,
+				DeprecationMessage: "Use examplecloud_things instead.",
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		schemas       docgen.Schemas
+		opts          docgen.Options
+		expected      map[string]string
+		expectedError string
+	}{
+		"defaults": {
+			schemas: schemas,
+			expected: map[string]string{
+				"index.md": "---\n" +
+					"page_title: \"examplecloud Provider\"\n" +
+					"description: |-\n" +
+					"\n" +
+					"---\n" +
+					"\n" +
+					"# examplecloud Provider\n" +
+					"\n" +
+					"## Schema\n" +
+					"\n" +
+					"### Optional\n" +
+					"\n" +
+					"- `token` (String, Sensitive)\n",
+				"resources/thing.md": "---\n" +
+					"page_title: \"examplecloud_thing Resource - examplecloud\"\n" +
+					"subcategory: \"\"\n" +
+					"description: |-\n" +
+					"  Manages a *thing*.\n" +
+					"  Second line.\n" +
+					"---\n" +
+					"\n" +
+					"# examplecloud_thing (Resource)\n" +
+					"\n" +
+					"Manages a *thing*.\n" +
+					"Second line.\n" +
+					"\n" +
+					"## Schema\n" +
+					"\n" +
+					"### Read-Only\n" +
+					"\n" +
+					"- `id` (String)\n",
+				"data-sources/thing.md": "---\n" +
+					"page_title: \"examplecloud_thing Data Source - examplecloud\"\n" +
+					"subcategory: \"\"\n" +
+					"description: |-\n" +
+					"\n" +
+					"---\n" +
+					"\n" +
+					"# examplecloud_thing (Data Source)\n" +
+					"\n" +
+					"~> **Deprecated** Use examplecloud_things instead.\n" +
+					"\n" +
+					"## Schema\n" +
+					"\n" +
+					"### Read-Only\n" +
+					"\n" +
+					"- `id` (String)\n",
+			},
+		},
+		"examples": {
+			schemas: docgen.Schemas{
+				ProviderName: "examplecloud",
+				Resources: map[string]fwschema.Schema{
+					"examplecloud_thing": thingSchema,
+				},
+			},
+			opts: docgen.Options{
+				Examples: fstest.MapFS{
+					"resources/examplecloud_thing/resource.tf": {Data: []byte("resource \"examplecloud_thing\" \"example\" {}\n")},
+					"resources/examplecloud_thing/import.sh":   {Data: []byte("terraform import examplecloud_thing.example 123\n")},
+				},
+			},
+			expected: map[string]string{
+				"resources/thing.md": "---\n" +
+					"page_title: \"examplecloud_thing Resource - examplecloud\"\n" +
+					"subcategory: \"\"\n" +
+					"description: |-\n" +
+					"  Manages a *thing*.\n" +
+					"  Second line.\n" +
+					"---\n" +
+					"\n" +
+					"# examplecloud_thing (Resource)\n" +
+					"\n" +
+					"Manages a *thing*.\n" +
+					"Second line.\n" +
+					"\n" +
+					"## Example Usage\n" +
+					"\n" +
+					"```terraform\n" +
+					"resource \"examplecloud_thing\" \"example\" {}\n" +
+					"```\n" +
+					"\n" +
+					"## Schema\n" +
+					"\n" +
+					"### Read-Only\n" +
+					"\n" +
+					"- `id` (String)\n" +
+					"\n" +
+					"## Import\n" +
+					"\n" +
+					"Import is supported using the following syntax:\n" +
+					"\n" +
+					"```shell\n" +
+					"terraform import examplecloud_thing.example 123\n" +
+					"```\n",
+			},
+		},
+		"template-overrides": {
+			schemas: schemas,
+			opts: docgen.Options{
+				Examples: fstest.MapFS{
+					"provider/advanced.tf": {Data: []byte("provider \"examplecloud\" {}\n")},
+				},
+				Templates: fstest.MapFS{
+					"index.md.tmpl":               {Data: []byte("# {{ .Kind }} {{ .Name }}\n\n{{ tffile \"provider/advanced.tf\" }}\n")},
+					"resources/thing.md.tmpl":     {Data: []byte("# {{ .ShortName }}\n\n{{ .SchemaMarkdown }}")},
+					"data-sources.md.tmpl":        {Data: []byte("# {{ .Kind }} {{ .Name }}\n")},
+					"data-sources/unused.md.tmpl": {Data: []byte("unused")},
+				},
+			},
+			expected: map[string]string{
+				"index.md":              "# Provider examplecloud\n\n```terraform\nprovider \"examplecloud\" {}\n```\n",
+				"resources/thing.md":    "# thing\n\n## Schema\n\n### Read-Only\n\n- `id` (String)\n",
+				"data-sources/thing.md": "# Data Source examplecloud_thing\n",
+			},
+		},
+		"template-parse-error": {
+			schemas: schemas,
+			opts: docgen.Options{
+				Templates: fstest.MapFS{
+					"resources.md.tmpl": {Data: []byte("{{ .Name ")},
+				},
+			},
+			expectedError: `resource examplecloud_thing: unable to parse template: template: page:1: unclosed action`,
+		},
+		"template-missing-example": {
+			schemas: schemas,
+			opts: docgen.Options{
+				Templates: fstest.MapFS{
+					"index.md.tmpl": {Data: []byte(`{{ tffile "provider/missing.tf" }}`)},
+				},
+			},
+			expectedError: `provider: unable to execute template: template: page:1:3: executing "page" at <tffile "provider/missing.tf">: error calling tffile: unable to read example file provider/missing.tf: no examples`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := docgen.Generate(context.Background(), testCase.schemas, testCase.opts)
+
+			if err != nil {
+				if testCase.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if diff := cmp.Diff(err.Error(), testCase.expectedError); diff != "" {
+					t.Fatalf("unexpected error difference: %s", diff)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("expected error: %s", testCase.expectedError)
+			}
+
+			gotStrings := make(map[string]string, len(got))
+
+			for fileName, content := range got {
+				gotStrings[fileName] = string(content)
+			}
+
+			if diff := cmp.Diff(gotStrings, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,287 @@
+package docgen
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+)
+
+const (
+	// groupRequired is the heading of required attributes.
+	groupRequired = "Required"
+
+	// groupOptional is the heading of optional attributes and blocks.
+	groupOptional = "Optional"
+
+	// groupReadOnly is the heading of computed-only attributes.
+	groupReadOnly = "Read-Only"
+)
+
+// groups is the order of attribute and block groups.
+var groups = []string{groupRequired, groupOptional, groupReadOnly}
+
+// nestedSchema is a nested attribute object or block object which is
+// rendered after the parent schema.
+type nestedSchema struct {
+	anchor     string
+	path       []string
+	attributes map[string]fwschema.Attribute
+	blocks     map[string]fwschema.Block
+}
+
+// schemaItem is an attribute or block list item.
+type schemaItem struct {
+	name  string
+	group string
+	text  string
+}
+
+// schemaGroup is the rendered list items of a group.
+type schemaGroup struct {
+	heading string
+	text    string
+}
+
+// schemaRenderer renders schema Markdown, collecting nested schemas while
+// rendering so they can be written after their parent.
+type schemaRenderer struct {
+	ctx    context.Context
+	nested []nestedSchema
+}
+
+// SchemaMarkdown returns the Terraform Registry-style Markdown of the schema
+// attributes and blocks, starting with a "## Schema" heading. Attributes and
+// blocks are grouped by whether they are required, optional, or read-only,
+// with nested attributes and blocks described in separate sections linked
+// from their parent.
+//
+// Each attribute and block includes its type, description, deprecation
+// message, and the descriptions of its validators and plan modifiers.
+func SchemaMarkdown(ctx context.Context, s fwschema.Schema) string {
+	r := &schemaRenderer{ctx: ctx}
+
+	var b strings.Builder
+
+	b.WriteString("## Schema\n")
+
+	for _, group := range r.groups(nil, s.GetAttributes(), s.GetBlocks()) {
+		fmt.Fprintf(&b, "\n### %s\n\n%s", group.heading, group.text)
+	}
+
+	for len(r.nested) > 0 {
+		nested := r.nested[0]
+		r.nested = r.nested[1:]
+
+		fmt.Fprintf(&b, "\n<a id=%q></a>\n### Nested Schema for `%s`\n", nested.anchor, strings.Join(nested.path, "."))
+
+		for _, group := range r.groups(nested.path, nested.attributes, nested.blocks) {
+			fmt.Fprintf(&b, "\n%s:\n\n%s", group.heading, group.text)
+		}
+	}
+
+	return b.String()
+}
+
+// groups returns the rendered list items of the given attributes and blocks
+// by group.
+func (r *schemaRenderer) groups(path []string, attributes map[string]fwschema.Attribute, blocks map[string]fwschema.Block) []schemaGroup {
+	items := make([]schemaItem, 0, len(attributes)+len(blocks))
+
+	for name, attribute := range attributes {
+		items = append(items, r.attribute(append(path[:len(path):len(path)], name), attribute))
+	}
+
+	for name, block := range blocks {
+		items = append(items, r.block(append(path[:len(path):len(path)], name), block))
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].name < items[j].name
+	})
+
+	var result []schemaGroup
+
+	for _, group := range groups {
+		var b strings.Builder
+
+		for _, item := range items {
+			if item.group == group {
+				b.WriteString(item.text)
+			}
+		}
+
+		if b.Len() > 0 {
+			result = append(result, schemaGroup{
+				heading: group,
+				text:    b.String(),
+			})
+		}
+	}
+
+	return result
+}
+
+// attribute returns the list item of an attribute.
+func (r *schemaRenderer) attribute(path []string, a fwschema.Attribute) schemaItem {
+	item := schemaItem{
+		name:  path[len(path)-1],
+		group: groupReadOnly,
+	}
+
+	switch {
+	case a.IsRequired():
+		item.group = groupRequired
+	case a.IsOptional():
+		item.group = groupOptional
+	}
+
+	typeName := typeString(a.GetType().TerraformType(r.ctx))
+	var anchor string
+
+	if nestedAttribute, ok := a.(fwschema.NestedAttribute); ok {
+		switch nestedAttribute.GetNestingMode() {
+		case fwschema.NestingModeList:
+			typeName = "Attributes List"
+		case fwschema.NestingModeMap:
+			typeName = "Attributes Map"
+		case fwschema.NestingModeSet:
+			typeName = "Attributes Set"
+		default:
+			typeName = "Attributes"
+		}
+
+		anchor = "nestedatt--" + strings.Join(path, "--")
+
+		r.nested = append(r.nested, nestedSchema{
+			anchor:     anchor,
+			path:       path,
+			attributes: nestedAttribute.GetNestedObject().GetAttributes(),
+		})
+	}
+
+	flags := []string{typeName}
+
+	if a.IsSensitive() {
+		flags = append(flags, "Sensitive")
+	}
+
+	item.text = listItem(
+		item.name,
+		flags,
+		preferMarkdown(a.GetDescription(), a.GetMarkdownDescription()),
+		anchor,
+		a.GetDeprecationMessage(),
+		attributeValidatorDescriptions(r.ctx, a),
+		attributePlanModifierDescriptions(r.ctx, a),
+	)
+
+	return item
+}
+
+// block returns the list item of a block.
+func (r *schemaRenderer) block(path []string, b fwschema.Block) schemaItem {
+	item := schemaItem{
+		name:  path[len(path)-1],
+		group: groupOptional,
+	}
+
+	var typeName string
+
+	switch b.GetNestingMode() {
+	case fwschema.BlockNestingModeList:
+		typeName = "Block List"
+	case fwschema.BlockNestingModeSet:
+		typeName = "Block Set"
+	default:
+		typeName = "Block"
+	}
+
+	anchor := "nestedblock--" + strings.Join(path, "--")
+
+	r.nested = append(r.nested, nestedSchema{
+		anchor:     anchor,
+		path:       path,
+		attributes: b.GetNestedObject().GetAttributes(),
+		blocks:     b.GetNestedObject().GetBlocks(),
+	})
+
+	item.text = listItem(
+		item.name,
+		[]string{typeName},
+		preferMarkdown(b.GetDescription(), b.GetMarkdownDescription()),
+		anchor,
+		b.GetDeprecationMessage(),
+		blockValidatorDescriptions(r.ctx, b),
+		blockPlanModifierDescriptions(r.ctx, b),
+	)
+
+	return item
+}
+
+// listItem returns the Markdown list item of an attribute or block, such as
+// "- `name` (String, Deprecated) Description.", followed by indented list
+// items for the deprecation message, validators, and plan modifiers.
+func listItem(name string, flags []string, description string, anchor string, deprecationMessage string, validators []string, planModifiers []string) string {
+	var b strings.Builder
+
+	if deprecationMessage != "" {
+		flags = append(flags, "Deprecated")
+	}
+
+	fmt.Fprintf(&b, "- `%s` (%s)", name, strings.Join(flags, ", "))
+
+	if description = strings.TrimSpace(description); description != "" {
+		b.WriteString(" " + description)
+	}
+
+	if anchor != "" {
+		fmt.Fprintf(&b, " (see [below for nested schema](#%s))", anchor)
+	}
+
+	b.WriteString("\n")
+
+	if deprecationMessage != "" {
+		fmt.Fprintf(&b, "  - Deprecation: %s\n", strings.TrimSpace(deprecationMessage))
+	}
+
+	for _, v := range validators {
+		fmt.Fprintf(&b, "  - Validation: %s\n", v)
+	}
+
+	for _, p := range planModifiers {
+		fmt.Fprintf(&b, "  - Plan modification: %s\n", p)
+	}
+
+	return b.String()
+}
+
+// typeString returns the documentation name of a Terraform type, such as
+// List of String.
+func typeString(typ tftypes.Type) string {
+	switch t := typ.(type) {
+	case tftypes.List:
+		return "List of " + typeString(t.ElementType)
+	case tftypes.Map:
+		return "Map of " + typeString(t.ElementType)
+	case tftypes.Object:
+		return "Object"
+	case tftypes.Set:
+		return "Set of " + typeString(t.ElementType)
+	}
+
+	switch {
+	case typ.Is(tftypes.Bool):
+		return "Boolean"
+	case typ.Is(tftypes.Number):
+		return "Number"
+	case typ.Is(tftypes.String):
+		return "String"
+	default:
+		return "Dynamic"
+	}
+}
@@ -0,0 +1,198 @@
+package docgen_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/docgen"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSchemaMarkdown(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema   fwschema.Schema
+		expected string
+	}{
+		"empty": {
+			schema:   schema.Schema{},
+			expected: "## Schema\n",
+		},
+		"attributes": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed:    true,
+						Description: "Identifier.",
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"name": schema.StringAttribute{
+						MarkdownDescription: "The *name*.",
+						Required:            true,
+						Validators: []validator.String{
+							testvalidator.String{
+								DescriptionMethod: func(_ context.Context) string {
+									return "string length must be at least 1"
+								},
+							},
+							testvalidator.String{
+								DescriptionMethod: func(_ context.Context) string {
+									return "value must be lowercase"
+								},
+								MarkdownDescriptionMethod: func(_ context.Context) string {
+									return "value must be *lowercase*"
+								},
+							},
+						},
+					},
+					"old_name": schema.StringAttribute{
+						DeprecationMessage: "Use name instead.",
+						Optional:           true,
+					},
+					"password": schema.StringAttribute{
+						Optional:  true,
+						Sensitive: true,
+					},
+					"tags": schema.MapAttribute{
+						Computed:    true,
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"zones": schema.ListAttribute{
+						ElementType: types.SetType{ElemType: types.Int64Type},
+						Optional:    true,
+					},
+				},
+			},
+			expected: "## Schema\n" +
+				"\n" +
+				"### Required\n" +
+				"\n" +
+				"- `name` (String) The *name*.\n" +
+				"  - Validation: string length must be at least 1\n" +
+				"  - Validation: value must be *lowercase*\n" +
+				"\n" +
+				"### Optional\n" +
+				"\n" +
+				"- `old_name` (String, Deprecated)\n" +
+				"  - Deprecation: Use name instead.\n" +
+				"- `password` (String, Sensitive)\n" +
+				"- `tags` (Map of String)\n" +
+				"- `zones` (List of Set of Number)\n" +
+				"\n" +
+				"### Read-Only\n" +
+				"\n" +
+				"- `id` (String) Identifier.\n" +
+				"  - Plan modification: Once set, the value of this attribute in state will not change.\n",
+		},
+		"nested": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"rules": schema.ListNestedAttribute{
+						Description: "Rules.",
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"port": schema.Int64Attribute{
+									Required: true,
+								},
+								"settings": schema.SingleNestedAttribute{
+									Attributes: map[string]schema.Attribute{
+										"enabled": schema.BoolAttribute{
+											Computed: true,
+										},
+									},
+									Optional: true,
+								},
+							},
+						},
+						Optional: true,
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"timeouts": schema.SingleNestedBlock{
+						Attributes: map[string]schema.Attribute{
+							"create": schema.StringAttribute{
+								Optional: true,
+							},
+						},
+						Blocks: map[string]schema.Block{
+							"retry": schema.SetNestedBlock{
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"attempts": schema.NumberAttribute{
+											Optional: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: "## Schema\n" +
+				"\n" +
+				"### Optional\n" +
+				"\n" +
+				"- `rules` (Attributes List) Rules. (see [below for nested schema](#nestedatt--rules))\n" +
+				"- `timeouts` (Block) (see [below for nested schema](#nestedblock--timeouts))\n" +
+				"\n" +
+				"<a id=\"nestedatt--rules\"></a>\n" +
+				"### Nested Schema for `rules`\n" +
+				"\n" +
+				"Required:\n" +
+				"\n" +
+				"- `port` (Number)\n" +
+				"\n" +
+				"Optional:\n" +
+				"\n" +
+				"- `settings` (Attributes) (see [below for nested schema](#nestedatt--rules--settings))\n" +
+				"\n" +
+				"<a id=\"nestedblock--timeouts\"></a>\n" +
+				"### Nested Schema for `timeouts`\n" +
+				"\n" +
+				"Optional:\n" +
+				"\n" +
+				"- `create` (String)\n" +
+				"- `retry` (Block Set) (see [below for nested schema](#nestedblock--timeouts--retry))\n" +
+				"\n" +
+				"<a id=\"nestedatt--rules--settings\"></a>\n" +
+				"### Nested Schema for `rules.settings`\n" +
+				"\n" +
+				"Read-Only:\n" +
+				"\n" +
+				"- `enabled` (Boolean)\n" +
+				"\n" +
+				"<a id=\"nestedblock--timeouts--retry\"></a>\n" +
+				"### Nested Schema for `timeouts.retry`\n" +
+				"\n" +
+				"Optional:\n" +
+				"\n" +
+				"- `attempts` (Number)\n",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := docgen.SchemaMarkdown(context.Background(), testCase.schema)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package docgen
+
+import (
+	"fmt"
+	"io/fs"
+	"strings"
+	"text/template"
+)
+
+// defaultProviderTemplate is the provider page template used when there is
+// no template override.
+const defaultProviderTemplate = `---
+page_title: "{{ .ProviderName }} Provider"
+description: |-
+{{ .Description | trimspace | prefixlines "  " }}
+---
+
+# {{ .ProviderName }} Provider
+{{- if .DeprecationMessage }}
+
+~> **Deprecated** {{ .DeprecationMessage | trimspace }}
+{{- end }}
+{{- if .Description }}
+
+{{ .Description | trimspace }}
+{{- end }}
+{{- if .ExampleFile }}
+
+## Example Usage
+
+{{ tffile .ExampleFile }}
+{{- end }}
+
+{{ .SchemaMarkdown | trimspace }}
+`
+
+// defaultTemplate is the resource and data source page template used when
+// there is no template override.
+const defaultTemplate = `---
+page_title: "{{ .Name }} {{ .Kind }} - {{ .ProviderName }}"
+subcategory: ""
+description: |-
+{{ .Description | trimspace | prefixlines "  " }}
+---
+
+# {{ .Name }} ({{ .Kind }})
+{{- if .DeprecationMessage }}
+
+~> **Deprecated** {{ .DeprecationMessage | trimspace }}
+{{- end }}
+{{- if .Description }}
+
+{{ .Description | trimspace }}
+{{- end }}
+{{- if .ExampleFile }}
+
+## Example Usage
+
+{{ tffile .ExampleFile }}
+{{- end }}
+
+{{ .SchemaMarkdown | trimspace }}
+{{- if .ImportFile }}
+
+## Import
+
+Import is supported using the following syntax:
+
+{{ codefile "shell" .ImportFile }}
+{{- end }}
+`
+
+// templateFuncs returns the functions available to page templates:
+//
+//   - codefile: returns a fenced code block of the given language with the
+//     contents of a file in the examples.
+//   - prefixlines: returns the string with every line prefixed.
+//   - tffile: returns a fenced terraform code block with the contents of a
+//     file in the examples.
+//   - trimspace: returns the string without leading and trailing white space.
+func templateFuncs(examples fs.FS) template.FuncMap {
+	codefile := func(language string, name string) (string, error) {
+		if examples == nil {
+			return "", fmt.Errorf("unable to read example file %s: no examples", name)
+		}
+
+		content, err := fs.ReadFile(examples, name)
+
+		if err != nil {
+			return "", fmt.Errorf("unable to read example file: %w", err)
+		}
+
+		return "```" + language + "\n" + strings.TrimSpace(string(content)) + "\n```", nil
+	}
+
+	return template.FuncMap{
+		"codefile": codefile,
+		"prefixlines": func(prefix string, s string) string {
+			if s == "" {
+				return ""
+			}
+
+			return prefix + strings.ReplaceAll(s, "\n", "\n"+prefix)
+		},
+		"tffile": func(name string) (string, error) {
+			return codefile("terraform", name)
+		},
+		"trimspace": strings.TrimSpace,
+	}
+}
@@ -0,0 +1,324 @@
+package fromflatmap
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// unknownValue is the flatmap string which represents an unknown value,
+// which is the same as the legacy Terraform SDK unknown variable value.
+const unknownValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+// Value returns the tftypes.Value of the given object type decoded from the
+// flatmap, such as the tfprotov6.RawState Flatmap field.
+//
+// In the flatmap format, each primitive value is a string with a key of its
+// dot separated path, such as "block.0.attribute". Lists, sets, and tuples
+// have a "#" key with the number of elements, where set elements are keyed by
+// a hash rather than an index, such as "set.1234.attribute". Hashes of set
+// elements with unknown values are prefixed with "~", such as "set.~1234",
+// and are decoded the same way. Maps have a "%" key with the number of
+// elements, which are keyed by the map key. Map keys containing periods are
+// only supported for maps of primitive values, as the map key of maps of
+// collections or objects is the key segment before the first period, such as
+// "map.key.#". Missing keys are decoded as null values and the unknown value
+// marker as unknown values. Keys which are not in the type are ignored.
+func Value(flatmap map[string]string, typ tftypes.Type) (tftypes.Value, error) {
+	objectType, ok := typ.(tftypes.Object)
+
+	if !ok {
+		return tftypes.Value{}, fmt.Errorf("unable to decode flatmap: expected object type, got %s", typ)
+	}
+
+	return objectValue(flatmap, "", objectType)
+}
+
+// value returns the value of the given type at the given flatmap key.
+func value(flatmap map[string]string, key string, typ tftypes.Type) (tftypes.Value, error) {
+	switch t := typ.(type) {
+	case tftypes.Object:
+		return objectValue(flatmap, key+".", t)
+	case tftypes.Tuple:
+		return tupleValue(flatmap, key+".", t)
+	case tftypes.Map:
+		return mapValue(flatmap, key+".", t)
+	case tftypes.List:
+		return listValue(flatmap, key+".", t)
+	case tftypes.Set:
+		return setValue(flatmap, key+".", t)
+	default:
+		return primitiveValue(flatmap, key, typ)
+	}
+}
+
+// primitiveValue returns the string, number, or bool value at the given
+// flatmap key.
+func primitiveValue(flatmap map[string]string, key string, typ tftypes.Type) (tftypes.Value, error) {
+	rawValue, ok := flatmap[key]
+
+	if !ok {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	if rawValue == unknownValue {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	switch {
+	case typ.Is(tftypes.String):
+		return tftypes.NewValue(typ, rawValue), nil
+	case typ.Is(tftypes.Number):
+		n, _, err := big.ParseFloat(rawValue, 10, 512, big.ToNearestEven)
+
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("unable to decode flatmap key %q as number: %w", key, err)
+		}
+
+		return tftypes.NewValue(typ, n), nil
+	case typ.Is(tftypes.Bool):
+		switch rawValue {
+		case "true", "1":
+			return tftypes.NewValue(typ, true), nil
+		case "false", "0":
+			return tftypes.NewValue(typ, false), nil
+		default:
+			return tftypes.Value{}, fmt.Errorf("unable to decode flatmap key %q as bool: invalid value %q", key, rawValue)
+		}
+	default:
+		return tftypes.Value{}, fmt.Errorf("unable to decode flatmap key %q: unsupported type %s", key, typ)
+	}
+}
+
+// objectValue returns the object with attributes keyed under the prefix.
+func objectValue(flatmap map[string]string, prefix string, typ tftypes.Object) (tftypes.Value, error) {
+	attributes := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+
+	for name, attributeType := range typ.AttributeTypes {
+		attribute, err := value(flatmap, prefix+name, attributeType)
+
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+
+		attributes[name] = attribute
+	}
+
+	return tftypes.NewValue(typ, attributes), nil
+}
+
+// tupleValue returns the tuple with elements keyed under the prefix.
+func tupleValue(flatmap map[string]string, prefix string, typ tftypes.Tuple) (tftypes.Value, error) {
+	count, known, err := elementCount(flatmap, prefix, "#")
+
+	if err != nil || count < 0 || !known {
+		return nullOrUnknown(typ, count, known), err
+	}
+
+	if count != len(typ.ElementTypes) {
+		return tftypes.Value{}, fmt.Errorf("unable to decode flatmap key %q: expected %d tuple elements, got %d", prefix+"#", len(typ.ElementTypes), count)
+	}
+
+	elements := make([]tftypes.Value, 0, count)
+
+	for index, elementType := range typ.ElementTypes {
+		element, err := value(flatmap, prefix+strconv.Itoa(index), elementType)
+
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+
+		elements = append(elements, element)
+	}
+
+	return tftypes.NewValue(typ, elements), nil
+}
+
+// listValue returns the list with elements keyed by index under the prefix.
+func listValue(flatmap map[string]string, prefix string, typ tftypes.List) (tftypes.Value, error) {
+	count, known, err := elementCount(flatmap, prefix, "#")
+
+	if err != nil || count < 0 || !known {
+		return nullOrUnknown(typ, count, known), err
+	}
+
+	elements := make([]tftypes.Value, 0, count)
+
+	for index := 0; index < count; index++ {
+		element, err := value(flatmap, prefix+strconv.Itoa(index), typ.ElementType)
+
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+
+		elements = append(elements, element)
+	}
+
+	return tftypes.NewValue(typ, elements), nil
+}
+
+// setValue returns the set with elements keyed by hash under the prefix.
+func setValue(flatmap map[string]string, prefix string, typ tftypes.Set) (tftypes.Value, error) {
+	count, known, err := elementCount(flatmap, prefix, "#")
+
+	if err != nil || count < 0 || !known {
+		return nullOrUnknown(typ, count, known), err
+	}
+
+	elementKeys := subKeys(flatmap, prefix, "#")
+	elements := make([]tftypes.Value, 0, len(elementKeys))
+
+	for _, elementKey := range elementKeys {
+		element, err := value(flatmap, prefix+elementKey, typ.ElementType)
+
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+
+		elements = append(elements, element)
+	}
+
+	// An element without any non-null attributes has no keys, so the count
+	// is the only indication of the element.
+	if len(elements) == 0 && count == 1 {
+		element, err := emptyValue(typ.ElementType)
+
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+
+		elements = append(elements, element)
+	}
+
+	return tftypes.NewValue(typ, elements), nil
+}
+
+// mapValue returns the map with elements keyed by map key under the prefix.
+func mapValue(flatmap map[string]string, prefix string, typ tftypes.Map) (tftypes.Value, error) {
+	count, known, err := elementCount(flatmap, prefix, "%")
+
+	if err != nil || count < 0 || !known {
+		return nullOrUnknown(typ, count, known), err
+	}
+
+	elements := make(map[string]tftypes.Value, count)
+
+	// The flatmap format cannot distinguish between map keys containing
+	// periods and nested values, so map elements of collection and object
+	// types are keyed by the map key before the first period, such as
+	// "map.key.#" and "map.key.0" of a map of lists.
+	var elementKeys []string
+
+	switch typ.ElementType.(type) {
+	case tftypes.List, tftypes.Map, tftypes.Object, tftypes.Set, tftypes.Tuple:
+		elementKeys = subKeys(flatmap, prefix, "%")
+	default:
+		for key := range flatmap {
+			if strings.HasPrefix(key, prefix) && key != prefix+"%" {
+				elementKeys = append(elementKeys, strings.TrimPrefix(key, prefix))
+			}
+		}
+	}
+
+	for _, elementKey := range elementKeys {
+		element, err := value(flatmap, prefix+elementKey, typ.ElementType)
+
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+
+		elements[elementKey] = element
+	}
+
+	return tftypes.NewValue(typ, elements), nil
+}
+
+// elementCount returns the number of elements in the collection under the
+// prefix from the count key, such as "#" or "%". The count is -1 if the
+// collection is null and known is false if the collection is unknown.
+func elementCount(flatmap map[string]string, prefix string, countKey string) (int, bool, error) {
+	if flatmap[strings.TrimSuffix(prefix, ".")] == unknownValue {
+		return 0, false, nil
+	}
+
+	rawCount, ok := flatmap[prefix+countKey]
+
+	if !ok {
+		return -1, true, nil
+	}
+
+	if rawCount == unknownValue {
+		return 0, false, nil
+	}
+
+	count, err := strconv.Atoi(rawCount)
+
+	if err != nil {
+		return -1, true, fmt.Errorf("unable to decode flatmap key %q as count: %w", prefix+countKey, err)
+	}
+
+	return count, true, nil
+}
+
+// nullOrUnknown returns the null or unknown value for a collection based on
+// the results of elementCount.
+func nullOrUnknown(typ tftypes.Type, count int, known bool) tftypes.Value {
+	if !known {
+		return tftypes.NewValue(typ, tftypes.UnknownValue)
+	}
+
+	return tftypes.NewValue(typ, nil)
+}
+
+// subKeys returns the sorted, unique first key segments under the prefix,
+// such as set element hashes, excluding the count key.
+func subKeys(flatmap map[string]string, prefix string, countKey string) []string {
+	seen := make(map[string]struct{})
+
+	for key := range flatmap {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+
+		subKey := strings.TrimPrefix(key, prefix)
+
+		if subKey == countKey {
+			continue
+		}
+
+		if index := strings.IndexByte(subKey, '.'); index != -1 {
+			subKey = subKey[:index]
+		}
+
+		seen[subKey] = struct{}{}
+	}
+
+	result := make([]string, 0, len(seen))
+
+	for subKey := range seen {
+		result = append(result, subKey)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+// emptyValue returns an empty collection, an object with null attributes, or
+// a null primitive of the given type.
+func emptyValue(typ tftypes.Type) (tftypes.Value, error) {
+	switch t := typ.(type) {
+	case tftypes.List, tftypes.Set:
+		return tftypes.NewValue(typ, []tftypes.Value{}), nil
+	case tftypes.Map:
+		return tftypes.NewValue(typ, map[string]tftypes.Value{}), nil
+	case tftypes.Object:
+		return objectValue(map[string]string{}, "", t)
+	default:
+		return tftypes.NewValue(typ, nil), nil
+	}
+}
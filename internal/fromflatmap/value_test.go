@@ -0,0 +1,293 @@
+package fromflatmap_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromflatmap"
+)
+
+func TestValue(t *testing.T) {
+	t.Parallel()
+
+	blockType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name": tftypes.String,
+			"port": tftypes.Number,
+		},
+	}
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"bool":   tftypes.Bool,
+			"id":     tftypes.String,
+			"list":   tftypes.List{ElementType: tftypes.String},
+			"map":    tftypes.Map{ElementType: tftypes.String},
+			"number": tftypes.Number,
+			"block":  tftypes.List{ElementType: blockType},
+			"set":    tftypes.Set{ElementType: blockType},
+		},
+	}
+
+	testCases := map[string]struct {
+		flatmap       map[string]string
+		typ           tftypes.Type
+		expected      tftypes.Value
+		expectedError string
+	}{
+		"all": {
+			flatmap: map[string]string{
+				"bool":              "true",
+				"id":                "test-id",
+				"list.#":            "2",
+				"list.0":            "a",
+				"list.1":            "b",
+				"map.%":             "1",
+				"map.key.with.dots": "value",
+				"number":            "1.5",
+				"block.#":           "1",
+				"block.0.name":      "test-block",
+				"block.0.port":      "80",
+				"set.#":             "2",
+				"set.1234.name":     "first",
+				"set.1234.port":     "443",
+				"set.5678.name":     "second",
+				"unexpected":        "ignored",
+			},
+			typ: testType,
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"bool": tftypes.NewValue(tftypes.Bool, true),
+				"id":   tftypes.NewValue(tftypes.String, "test-id"),
+				"list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "a"),
+					tftypes.NewValue(tftypes.String, "b"),
+				}),
+				"map": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+					"key.with.dots": tftypes.NewValue(tftypes.String, "value"),
+				}),
+				"number": tftypes.NewValue(tftypes.Number, big.NewFloat(1.5)),
+				"block": tftypes.NewValue(tftypes.List{ElementType: blockType}, []tftypes.Value{
+					tftypes.NewValue(blockType, map[string]tftypes.Value{
+						"name": tftypes.NewValue(tftypes.String, "test-block"),
+						"port": tftypes.NewValue(tftypes.Number, big.NewFloat(80)),
+					}),
+				}),
+				"set": tftypes.NewValue(tftypes.Set{ElementType: blockType}, []tftypes.Value{
+					tftypes.NewValue(blockType, map[string]tftypes.Value{
+						"name": tftypes.NewValue(tftypes.String, "first"),
+						"port": tftypes.NewValue(tftypes.Number, big.NewFloat(443)),
+					}),
+					tftypes.NewValue(blockType, map[string]tftypes.Value{
+						"name": tftypes.NewValue(tftypes.String, "second"),
+						"port": tftypes.NewValue(tftypes.Number, nil),
+					}),
+				}),
+			}),
+		},
+		"null-and-empty": {
+			flatmap: map[string]string{
+				"bool":    "0",
+				"list.#":  "0",
+				"map.%":   "0",
+				"block.#": "0",
+			},
+			typ: testType,
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"bool":   tftypes.NewValue(tftypes.Bool, false),
+				"id":     tftypes.NewValue(tftypes.String, nil),
+				"list":   tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{}),
+				"map":    tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{}),
+				"number": tftypes.NewValue(tftypes.Number, nil),
+				"block":  tftypes.NewValue(tftypes.List{ElementType: blockType}, []tftypes.Value{}),
+				"set":    tftypes.NewValue(tftypes.Set{ElementType: blockType}, nil),
+			}),
+		},
+		"unknown": {
+			flatmap: map[string]string{
+				"id":     "74D93920-ED26-11E3-AC10-0800200C9A66",
+				"list.#": "74D93920-ED26-11E3-AC10-0800200C9A66",
+				"map":    "74D93920-ED26-11E3-AC10-0800200C9A66",
+			},
+			typ: testType,
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"bool":   tftypes.NewValue(tftypes.Bool, nil),
+				"id":     tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"list":   tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tftypes.UnknownValue),
+				"map":    tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, tftypes.UnknownValue),
+				"number": tftypes.NewValue(tftypes.Number, nil),
+				"block":  tftypes.NewValue(tftypes.List{ElementType: blockType}, nil),
+				"set":    tftypes.NewValue(tftypes.Set{ElementType: blockType}, nil),
+			}),
+		},
+		"set-element-without-keys": {
+			flatmap: map[string]string{
+				"set.#": "1",
+			},
+			typ: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"set": tftypes.Set{ElementType: blockType},
+				},
+			},
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"set": tftypes.Set{ElementType: blockType},
+				},
+			}, map[string]tftypes.Value{
+				"set": tftypes.NewValue(tftypes.Set{ElementType: blockType}, []tftypes.Value{
+					tftypes.NewValue(blockType, map[string]tftypes.Value{
+						"name": tftypes.NewValue(tftypes.String, nil),
+						"port": tftypes.NewValue(tftypes.Number, nil),
+					}),
+				}),
+			}),
+		},
+		"map-of-collections": {
+			flatmap: map[string]string{
+				"lists.%":        "2",
+				"lists.a.#":      "2",
+				"lists.a.0":      "one",
+				"lists.a.1":      "two",
+				"lists.b":        "74D93920-ED26-11E3-AC10-0800200C9A66",
+				"maps.%":         "1",
+				"maps.a.%":       "2",
+				"maps.a.x":       "1",
+				"maps.a.y":       "2",
+				"sets.%":         "1",
+				"sets.a.#":       "1",
+				"sets.a.1234":    "one",
+				"objects.%":      "1",
+				"objects.a.name": "test",
+			},
+			typ: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"lists":   tftypes.Map{ElementType: tftypes.List{ElementType: tftypes.String}},
+					"maps":    tftypes.Map{ElementType: tftypes.Map{ElementType: tftypes.Number}},
+					"sets":    tftypes.Map{ElementType: tftypes.Set{ElementType: tftypes.String}},
+					"objects": tftypes.Map{ElementType: blockType},
+				},
+			},
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"lists":   tftypes.Map{ElementType: tftypes.List{ElementType: tftypes.String}},
+					"maps":    tftypes.Map{ElementType: tftypes.Map{ElementType: tftypes.Number}},
+					"sets":    tftypes.Map{ElementType: tftypes.Set{ElementType: tftypes.String}},
+					"objects": tftypes.Map{ElementType: blockType},
+				},
+			}, map[string]tftypes.Value{
+				"lists": tftypes.NewValue(tftypes.Map{ElementType: tftypes.List{ElementType: tftypes.String}}, map[string]tftypes.Value{
+					"a": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+						tftypes.NewValue(tftypes.String, "one"),
+						tftypes.NewValue(tftypes.String, "two"),
+					}),
+					"b": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tftypes.UnknownValue),
+				}),
+				"maps": tftypes.NewValue(tftypes.Map{ElementType: tftypes.Map{ElementType: tftypes.Number}}, map[string]tftypes.Value{
+					"a": tftypes.NewValue(tftypes.Map{ElementType: tftypes.Number}, map[string]tftypes.Value{
+						"x": tftypes.NewValue(tftypes.Number, big.NewFloat(1)),
+						"y": tftypes.NewValue(tftypes.Number, big.NewFloat(2)),
+					}),
+				}),
+				"sets": tftypes.NewValue(tftypes.Map{ElementType: tftypes.Set{ElementType: tftypes.String}}, map[string]tftypes.Value{
+					"a": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+						tftypes.NewValue(tftypes.String, "one"),
+					}),
+				}),
+				"objects": tftypes.NewValue(tftypes.Map{ElementType: blockType}, map[string]tftypes.Value{
+					"a": tftypes.NewValue(blockType, map[string]tftypes.Value{
+						"name": tftypes.NewValue(tftypes.String, "test"),
+						"port": tftypes.NewValue(tftypes.Number, nil),
+					}),
+				}),
+			}),
+		},
+		"set-hash-keys": {
+			flatmap: map[string]string{
+				"strings.#":      "2",
+				"strings.1234":   "a",
+				"strings.~5678":  "74D93920-ED26-11E3-AC10-0800200C9A66",
+				"set.#":          "2",
+				"set.1234.name":  "first",
+				"set.~5678.name": "second",
+				"set.~5678.port": "74D93920-ED26-11E3-AC10-0800200C9A66",
+			},
+			typ: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"strings": tftypes.Set{ElementType: tftypes.String},
+					"set":     tftypes.Set{ElementType: blockType},
+				},
+			},
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"strings": tftypes.Set{ElementType: tftypes.String},
+					"set":     tftypes.Set{ElementType: blockType},
+				},
+			}, map[string]tftypes.Value{
+				"strings": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "a"),
+					tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				}),
+				"set": tftypes.NewValue(tftypes.Set{ElementType: blockType}, []tftypes.Value{
+					tftypes.NewValue(blockType, map[string]tftypes.Value{
+						"name": tftypes.NewValue(tftypes.String, "first"),
+						"port": tftypes.NewValue(tftypes.Number, nil),
+					}),
+					tftypes.NewValue(blockType, map[string]tftypes.Value{
+						"name": tftypes.NewValue(tftypes.String, "second"),
+						"port": tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+					}),
+				}),
+			}),
+		},
+		"invalid-bool": {
+			flatmap: map[string]string{
+				"bool": "yes",
+			},
+			typ:           testType,
+			expectedError: `unable to decode flatmap key "bool" as bool: invalid value "yes"`,
+		},
+		"invalid-count": {
+			flatmap: map[string]string{
+				"list.#": "two",
+			},
+			typ:           testType,
+			expectedError: `unable to decode flatmap key "list.#" as count: strconv.Atoi: parsing "two": invalid syntax`,
+		},
+		"invalid-type": {
+			flatmap:       map[string]string{},
+			typ:           tftypes.String,
+			expectedError: "unable to decode flatmap: expected object type, got tftypes.String",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := fromflatmap.Value(testCase.flatmap, testCase.typ)
+
+			if err != nil {
+				if testCase.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if diff := cmp.Diff(err.Error(), testCase.expectedError); diff != "" {
+					t.Fatalf("unexpected error difference: %s", diff)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("expected error: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
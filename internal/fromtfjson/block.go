@@ -0,0 +1,91 @@
+package fromtfjson
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/tfjson"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// Block returns the schema.Block equivalent of a *tfjson.SchemaBlockType.
+// Errors will be tftypes.AttributePathErrors based on `path`.
+func Block(path *tftypes.AttributePath, b *tfjson.SchemaBlockType) (schema.Block, error) {
+	if b == nil {
+		return nil, path.NewErrorf("missing block")
+	}
+
+	block := b.Block
+
+	if block == nil {
+		block = &tfjson.SchemaBlock{}
+	}
+
+	attributes, err := schemaAttributes(path, block.Attributes)
+
+	if err != nil {
+		return nil, err
+	}
+
+	blocks, err := blocks(path, block.NestedBlocks)
+
+	if err != nil {
+		return nil, err
+	}
+
+	description, markdownDescription := descriptions(block.Description, block.DescriptionKind)
+	deprecationMessage := deprecation(block.Deprecated)
+
+	switch b.NestingMode {
+	case tfjson.SchemaNestingModeList:
+		return schema.ListNestedBlock{
+			NestedObject: schema.NestedBlockObject{
+				Attributes: attributes,
+				Blocks:     blocks,
+			},
+			Description:         description,
+			MarkdownDescription: markdownDescription,
+			DeprecationMessage:  deprecationMessage,
+		}, nil
+	case tfjson.SchemaNestingModeSet:
+		return schema.SetNestedBlock{
+			NestedObject: schema.NestedBlockObject{
+				Attributes: attributes,
+				Blocks:     blocks,
+			},
+			Description:         description,
+			MarkdownDescription: markdownDescription,
+			DeprecationMessage:  deprecationMessage,
+		}, nil
+	case tfjson.SchemaNestingModeSingle:
+		return schema.SingleNestedBlock{
+			Attributes:          attributes,
+			Blocks:              blocks,
+			Description:         description,
+			MarkdownDescription: markdownDescription,
+			DeprecationMessage:  deprecationMessage,
+		}, nil
+	default:
+		return nil, path.NewErrorf("unrecognized nesting mode %q", b.NestingMode)
+	}
+}
+
+// blocks returns the schema.Block equivalents of the given blocks.
+func blocks(path *tftypes.AttributePath, nestedBlocks map[string]*tfjson.SchemaBlockType) (map[string]schema.Block, error) {
+	if len(nestedBlocks) == 0 {
+		return nil, nil
+	}
+
+	result := make(map[string]schema.Block, len(nestedBlocks))
+
+	for name, b := range nestedBlocks {
+		block, err := Block(path.WithAttributeName(name), b)
+
+		if err != nil {
+			return nil, err
+		}
+
+		result[name] = block
+	}
+
+	return result, nil
+}
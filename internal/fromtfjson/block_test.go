@@ -0,0 +1,122 @@
+package fromtfjson_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtfjson"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tfjson"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func TestBlock(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		block       *tfjson.SchemaBlockType
+		expected    schema.Block
+		expectedErr string
+	}{
+		"nil": {
+			expectedErr: `AttributeName("test"): missing block`,
+		},
+		"list": {
+			block: &tfjson.SchemaBlockType{
+				Block: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"string": {
+							AttributeType: &tfjson.Type{Type: tftypes.String},
+							Optional:      true,
+						},
+					},
+					Description:     "Example description.",
+					DescriptionKind: tfjson.SchemaDescriptionKindPlain,
+				},
+				NestingMode: tfjson.SchemaNestingModeList,
+			},
+			expected: schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"string": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+				Description: "Example description.",
+			},
+		},
+		"set-nested-single": {
+			block: &tfjson.SchemaBlockType{
+				Block: &tfjson.SchemaBlock{
+					NestedBlocks: map[string]*tfjson.SchemaBlockType{
+						"single": {
+							Block: &tfjson.SchemaBlock{
+								Deprecated: true,
+							},
+							NestingMode: tfjson.SchemaNestingModeSingle,
+						},
+					},
+				},
+				NestingMode: tfjson.SchemaNestingModeSet,
+			},
+			expected: schema.SetNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"single": schema.SingleNestedBlock{
+							DeprecationMessage: fromtfjson.DeprecationMessage,
+						},
+					},
+				},
+			},
+		},
+		"nested-error": {
+			block: &tfjson.SchemaBlockType{
+				Block: &tfjson.SchemaBlock{
+					NestedBlocks: map[string]*tfjson.SchemaBlockType{
+						"nested": nil,
+					},
+				},
+				NestingMode: tfjson.SchemaNestingModeList,
+			},
+			expectedErr: `AttributeName("test").AttributeName("nested"): missing block`,
+		},
+		"unrecognized-nesting-mode": {
+			block: &tfjson.SchemaBlockType{
+				NestingMode: tfjson.SchemaNestingModeGroup,
+			},
+			expectedErr: `AttributeName("test"): unrecognized nesting mode "group"`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := fromtfjson.Block(tftypes.NewAttributePath().WithAttributeName("test"), testCase.block)
+
+			if err != nil {
+				if testCase.expectedErr == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if diff := cmp.Diff(err.Error(), testCase.expectedErr); diff != "" {
+					t.Fatalf("unexpected error difference: %s", diff)
+				}
+
+				return
+			}
+
+			if testCase.expectedErr != "" {
+				t.Fatalf("expected error: %s", testCase.expectedErr)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,10 @@
+// Package fromtfjson contains functions to convert from the Terraform CLI
+// machine-readable JSON types in the internal/tfjson package to framework
+// types.
+//
+// Schemas are converted into resource/schema types, regardless of whether
+// they originally described a provider, resource, or data source, since that
+// package supports every schema concept of the JSON format. The resulting
+// schemas are intended for inspection, such as comparison, rather than for
+// serving a provider.
+package fromtfjson
@@ -0,0 +1,67 @@
+package fromtfjson
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/tfjson"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// DeprecationMessage is the deprecation message of converted schemas,
+// attributes, and blocks, since the JSON format only records whether
+// something is deprecated.
+const DeprecationMessage = "Deprecated."
+
+// Schema returns the schema.Schema equivalent of a *tfjson.Schema. A nil
+// schema returns an empty schema.
+func Schema(s *tfjson.Schema) (schema.Schema, error) {
+	if s == nil {
+		return schema.Schema{}, nil
+	}
+
+	result := schema.Schema{
+		Version: s.Version,
+	}
+
+	if s.Block == nil {
+		return result, nil
+	}
+
+	attributes, err := schemaAttributes(tftypes.NewAttributePath(), s.Block.Attributes)
+
+	if err != nil {
+		return result, err
+	}
+
+	blocks, err := blocks(tftypes.NewAttributePath(), s.Block.NestedBlocks)
+
+	if err != nil {
+		return result, err
+	}
+
+	result.Attributes = attributes
+	result.Blocks = blocks
+	result.Description, result.MarkdownDescription = descriptions(s.Block.Description, s.Block.DescriptionKind)
+	result.DeprecationMessage = deprecation(s.Block.Deprecated)
+
+	return result, nil
+}
+
+// descriptions returns the plaintext and Markdown descriptions based on the
+// description kind.
+func descriptions(description string, kind tfjson.SchemaDescriptionKind) (string, string) {
+	if kind == tfjson.SchemaDescriptionKindMarkdown {
+		return "", description
+	}
+
+	return description, ""
+}
+
+// deprecation returns DeprecationMessage if deprecated.
+func deprecation(deprecated bool) string {
+	if !deprecated {
+		return ""
+	}
+
+	return DeprecationMessage
+}
@@ -0,0 +1,190 @@
+package fromtfjson
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/tfjson"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SchemaAttribute returns the schema.Attribute equivalent of a
+// *tfjson.SchemaAttribute. Errors will be tftypes.AttributePathErrors based on
+// `path`.
+func SchemaAttribute(path *tftypes.AttributePath, a *tfjson.SchemaAttribute) (schema.Attribute, error) {
+	if a == nil {
+		return nil, path.NewErrorf("missing attribute")
+	}
+
+	description, markdownDescription := descriptions(a.Description, a.DescriptionKind)
+	deprecationMessage := deprecation(a.Deprecated)
+
+	if a.AttributeNestedType != nil {
+		attributes, err := schemaAttributes(path, a.AttributeNestedType.Attributes)
+
+		if err != nil {
+			return nil, err
+		}
+
+		switch a.AttributeNestedType.NestingMode {
+		case tfjson.SchemaNestingModeList:
+			return schema.ListNestedAttribute{
+				NestedObject:        schema.NestedAttributeObject{Attributes: attributes},
+				Required:            a.Required,
+				Optional:            a.Optional,
+				Computed:            a.Computed,
+				Sensitive:           a.Sensitive,
+				Description:         description,
+				MarkdownDescription: markdownDescription,
+				DeprecationMessage:  deprecationMessage,
+			}, nil
+		case tfjson.SchemaNestingModeMap:
+			return schema.MapNestedAttribute{
+				NestedObject:        schema.NestedAttributeObject{Attributes: attributes},
+				Required:            a.Required,
+				Optional:            a.Optional,
+				Computed:            a.Computed,
+				Sensitive:           a.Sensitive,
+				Description:         description,
+				MarkdownDescription: markdownDescription,
+				DeprecationMessage:  deprecationMessage,
+			}, nil
+		case tfjson.SchemaNestingModeSet:
+			return schema.SetNestedAttribute{
+				NestedObject:        schema.NestedAttributeObject{Attributes: attributes},
+				Required:            a.Required,
+				Optional:            a.Optional,
+				Computed:            a.Computed,
+				Sensitive:           a.Sensitive,
+				Description:         description,
+				MarkdownDescription: markdownDescription,
+				DeprecationMessage:  deprecationMessage,
+			}, nil
+		case tfjson.SchemaNestingModeSingle:
+			return schema.SingleNestedAttribute{
+				Attributes:          attributes,
+				Required:            a.Required,
+				Optional:            a.Optional,
+				Computed:            a.Computed,
+				Sensitive:           a.Sensitive,
+				Description:         description,
+				MarkdownDescription: markdownDescription,
+				DeprecationMessage:  deprecationMessage,
+			}, nil
+		default:
+			return nil, path.NewErrorf("unrecognized nesting mode %q", a.AttributeNestedType.NestingMode)
+		}
+	}
+
+	if a.AttributeType == nil {
+		return nil, path.NewErrorf("missing type")
+	}
+
+	attrType, err := Type(a.AttributeType.Type)
+
+	if err != nil {
+		return nil, path.NewError(err)
+	}
+
+	switch t := attrType.(type) {
+	case types.ListType:
+		return schema.ListAttribute{
+			ElementType:         t.ElemType,
+			Required:            a.Required,
+			Optional:            a.Optional,
+			Computed:            a.Computed,
+			Sensitive:           a.Sensitive,
+			Description:         description,
+			MarkdownDescription: markdownDescription,
+			DeprecationMessage:  deprecationMessage,
+		}, nil
+	case types.MapType:
+		return schema.MapAttribute{
+			ElementType:         t.ElemType,
+			Required:            a.Required,
+			Optional:            a.Optional,
+			Computed:            a.Computed,
+			Sensitive:           a.Sensitive,
+			Description:         description,
+			MarkdownDescription: markdownDescription,
+			DeprecationMessage:  deprecationMessage,
+		}, nil
+	case types.ObjectType:
+		return schema.ObjectAttribute{
+			AttributeTypes:      t.AttrTypes,
+			Required:            a.Required,
+			Optional:            a.Optional,
+			Computed:            a.Computed,
+			Sensitive:           a.Sensitive,
+			Description:         description,
+			MarkdownDescription: markdownDescription,
+			DeprecationMessage:  deprecationMessage,
+		}, nil
+	case types.SetType:
+		return schema.SetAttribute{
+			ElementType:         t.ElemType,
+			Required:            a.Required,
+			Optional:            a.Optional,
+			Computed:            a.Computed,
+			Sensitive:           a.Sensitive,
+			Description:         description,
+			MarkdownDescription: markdownDescription,
+			DeprecationMessage:  deprecationMessage,
+		}, nil
+	}
+
+	switch attrType {
+	case types.BoolType:
+		return schema.BoolAttribute{
+			Required:            a.Required,
+			Optional:            a.Optional,
+			Computed:            a.Computed,
+			Sensitive:           a.Sensitive,
+			Description:         description,
+			MarkdownDescription: markdownDescription,
+			DeprecationMessage:  deprecationMessage,
+		}, nil
+	case types.NumberType:
+		return schema.NumberAttribute{
+			Required:            a.Required,
+			Optional:            a.Optional,
+			Computed:            a.Computed,
+			Sensitive:           a.Sensitive,
+			Description:         description,
+			MarkdownDescription: markdownDescription,
+			DeprecationMessage:  deprecationMessage,
+		}, nil
+	default:
+		return schema.StringAttribute{
+			Required:            a.Required,
+			Optional:            a.Optional,
+			Computed:            a.Computed,
+			Sensitive:           a.Sensitive,
+			Description:         description,
+			MarkdownDescription: markdownDescription,
+			DeprecationMessage:  deprecationMessage,
+		}, nil
+	}
+}
+
+// schemaAttributes returns the schema.Attribute equivalents of the given
+// attributes.
+func schemaAttributes(path *tftypes.AttributePath, attributes map[string]*tfjson.SchemaAttribute) (map[string]schema.Attribute, error) {
+	if len(attributes) == 0 {
+		return nil, nil
+	}
+
+	result := make(map[string]schema.Attribute, len(attributes))
+
+	for name, a := range attributes {
+		attribute, err := SchemaAttribute(path.WithAttributeName(name), a)
+
+		if err != nil {
+			return nil, err
+		}
+
+		result[name] = attribute
+	}
+
+	return result, nil
+}
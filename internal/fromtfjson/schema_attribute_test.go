@@ -0,0 +1,232 @@
+package fromtfjson_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtfjson"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tfjson"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSchemaAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute   *tfjson.SchemaAttribute
+		expected    schema.Attribute
+		expectedErr string
+	}{
+		"nil": {
+			expectedErr: `AttributeName("test"): missing attribute`,
+		},
+		"missing-type": {
+			attribute:   &tfjson.SchemaAttribute{Optional: true},
+			expectedErr: `AttributeName("test"): missing type`,
+		},
+		"bool": {
+			attribute: &tfjson.SchemaAttribute{
+				AttributeType:   &tfjson.Type{Type: tftypes.Bool},
+				Computed:        true,
+				Description:     "Example description.",
+				DescriptionKind: tfjson.SchemaDescriptionKindPlain,
+			},
+			expected: schema.BoolAttribute{
+				Computed:    true,
+				Description: "Example description.",
+			},
+		},
+		"number": {
+			attribute: &tfjson.SchemaAttribute{
+				AttributeType: &tfjson.Type{Type: tftypes.Number},
+				Optional:      true,
+				Sensitive:     true,
+			},
+			expected: schema.NumberAttribute{
+				Optional:  true,
+				Sensitive: true,
+			},
+		},
+		"string": {
+			attribute: &tfjson.SchemaAttribute{
+				AttributeType: &tfjson.Type{Type: tftypes.String},
+				Deprecated:    true,
+				Required:      true,
+			},
+			expected: schema.StringAttribute{
+				DeprecationMessage: fromtfjson.DeprecationMessage,
+				Required:           true,
+			},
+		},
+		"list": {
+			attribute: &tfjson.SchemaAttribute{
+				AttributeType: &tfjson.Type{Type: tftypes.List{ElementType: tftypes.String}},
+				Optional:      true,
+			},
+			expected: schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+		"map": {
+			attribute: &tfjson.SchemaAttribute{
+				AttributeType: &tfjson.Type{Type: tftypes.Map{ElementType: tftypes.String}},
+				Optional:      true,
+			},
+			expected: schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+		"object": {
+			attribute: &tfjson.SchemaAttribute{
+				AttributeType: &tfjson.Type{Type: tftypes.Object{AttributeTypes: map[string]tftypes.Type{"string": tftypes.String}}},
+				Optional:      true,
+			},
+			expected: schema.ObjectAttribute{
+				AttributeTypes: map[string]attr.Type{"string": types.StringType},
+				Optional:       true,
+			},
+		},
+		"set": {
+			attribute: &tfjson.SchemaAttribute{
+				AttributeType: &tfjson.Type{Type: tftypes.Set{ElementType: tftypes.String}},
+				Optional:      true,
+			},
+			expected: schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+		"unsupported-type": {
+			attribute: &tfjson.SchemaAttribute{
+				AttributeType: &tfjson.Type{Type: tftypes.DynamicPseudoType},
+				Optional:      true,
+			},
+			expectedErr: `AttributeName("test"): unsupported type tftypes.DynamicPseudoType`,
+		},
+		"list-nested": {
+			attribute: &tfjson.SchemaAttribute{
+				AttributeNestedType: &tfjson.SchemaNestedAttributeType{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"string": {
+							AttributeType: &tfjson.Type{Type: tftypes.String},
+							Computed:      true,
+						},
+					},
+					NestingMode: tfjson.SchemaNestingModeList,
+				},
+				Optional: true,
+			},
+			expected: schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"string": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+				Optional: true,
+			},
+		},
+		"map-nested": {
+			attribute: &tfjson.SchemaAttribute{
+				AttributeNestedType: &tfjson.SchemaNestedAttributeType{
+					NestingMode: tfjson.SchemaNestingModeMap,
+				},
+				Required: true,
+			},
+			expected: schema.MapNestedAttribute{
+				Required: true,
+			},
+		},
+		"set-nested": {
+			attribute: &tfjson.SchemaAttribute{
+				AttributeNestedType: &tfjson.SchemaNestedAttributeType{
+					NestingMode: tfjson.SchemaNestingModeSet,
+				},
+				Computed: true,
+			},
+			expected: schema.SetNestedAttribute{
+				Computed: true,
+			},
+		},
+		"single-nested": {
+			attribute: &tfjson.SchemaAttribute{
+				AttributeNestedType: &tfjson.SchemaNestedAttributeType{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"string": {
+							AttributeType: &tfjson.Type{Type: tftypes.String},
+							Optional:      true,
+						},
+					},
+					NestingMode: tfjson.SchemaNestingModeSingle,
+				},
+				Optional: true,
+			},
+			expected: schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"string": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+				Optional: true,
+			},
+		},
+		"nested-error": {
+			attribute: &tfjson.SchemaAttribute{
+				AttributeNestedType: &tfjson.SchemaNestedAttributeType{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"string": {},
+					},
+					NestingMode: tfjson.SchemaNestingModeSingle,
+				},
+				Optional: true,
+			},
+			expectedErr: `AttributeName("test").AttributeName("string"): missing type`,
+		},
+		"unrecognized-nesting-mode": {
+			attribute: &tfjson.SchemaAttribute{
+				AttributeNestedType: &tfjson.SchemaNestedAttributeType{
+					NestingMode: tfjson.SchemaNestingModeGroup,
+				},
+				Optional: true,
+			},
+			expectedErr: `AttributeName("test"): unrecognized nesting mode "group"`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := fromtfjson.SchemaAttribute(tftypes.NewAttributePath().WithAttributeName("test"), testCase.attribute)
+
+			if err != nil {
+				if testCase.expectedErr == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if diff := cmp.Diff(err.Error(), testCase.expectedErr); diff != "" {
+					t.Fatalf("unexpected error difference: %s", diff)
+				}
+
+				return
+			}
+
+			if testCase.expectedErr != "" {
+				t.Fatalf("expected error: %s", testCase.expectedErr)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
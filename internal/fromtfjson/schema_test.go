@@ -0,0 +1,120 @@
+package fromtfjson_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtfjson"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tfjson"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func TestSchema(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema      *tfjson.Schema
+		expected    schema.Schema
+		expectedErr string
+	}{
+		"nil": {
+			expected: schema.Schema{},
+		},
+		"missing-block": {
+			schema: &tfjson.Schema{
+				Version: 2,
+			},
+			expected: schema.Schema{
+				Version: 2,
+			},
+		},
+		"attributes-blocks": {
+			schema: &tfjson.Schema{
+				Version: 1,
+				Block: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"string": {
+							AttributeType: &tfjson.Type{Type: tftypes.String},
+							Required:      true,
+						},
+					},
+					NestedBlocks: map[string]*tfjson.SchemaBlockType{
+						"list": {
+							NestingMode: tfjson.SchemaNestingModeList,
+						},
+					},
+					Deprecated:      true,
+					Description:     "**Example**",
+					DescriptionKind: tfjson.SchemaDescriptionKindMarkdown,
+				},
+			},
+			expected: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"string": schema.StringAttribute{
+						Required: true,
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"list": schema.ListNestedBlock{},
+				},
+				DeprecationMessage:  fromtfjson.DeprecationMessage,
+				MarkdownDescription: "**Example**",
+				Version:             1,
+			},
+		},
+		"attribute-error": {
+			schema: &tfjson.Schema{
+				Block: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"test": {},
+					},
+				},
+			},
+			expectedErr: `AttributeName("test"): missing type`,
+		},
+		"block-error": {
+			schema: &tfjson.Schema{
+				Block: &tfjson.SchemaBlock{
+					NestedBlocks: map[string]*tfjson.SchemaBlockType{
+						"test": {
+							NestingMode: tfjson.SchemaNestingModeMap,
+						},
+					},
+				},
+			},
+			expectedErr: `AttributeName("test"): unrecognized nesting mode "map"`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := fromtfjson.Schema(testCase.schema)
+
+			if err != nil {
+				if testCase.expectedErr == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if diff := cmp.Diff(err.Error(), testCase.expectedErr); diff != "" {
+					t.Fatalf("unexpected error difference: %s", diff)
+				}
+
+				return
+			}
+
+			if testCase.expectedErr != "" {
+				t.Fatalf("expected error: %s", testCase.expectedErr)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package fromtfjson
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Type returns the framework type equivalent of a Terraform type.
+func Type(typ tftypes.Type) (attr.Type, error) {
+	switch t := typ.(type) {
+	case tftypes.List:
+		elemType, err := Type(t.ElementType)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return types.ListType{ElemType: elemType}, nil
+	case tftypes.Map:
+		elemType, err := Type(t.ElementType)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return types.MapType{ElemType: elemType}, nil
+	case tftypes.Object:
+		if len(t.OptionalAttributes) > 0 {
+			return nil, fmt.Errorf("unsupported object type with optional attributes %s", typ)
+		}
+
+		attrTypes := make(map[string]attr.Type, len(t.AttributeTypes))
+
+		for name, attrType := range t.AttributeTypes {
+			fwType, err := Type(attrType)
+
+			if err != nil {
+				return nil, err
+			}
+
+			attrTypes[name] = fwType
+		}
+
+		return types.ObjectType{AttrTypes: attrTypes}, nil
+	case tftypes.Set:
+		elemType, err := Type(t.ElementType)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return types.SetType{ElemType: elemType}, nil
+	}
+
+	switch {
+	case typ == nil:
+		return nil, fmt.Errorf("missing type")
+	case typ.Is(tftypes.Bool):
+		return types.BoolType, nil
+	case typ.Is(tftypes.Number):
+		return types.NumberType, nil
+	case typ.Is(tftypes.String):
+		return types.StringType, nil
+	}
+
+	return nil, fmt.Errorf("unsupported type %s", typ)
+}
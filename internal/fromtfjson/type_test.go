@@ -0,0 +1,111 @@
+package fromtfjson_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtfjson"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ         tftypes.Type
+		expected    attr.Type
+		expectedErr string
+	}{
+		"nil": {
+			expectedErr: "missing type",
+		},
+		"bool": {
+			typ:      tftypes.Bool,
+			expected: types.BoolType,
+		},
+		"number": {
+			typ:      tftypes.Number,
+			expected: types.NumberType,
+		},
+		"string": {
+			typ:      tftypes.String,
+			expected: types.StringType,
+		},
+		"list-map-set": {
+			typ: tftypes.List{
+				ElementType: tftypes.Map{
+					ElementType: tftypes.Set{
+						ElementType: tftypes.String,
+					},
+				},
+			},
+			expected: types.ListType{
+				ElemType: types.MapType{
+					ElemType: types.SetType{
+						ElemType: types.StringType,
+					},
+				},
+			},
+		},
+		"object": {
+			typ: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"bool": tftypes.Bool,
+				},
+			},
+			expected: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"bool": types.BoolType,
+				},
+			},
+		},
+		"object-optional-attributes": {
+			typ: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"bool": tftypes.Bool,
+				},
+				OptionalAttributes: map[string]struct{}{
+					"bool": {},
+				},
+			},
+			expectedErr: `unsupported object type with optional attributes tftypes.Object["bool":tftypes.Bool?]`,
+		},
+		"dynamic": {
+			typ:         tftypes.DynamicPseudoType,
+			expectedErr: "unsupported type tftypes.DynamicPseudoType",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := fromtfjson.Type(testCase.typ)
+
+			if err != nil {
+				if testCase.expectedErr == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if diff := cmp.Diff(err.Error(), testCase.expectedErr); diff != "" {
+					t.Fatalf("unexpected error difference: %s", diff)
+				}
+
+				return
+			}
+
+			if testCase.expectedErr != "" {
+				t.Fatalf("expected error: %s", testCase.expectedErr)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,3 @@
+// Package fwdiag contains framework internal helpers for working with
+// diagnostics.
+package fwdiag
@@ -0,0 +1,25 @@
+package fwdiag
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// Error returns an error containing the summary and detail of any error
+// diagnostics, for functions which must return an error. It returns nil if
+// there are no error diagnostics.
+func Error(diags diag.Diagnostics) error {
+	var diagMsgs []string
+
+	for _, d := range diags.Errors() {
+		diagMsgs = append(diagMsgs, d.Summary()+": "+d.Detail())
+	}
+
+	if len(diagMsgs) == 0 {
+		return nil
+	}
+
+	return errors.New(strings.Join(diagMsgs, ", "))
+}
@@ -0,0 +1,62 @@
+package fwdiag_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwdiag"
+)
+
+func TestError(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diags    diag.Diagnostics
+		expected string
+	}{
+		"nil": {
+			diags:    nil,
+			expected: "",
+		},
+		"warning": {
+			diags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("Warning Summary", "Warning detail."),
+			},
+			expected: "",
+		},
+		"error": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error Summary", "Error detail."),
+			},
+			expected: "Error Summary: Error detail.",
+		},
+		"errors": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error Summary 1", "Error detail 1."),
+				diag.NewWarningDiagnostic("Warning Summary", "Warning detail."),
+				diag.NewErrorDiagnostic("Error Summary 2", "Error detail 2."),
+			},
+			expected: "Error Summary 1: Error detail 1., Error Summary 2: Error detail 2.",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := fwdiag.Error(testCase.diags)
+
+			var got string
+
+			if err != nil {
+				got = err.Error()
+			}
+
+			if got != testCase.expected {
+				t.Errorf("expected %q, got %q", testCase.expected, got)
+			}
+		})
+	}
+}
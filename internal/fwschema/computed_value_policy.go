@@ -0,0 +1,37 @@
+package fwschema
+
+// ComputedValuePolicy is an enum type of the ways a Computed attribute
+// value, which is null in the configuration, is planned when the resource has
+// changes.
+type ComputedValuePolicy uint8
+
+const (
+	// ComputedValuePolicyUnset is the default policy, which inherits the
+	// policy of the parent nested attribute or the schema. If no policy is
+	// set, the value is planned as ComputedValuePolicyVolatile.
+	ComputedValuePolicyUnset ComputedValuePolicy = 0
+
+	// ComputedValuePolicyVolatile marks the value as unknown in the plan
+	// whenever the resource has changes, so the provider can set a new value
+	// during apply.
+	ComputedValuePolicyVolatile ComputedValuePolicy = 1
+
+	// ComputedValuePolicyPreserve keeps the prior state value in the plan,
+	// if there is one, so the value is only unknown when the resource is
+	// created or the value is new, such as a new nested attribute element.
+	ComputedValuePolicyPreserve ComputedValuePolicy = 2
+)
+
+// String returns a human readable name of the policy.
+func (p ComputedValuePolicy) String() string {
+	switch p {
+	case ComputedValuePolicyUnset:
+		return "unset"
+	case ComputedValuePolicyVolatile:
+		return "volatile"
+	case ComputedValuePolicyPreserve:
+		return "preserve"
+	default:
+		return "invalid"
+	}
+}
@@ -0,0 +1,20 @@
+package fwxschema
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// AttributeWithComputedDependencies is an optional interface on Attribute
+// which enables declaring other attributes whose changes cause a Computed
+// value without configuration to be unknown in the plan. When dependencies
+// are declared, the value keeps its prior state value if no dependency
+// changed, regardless of the computed value policy.
+type AttributeWithComputedDependencies interface {
+	fwschema.Attribute
+
+	// GetComputedDependencies should return the path expressions of the
+	// attributes the Computed value depends on. Relative expressions are
+	// merged with the path of the attribute.
+	GetComputedDependencies() path.Expressions
+}
@@ -0,0 +1,27 @@
+package fwxschema
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+)
+
+// AttributeWithComputedValuePolicy is an optional interface on Attribute
+// which enables control over how Computed values without configuration are
+// planned when the resource has changes.
+type AttributeWithComputedValuePolicy interface {
+	fwschema.Attribute
+
+	// GetComputedValuePolicy should return the policy for the attribute,
+	// including any nested attributes which do not set their own policy.
+	GetComputedValuePolicy() fwschema.ComputedValuePolicy
+}
+
+// SchemaWithComputedValuePolicy is an optional interface on Schema which
+// enables control over how Computed values without configuration are planned
+// when the resource has changes.
+type SchemaWithComputedValuePolicy interface {
+	fwschema.Schema
+
+	// GetComputedValuePolicy should return the policy for all attributes
+	// which do not set their own policy.
+	GetComputedValuePolicy() fwschema.ComputedValuePolicy
+}
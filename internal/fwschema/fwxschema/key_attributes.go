@@ -0,0 +1,27 @@
+package fwxschema
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+)
+
+// AttributeWithKeyAttributes is an optional interface on a set nested
+// Attribute which enables correlating set elements across configuration,
+// plan, and prior state by the values of key attributes.
+type AttributeWithKeyAttributes interface {
+	fwschema.Attribute
+
+	// GetKeyAttributes should return the names of the nested attributes
+	// which identify a set element.
+	GetKeyAttributes() []string
+}
+
+// BlockWithKeyAttributes is an optional interface on a set nested Block
+// which enables correlating set elements across configuration, plan, and
+// prior state by the values of key attributes.
+type BlockWithKeyAttributes interface {
+	fwschema.Block
+
+	// GetKeyAttributes should return the names of the nested attributes
+	// which identify a set element.
+	GetKeyAttributes() []string
+}
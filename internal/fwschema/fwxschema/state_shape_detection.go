@@ -0,0 +1,16 @@
+package fwxschema
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+)
+
+// SchemaWithStateShapeDetection is an optional interface on Schema which
+// enables the framework to save a hash of the state-affecting parts of the
+// schema in resource private state and warn when they change without a
+// schema version increment.
+type SchemaWithStateShapeDetection interface {
+	fwschema.Schema
+
+	// GetDetectStateShapeChanges should return true to enable detection.
+	GetDetectStateShapeChanges() bool
+}
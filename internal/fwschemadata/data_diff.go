@@ -0,0 +1,33 @@
+package fwschemadata
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Diff returns the differences between the Data and the given Data, with
+// paths from the schema root. Refer to basetypes.Diff for details.
+func (d Data) Diff(ctx context.Context, after Data) ([]basetypes.ValueDiff, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	beforeValue, beforeDiags := d.ValueAtPath(ctx, path.Empty())
+
+	diags.Append(beforeDiags...)
+
+	afterValue, afterDiags := after.ValueAtPath(ctx, path.Empty())
+
+	diags.Append(afterDiags...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	diffs, diffDiags := basetypes.Diff(ctx, beforeValue, afterValue)
+
+	diags.Append(diffDiags...)
+
+	return diffs, diags
+}
@@ -0,0 +1,113 @@
+package fwschemadata_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDataDiff(t *testing.T) {
+	t.Parallel()
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"test": testschema.Attribute{
+				Type:     types.ListType{ElemType: types.StringType},
+				Required: true,
+			},
+		},
+	}
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test": tftypes.List{ElementType: tftypes.String},
+		},
+	}
+	testValue := tftypes.NewValue(testType, map[string]tftypes.Value{
+		"test": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "a"),
+		}),
+	})
+
+	testCases := map[string]struct {
+		before   tftypes.Value
+		after    tftypes.Value
+		expected []basetypes.ValueDiff
+	}{
+		"equal": {
+			before:   testValue,
+			after:    testValue,
+			expected: nil,
+		},
+		"element": {
+			before: testValue,
+			after: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"test": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "b"),
+				}),
+			}),
+			expected: []basetypes.ValueDiff{
+				{
+					Path:   path.Root("test").AtListIndex(0),
+					Before: types.StringValue("a"),
+					After:  types.StringValue("b"),
+				},
+			},
+		},
+		"null": {
+			before: tftypes.NewValue(testType, nil),
+			after:  testValue,
+			expected: []basetypes.ValueDiff{
+				{
+					Path: path.Empty(),
+					Before: types.ObjectNull(map[string]attr.Type{
+						"test": types.ListType{ElemType: types.StringType},
+					}),
+					After: types.ObjectValueMust(
+						map[string]attr.Type{
+							"test": types.ListType{ElemType: types.StringType},
+						},
+						map[string]attr.Value{
+							"test": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a")}),
+						},
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			before := fwschemadata.Data{
+				Schema:         testSchema,
+				TerraformValue: testCase.before,
+			}
+			after := fwschemadata.Data{
+				Schema:         testSchema,
+				TerraformValue: testCase.after,
+			}
+
+			got, diags := before.Diff(context.Background(), after)
+
+			if len(diags) > 0 {
+				t.Fatalf("unexpected diagnostics: %s", diags)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -10,5 +10,11 @@ import (
 
 // Get populates the struct passed as `target` with the entire state.
 func (d Data) Get(ctx context.Context, target any) diag.Diagnostics {
-	return reflect.Into(ctx, d.Schema.Type(), d.TerraformValue, target, reflect.Options{}, path.Empty())
+	return d.GetWithOptions(ctx, target, reflect.Options{})
+}
+
+// GetWithOptions populates the struct passed as `target` with the entire
+// state, using the given reflection options.
+func (d Data) GetWithOptions(ctx context.Context, target any, opts reflect.Options) diag.Diagnostics {
+	return reflect.Into(ctx, d.Schema.Type(), d.TerraformValue, target, opts, path.Empty())
 }
@@ -14,6 +14,12 @@ import (
 // GetAtPath retrieves the attribute found at `path` and populates the
 // `target` with the value.
 func (d Data) GetAtPath(ctx context.Context, schemaPath path.Path, target any) diag.Diagnostics {
+	return d.GetAtPathWithOptions(ctx, schemaPath, target, reflect.Options{})
+}
+
+// GetAtPathWithOptions retrieves the attribute found at `path` and populates
+// the `target` with the value, using the given reflection options.
+func (d Data) GetAtPathWithOptions(ctx context.Context, schemaPath path.Path, target any, opts reflect.Options) diag.Diagnostics {
 	ctx = logging.FrameworkWithAttributePath(ctx, schemaPath.String())
 
 	attrValue, diags := d.ValueAtPath(ctx, schemaPath)
@@ -50,7 +56,7 @@ func (d Data) GetAtPath(ctx context.Context, schemaPath path.Path, target any) d
 		return diags
 	}
 
-	reflectDiags := reflect.Into(ctx, attrValue.Type(ctx), raw, target, reflect.Options{}, schemaPath)
+	reflectDiags := reflect.Into(ctx, attrValue.Type(ctx), raw, target, opts, schemaPath)
 
 	diags.Append(reflectDiags...)
 
@@ -14,11 +14,19 @@ import (
 // If a parent path is null or unknown, which would prevent a full expression
 // from matching, the parent path is returned rather than no match to prevent
 // false positives.
+//
+// Any ExpressionStepAttributeNameAny and ExpressionStepAnyDepth steps are
+// first expanded against the schema, so null or unknown parent paths are only
+// returned if the schema allows a match beneath them. A path matching an
+// expression ending in an ExpressionStepAnyDepth is returned along with any
+// deeper matching paths.
 func (d Data) PathMatches(ctx context.Context, pathExpr path.Expression) (path.Paths, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	var paths path.Paths
 
-	if !d.ValidPathExpression(ctx, pathExpr) {
+	expandedSteps := d.expandPathExpression(ctx, pathExpr)
+
+	if len(expandedSteps) == 0 {
 		diags.AddError(
 			"Invalid Path Expression for Schema",
 			"The Terraform Provider unexpectedly provided a path expression that does not match the current schema. "+
@@ -41,17 +49,20 @@ func (d Data) PathMatches(ctx context.Context, pathExpr path.Expression) (path.P
 			return false, nil
 		}
 
-		if pathExpr.Matches(fwPath) {
-			paths.Append(fwPath)
+		var matches, matchesParent bool
 
-			// If we matched, there is no need to traverse further since a
-			// deeper path will never match.
-			return false, nil
+		for _, steps := range expandedSteps {
+			matches = matches || steps.Matches(fwPath.Steps())
+			matchesParent = matchesParent || steps.MatchesParent(fwPath.Steps())
+		}
+
+		if matches {
+			paths.Append(fwPath)
 		}
 
 		// If current path cannot be parent path, there is no need to traverse
 		// further since a deeper path will never match.
-		if !pathExpr.MatchesParent(fwPath) {
+		if !matchesParent {
 			return false, nil
 		}
 
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
@@ -1395,3 +1396,181 @@ func TestDataPathMatches(t *testing.T) {
 		})
 	}
 }
+
+func TestDataPathMatches_wildcards(t *testing.T) {
+	t.Parallel()
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"name": testschema.Attribute{
+				Type: types.StringType,
+			},
+			"tags": testschema.Attribute{
+				Type: types.MapType{ElemType: types.StringType},
+			},
+			"nested": testschema.NestedAttribute{
+				NestedObject: testschema.NestedAttributeObject{
+					Attributes: map[string]fwschema.Attribute{
+						"name": testschema.Attribute{
+							Type: types.StringType,
+						},
+						"tags": testschema.Attribute{
+							Type: types.MapType{ElemType: types.StringType},
+						},
+					},
+				},
+				NestingMode: fwschema.NestingModeList,
+			},
+		},
+	}
+	nestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name": tftypes.String,
+			"tags": tftypes.Map{ElementType: tftypes.String},
+		},
+	}
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name":   tftypes.String,
+			"tags":   tftypes.Map{ElementType: tftypes.String},
+			"nested": tftypes.List{ElementType: nestedType},
+		},
+	}
+	testValue := tftypes.NewValue(testType, map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, nil),
+		"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"key": tftypes.NewValue(tftypes.String, "value"),
+		}),
+		"nested": tftypes.NewValue(tftypes.List{ElementType: nestedType}, []tftypes.Value{
+			tftypes.NewValue(nestedType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "test"),
+				"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			}),
+			tftypes.NewValue(nestedType, nil),
+		}),
+	})
+
+	testCases := map[string]struct {
+		tfTypeValue   tftypes.Value
+		expression    path.Expression
+		expected      path.Paths
+		expectedDiags diag.Diagnostics
+	}{
+		"AnyDepth-AttributeNameExact": {
+			tfTypeValue: testValue,
+			expression:  path.MatchRootAnyDepth().AtName("tags"),
+			expected: path.Paths{
+				path.Root("tags"),
+				path.Root("nested").AtListIndex(0).AtName("tags"),
+				// null parent which could contain a match
+				path.Root("nested").AtListIndex(1),
+			},
+		},
+		"AnyDepth-AttributeNameExact-parent-unknown": {
+			tfTypeValue: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"name":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"tags":   tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"nested": tftypes.NewValue(tftypes.List{ElementType: nestedType}, tftypes.UnknownValue),
+			}),
+			expression: path.MatchRootAnyDepth().AtName("tags"),
+			expected: path.Paths{
+				path.Root("tags"),
+				path.Root("nested"),
+			},
+		},
+		"AnyDepth-ElementKeyStringAny": {
+			tfTypeValue: testValue,
+			expression:  path.MatchRootAnyDepth().AtAnyMapKey(),
+			expected: path.Paths{
+				path.Root("tags").AtMapKey("key"),
+				path.Root("nested").AtListIndex(0).AtName("tags"),
+				path.Root("nested").AtListIndex(1),
+			},
+		},
+		"AttributeNameExact-AnyDepth": {
+			tfTypeValue: testValue,
+			expression:  path.MatchRoot("nested").AtAnyDepth(),
+			expected: path.Paths{
+				path.Root("nested"),
+				path.Root("nested").AtListIndex(0),
+				path.Root("nested").AtListIndex(0).AtName("name"),
+				path.Root("nested").AtListIndex(0).AtName("tags"),
+				path.Root("nested").AtListIndex(1),
+			},
+		},
+		"AttributeNameAny": {
+			tfTypeValue: testValue,
+			expression:  path.MatchRootAnyName(),
+			expected: path.Paths{
+				path.Root("name"),
+				path.Root("nested"),
+				path.Root("tags"),
+			},
+		},
+		"AttributeNameAny-parent": {
+			tfTypeValue: testValue,
+			expression:  path.MatchRoot("nested").AtAnyListIndex().AtAnyName(),
+			expected: path.Paths{
+				path.Root("nested").AtListIndex(0).AtName("name"),
+				path.Root("nested").AtListIndex(0).AtName("tags"),
+				path.Root("nested").AtListIndex(1),
+			},
+		},
+		"AttributeNameAny-mismatch": {
+			tfTypeValue: testValue,
+			expression:  path.MatchRoot("name").AtAnyName(),
+			expected:    nil,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Path Expression for Schema",
+					"The Terraform Provider unexpectedly provided a path expression that does not match the current schema. "+
+						"This can happen if the path expression does not correctly follow the schema in structure or types. "+
+						"Please report this to the provider developers.\n\n"+
+						"Path Expression: name.*",
+				),
+			},
+		},
+		"AnyDepth-mismatch": {
+			tfTypeValue: testValue,
+			expression:  path.MatchRootAnyDepth().AtName("not-test"),
+			expected:    nil,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Path Expression for Schema",
+					"The Terraform Provider unexpectedly provided a path expression that does not match the current schema. "+
+						"This can happen if the path expression does not correctly follow the schema in structure or types. "+
+						"Please report this to the provider developers.\n\n"+
+						"Path Expression: **.not-test",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data := fwschemadata.Data{
+				Schema:         testSchema,
+				TerraformValue: testCase.tfTypeValue,
+			}
+
+			got, diags := data.PathMatches(context.Background(), testCase.expression)
+
+			// Object attributes and map elements are walked in random order.
+			sortPaths := cmpopts.SortSlices(func(a, b path.Path) bool {
+				return a.String() < b.String()
+			})
+
+			if diff := cmp.Diff(got, testCase.expected, sortPaths); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package fwschemadata
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
@@ -14,29 +15,141 @@ import (
 // schema underlying the Data. This can be used to determine if there was an
 // expression implementation error versus an expression returning no path
 // matches based on implementation details of the underlying data storage.
+//
+// Expressions with ExpressionStepAttributeNameAny or ExpressionStepAnyDepth
+// steps are valid if they can be applied to at least one location in the
+// schema.
 func (d Data) ValidPathExpression(ctx context.Context, expression path.Expression) bool {
+	return len(d.expandPathExpression(ctx, expression)) > 0
+}
+
+// expandPathExpression returns the resolved steps of the given expression
+// with any ExpressionStepAttributeNameAny and ExpressionStepAnyDepth steps
+// replaced by each of the steps they could match in the schema. Returns no
+// expression steps if the expression is not valid for the schema.
+func (d Data) expandPathExpression(ctx context.Context, expression path.Expression) []path.ExpressionSteps {
 	expressionSteps := expression.Resolve().Steps()
 
 	if len(expressionSteps) == 0 {
-		return false
+		return nil
 	}
 
-	return validatePathExpressionSteps(ctx, d.Schema.Type(), expressionSteps)
+	return expandPathExpressionSteps(ctx, d.Schema.Type(), path.ExpressionSteps{}, expressionSteps)
 }
 
-// validatePathExpressionSteps is a recursive function which returns true if
-// the path expression steps can be applied to the type.
-func validatePathExpressionSteps(ctx context.Context, currentType attr.Type, currentExpressionSteps path.ExpressionSteps) bool {
+// expandPathExpressionSteps is a recursive function which returns all
+// expression steps, starting with the already expanded steps, which are
+// valid applications of the remaining expression steps to the current type.
+func expandPathExpressionSteps(ctx context.Context, currentType attr.Type, expandedSteps path.ExpressionSteps, currentExpressionSteps path.ExpressionSteps) []path.ExpressionSteps {
 	currentExpressionStep, nextSteps := currentExpressionSteps.NextStep()
 
+	switch currentExpressionStep.(type) {
+	case nil:
+		// There are no more expression steps.
+		return []path.ExpressionSteps{expandedSteps}
+	case path.ExpressionStepAttributeNameAny:
+		var result []path.ExpressionSteps
+
+		for _, childStep := range childExpressionSteps(ctx, currentType) {
+			if _, ok := childStep.(path.ExpressionStepAttributeNameExact); !ok {
+				continue
+			}
+
+			childType, ok := pathExpressionStepType(ctx, currentType, childStep)
+
+			if !ok {
+				continue
+			}
+
+			result = append(result, expandPathExpressionSteps(ctx, childType, appendExpressionStep(expandedSteps, childStep), nextSteps)...)
+		}
+
+		return result
+	case path.ExpressionStepAnyDepth:
+		// Match no steps, then each possible step while keeping the
+		// recursive descent step for deeper matches.
+		result := expandPathExpressionSteps(ctx, currentType, expandedSteps, nextSteps)
+
+		for _, childStep := range childExpressionSteps(ctx, currentType) {
+			childType, ok := pathExpressionStepType(ctx, currentType, childStep)
+
+			if !ok {
+				continue
+			}
+
+			result = append(result, expandPathExpressionSteps(ctx, childType, appendExpressionStep(expandedSteps, childStep), currentExpressionSteps)...)
+		}
+
+		return result
+	}
+
+	nextType, ok := pathExpressionStepType(ctx, currentType, currentExpressionStep)
+
+	if !ok {
+		return nil
+	}
+
+	return expandPathExpressionSteps(ctx, nextType, appendExpressionStep(expandedSteps, currentExpressionStep), nextSteps)
+}
+
+// appendExpressionStep returns a copy of the steps with the given step added
+// to the end, so expansions never share underlying arrays.
+func appendExpressionStep(steps path.ExpressionSteps, step path.ExpressionStep) path.ExpressionSteps {
+	result := make(path.ExpressionSteps, 0, len(steps)+1)
+
+	result = append(result, steps...)
+
+	return append(result, step)
+}
+
+// childExpressionSteps returns an expression step for each possible step into
+// the given type. Attribute names are sorted for consistent results.
+func childExpressionSteps(ctx context.Context, currentType attr.Type) path.ExpressionSteps {
+	switch tfType := currentType.TerraformType(ctx).(type) {
+	case tftypes.Object:
+		names := make([]string, 0, len(tfType.AttributeTypes))
+
+		for name := range tfType.AttributeTypes {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+		result := make(path.ExpressionSteps, 0, len(names))
+
+		for _, name := range names {
+			result = append(result, path.ExpressionStepAttributeNameExact(name))
+		}
+
+		return result
+	case tftypes.List:
+		return path.ExpressionSteps{path.ExpressionStepElementKeyIntAny{}}
+	case tftypes.Map:
+		return path.ExpressionSteps{path.ExpressionStepElementKeyStringAny{}}
+	case tftypes.Set:
+		return path.ExpressionSteps{path.ExpressionStepElementKeyValueAny{}}
+	case tftypes.Tuple:
+		// Tuple elements can each be a different type.
+		result := make(path.ExpressionSteps, 0, len(tfType.ElementTypes))
+
+		for index := range tfType.ElementTypes {
+			result = append(result, path.ExpressionStepElementKeyIntExact(index))
+		}
+
+		return result
+	default:
+		return nil
+	}
+}
+
+// pathExpressionStepType returns the type after applying the path expression
+// step to the current type and true, or false if the step cannot be applied.
+func pathExpressionStepType(ctx context.Context, currentType attr.Type, currentExpressionStep path.ExpressionStep) (attr.Type, bool) {
 	// Generate a tftypes step based on the expression. For type definitions,
 	// any value should be acceptable for element steps.
 	var currentTfStep tftypes.AttributePathStep
 
 	switch step := currentExpressionStep.(type) {
-	case nil:
-		// There are no more expression steps.
-		return true
 	case path.ExpressionStepAttributeNameExact:
 		currentTfStep = tftypes.AttributeName(step)
 	case path.ExpressionStepElementKeyIntAny:
@@ -84,7 +197,7 @@ func validatePathExpressionSteps(ctx context.Context, currentType attr.Type, cur
 			},
 		)
 
-		return false
+		return nil, false
 	}
 
 	nextType, ok := nextTypeIface.(attr.Type)
@@ -95,5 +208,5 @@ func validatePathExpressionSteps(ctx context.Context, currentType attr.Type, cur
 		panic(fmt.Sprintf("%T returned unexpected type %T from ApplyTerraform5AttributePathStep", currentType, nextTypeIface))
 	}
 
-	return validatePathExpressionSteps(ctx, nextType, nextSteps)
+	return nextType, true
 }
@@ -249,6 +249,74 @@ func TestDataValidPathExpression(t *testing.T) {
 			expression: path.MatchRoot("test").AtSetValue(types.StringValue("test-value")),
 			expected:   false,
 		},
+		"AttributeNameAny-match": {
+			data: fwschemadata.Data{
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Required: true,
+							Type:     types.StringType,
+						},
+					},
+				},
+			},
+			expression: path.MatchRootAnyName(),
+			expected:   true,
+		},
+		"AttributeNameAny-mismatch": {
+			data: fwschemadata.Data{
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Required: true,
+							Type:     types.StringType,
+						},
+					},
+				},
+			},
+			expression: path.MatchRootAnyName().AtAnyName(),
+			expected:   false,
+		},
+		"AnyDepth-match": {
+			data: fwschemadata.Data{
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Required: true,
+							Type: types.ListType{
+								ElemType: types.ObjectType{
+									AttrTypes: map[string]attr.Type{
+										"tags": types.MapType{ElemType: types.StringType},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expression: path.MatchRootAnyDepth().AtName("tags").AtAnyMapKey(),
+			expected:   true,
+		},
+		"AnyDepth-mismatch": {
+			data: fwschemadata.Data{
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Required: true,
+							Type: types.ListType{
+								ElemType: types.ObjectType{
+									AttrTypes: map[string]attr.Type{
+										"tags": types.MapType{ElemType: types.StringType},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expression: path.MatchRootAnyDepth().AtName("tags").AtAnyListIndex(),
+			expected:   false,
+		},
 	}
 
 	for name, testCase := range testCases {
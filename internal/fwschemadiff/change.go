@@ -0,0 +1,173 @@
+package fwschemadiff
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// ChangeType is the kind of difference between two schemas.
+type ChangeType uint8
+
+const (
+	// ChangeTypeUnknown is an invalid change type, used to catch when a
+	// change type is expected and not set.
+	ChangeTypeUnknown ChangeType = 0
+
+	// ChangeTypeAttributeAdded is for new attributes which are not required.
+	ChangeTypeAttributeAdded ChangeType = 1
+
+	// ChangeTypeRequiredAttributeAdded is for new required attributes, which
+	// break existing configurations.
+	ChangeTypeRequiredAttributeAdded ChangeType = 2
+
+	// ChangeTypeAttributeRemoved is for removed attributes.
+	ChangeTypeAttributeRemoved ChangeType = 3
+
+	// ChangeTypeBlockAdded is for new blocks.
+	ChangeTypeBlockAdded ChangeType = 4
+
+	// ChangeTypeBlockRemoved is for removed blocks.
+	ChangeTypeBlockRemoved ChangeType = 5
+
+	// ChangeTypeAttributeBlockSwapped is for attributes which became blocks or
+	// blocks which became attributes, which changes the configuration syntax.
+	ChangeTypeAttributeBlockSwapped ChangeType = 6
+
+	// ChangeTypeTypeChanged is for attributes or blocks whose underlying
+	// Terraform type changed.
+	ChangeTypeTypeChanged ChangeType = 7
+
+	// ChangeTypeNestingModeChanged is for nested attributes or blocks whose
+	// nesting mode changed, such as from list to set.
+	ChangeTypeNestingModeChanged ChangeType = 8
+
+	// ChangeTypeRequirednessTightened is for attributes which became
+	// required or are no longer configurable, such as Optional to Required
+	// or Optional to Computed.
+	ChangeTypeRequirednessTightened ChangeType = 9
+
+	// ChangeTypeRequirednessRelaxed is for other changes of the Required,
+	// Optional, and Computed fields of attributes, such as Required to
+	// Optional.
+	ChangeTypeRequirednessRelaxed ChangeType = 10
+
+	// ChangeTypeSensitiveChanged is for attributes whose Sensitive field
+	// changed.
+	ChangeTypeSensitiveChanged ChangeType = 11
+)
+
+// String returns a human readable representation of the change type.
+func (t ChangeType) String() string {
+	switch t {
+	case ChangeTypeAttributeAdded:
+		return "attribute added"
+	case ChangeTypeRequiredAttributeAdded:
+		return "required attribute added"
+	case ChangeTypeAttributeRemoved:
+		return "attribute removed"
+	case ChangeTypeBlockAdded:
+		return "block added"
+	case ChangeTypeBlockRemoved:
+		return "block removed"
+	case ChangeTypeAttributeBlockSwapped:
+		return "attribute and block swapped"
+	case ChangeTypeTypeChanged:
+		return "type changed"
+	case ChangeTypeNestingModeChanged:
+		return "nesting mode changed"
+	case ChangeTypeRequirednessTightened:
+		return "requiredness tightened"
+	case ChangeTypeRequirednessRelaxed:
+		return "requiredness relaxed"
+	case ChangeTypeSensitiveChanged:
+		return "sensitive changed"
+	default:
+		return "unknown"
+	}
+}
+
+// Change is a single difference between two schemas.
+type Change struct {
+	// Expression matches the changed attribute or block. Nested attributes
+	// and blocks under lists, maps, and sets use any element steps, such as
+	// example[*].nested.
+	Expression path.Expression
+
+	// Type is the kind of difference.
+	Type ChangeType
+
+	// Detail is an optional human readable description of the prior and
+	// current values, such as "Optional to Required".
+	Detail string
+}
+
+// Breaking returns true if the change can cause existing practitioner
+// configurations or saved state to stop working.
+func (c Change) Breaking() bool {
+	switch c.Type {
+	case ChangeTypeRequiredAttributeAdded,
+		ChangeTypeAttributeRemoved,
+		ChangeTypeBlockRemoved,
+		ChangeTypeAttributeBlockSwapped,
+		ChangeTypeTypeChanged,
+		ChangeTypeNestingModeChanged,
+		ChangeTypeRequirednessTightened:
+		return true
+	default:
+		return false
+	}
+}
+
+// StateAffecting returns true if the change modifies the shape of saved
+// resource state, which requires a resource schema version increment and
+// state upgrade. Removed attributes and blocks are breaking, but not state
+// affecting, as the framework drops undefined attributes from saved state
+// of the same schema version.
+func (c Change) StateAffecting() bool {
+	switch c.Type {
+	case ChangeTypeTypeChanged,
+		ChangeTypeNestingModeChanged:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns a human readable representation of the change.
+func (c Change) String() string {
+	if c.Detail == "" {
+		return fmt.Sprintf("%s: %s", c.Expression, c.Type)
+	}
+
+	return fmt.Sprintf("%s: %s (%s)", c.Expression, c.Type, c.Detail)
+}
+
+// Changes is a collection of Change.
+type Changes []Change
+
+// Breaking returns the changes where Breaking returns true.
+func (c Changes) Breaking() Changes {
+	var result Changes
+
+	for _, change := range c {
+		if change.Breaking() {
+			result = append(result, change)
+		}
+	}
+
+	return result
+}
+
+// StateAffecting returns the changes where StateAffecting returns true.
+func (c Changes) StateAffecting() Changes {
+	var result Changes
+
+	for _, change := range c {
+		if change.StateAffecting() {
+			result = append(result, change)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,141 @@
+package fwschemadiff_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadiff"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestChangeBreaking(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		change   fwschemadiff.Change
+		expected bool
+	}{
+		"attribute-added": {
+			change:   fwschemadiff.Change{Type: fwschemadiff.ChangeTypeAttributeAdded},
+			expected: false,
+		},
+		"required-attribute-added": {
+			change:   fwschemadiff.Change{Type: fwschemadiff.ChangeTypeRequiredAttributeAdded},
+			expected: true,
+		},
+		"requiredness-relaxed": {
+			change:   fwschemadiff.Change{Type: fwschemadiff.ChangeTypeRequirednessRelaxed},
+			expected: false,
+		},
+		"requiredness-tightened": {
+			change:   fwschemadiff.Change{Type: fwschemadiff.ChangeTypeRequirednessTightened},
+			expected: true,
+		},
+		"sensitive-changed": {
+			change:   fwschemadiff.Change{Type: fwschemadiff.ChangeTypeSensitiveChanged},
+			expected: false,
+		},
+		"type-changed": {
+			change:   fwschemadiff.Change{Type: fwschemadiff.ChangeTypeTypeChanged},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.change.Breaking()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestChangeStateAffecting(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		change   fwschemadiff.Change
+		expected bool
+	}{
+		"attribute-block-swapped": {
+			change:   fwschemadiff.Change{Type: fwschemadiff.ChangeTypeAttributeBlockSwapped},
+			expected: false,
+		},
+		"attribute-removed": {
+			change:   fwschemadiff.Change{Type: fwschemadiff.ChangeTypeAttributeRemoved},
+			expected: false,
+		},
+		"block-removed": {
+			change:   fwschemadiff.Change{Type: fwschemadiff.ChangeTypeBlockRemoved},
+			expected: false,
+		},
+		"nesting-mode-changed": {
+			change:   fwschemadiff.Change{Type: fwschemadiff.ChangeTypeNestingModeChanged},
+			expected: true,
+		},
+		"requiredness-tightened": {
+			change:   fwschemadiff.Change{Type: fwschemadiff.ChangeTypeRequirednessTightened},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.change.StateAffecting()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestChangeString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		change   fwschemadiff.Change
+		expected string
+	}{
+		"detail": {
+			change: fwschemadiff.Change{
+				Expression: path.MatchRoot("test").AtAnyListIndex().AtName("nested"),
+				Type:       fwschemadiff.ChangeTypeRequirednessTightened,
+				Detail:     "Optional to Required",
+			},
+			expected: "test[*].nested: requiredness tightened (Optional to Required)",
+		},
+		"no-detail": {
+			change: fwschemadiff.Change{
+				Expression: path.MatchRoot("test"),
+				Type:       fwschemadiff.ChangeTypeAttributeRemoved,
+			},
+			expected: "test: attribute removed",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.change.String()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
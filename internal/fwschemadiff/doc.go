@@ -0,0 +1,5 @@
+// Package fwschemadiff implements the comparison of framework schemas, such
+// as between two versions of a provider, and the classification of the
+// differences by whether they break practitioner configurations or
+// previously saved resource state.
+package fwschemadiff
@@ -0,0 +1,73 @@
+package fwschemadiff
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtfjson"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tfjson"
+)
+
+// ParseProviderSchemas returns the ProviderSchemas of the provider schemas
+// JSON format, such as the output of the `terraform providers schema -json`
+// command. The address selects the provider, such as
+// registry.terraform.io/hashicorp/example, and can be omitted when there is
+// only a single provider. The ResourceStateUpgraders field is not set.
+func ParseProviderSchemas(data []byte, address string) (ProviderSchemas, error) {
+	var schemas tfjson.ProviderSchemas
+
+	if err := json.Unmarshal(data, &schemas); err != nil {
+		return ProviderSchemas{}, fmt.Errorf("unable to parse provider schemas: %w", err)
+	}
+
+	_, providerSchema, err := schemas.ProviderSchema(address)
+
+	if err != nil {
+		return ProviderSchemas{}, err
+	}
+
+	var result ProviderSchemas
+
+	if providerSchema.ConfigSchema != nil {
+		s, err := fromtfjson.Schema(providerSchema.ConfigSchema)
+
+		if err != nil {
+			return ProviderSchemas{}, fmt.Errorf("unable to convert provider schema: %w", err)
+		}
+
+		result.Provider = s
+	}
+
+	result.ResourceSchemas, err = schemasFromTfjson("resource", providerSchema.ResourceSchemas)
+
+	if err != nil {
+		return ProviderSchemas{}, err
+	}
+
+	result.DataSourceSchemas, err = schemasFromTfjson("data source", providerSchema.DataSourceSchemas)
+
+	if err != nil {
+		return ProviderSchemas{}, err
+	}
+
+	return result, nil
+}
+
+// schemasFromTfjson returns the fwschema.Schema equivalents of the given
+// resource or data source schemas.
+func schemasFromTfjson(kind string, schemas map[string]*tfjson.Schema) (map[string]fwschema.Schema, error) {
+	result := make(map[string]fwschema.Schema, len(schemas))
+
+	for typeName, schema := range schemas {
+		s, err := fromtfjson.Schema(schema)
+
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert %s %q schema: %w", kind, typeName, err)
+		}
+
+		result[typeName] = s
+	}
+
+	return result, nil
+}
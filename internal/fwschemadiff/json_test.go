@@ -0,0 +1,103 @@
+package fwschemadiff_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadiff"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func TestParseProviderSchemas(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		data          string
+		address       string
+		expected      fwschemadiff.ProviderSchemas
+		expectedError string
+	}{
+		"invalid-json": {
+			data:          `{`,
+			expectedError: "unable to parse provider schemas: unexpected end of JSON input",
+		},
+		"missing-provider": {
+			data:          `{"format_version":"1.0","provider_schemas":{"registry.terraform.io/examplecorp/examplecloud":{}}}`,
+			address:       "registry.terraform.io/examplecorp/other",
+			expectedError: `provider "registry.terraform.io/examplecorp/other" not found in provider schemas`,
+		},
+		"invalid-resource-schema": {
+			data:          `{"format_version":"1.0","provider_schemas":{"registry.terraform.io/examplecorp/examplecloud":{"resource_schemas":{"examplecloud_thing":{"version":0,"block":{"attributes":{"test":{"optional":true}}}}}}}}`,
+			expectedError: `unable to convert resource "examplecloud_thing" schema: AttributeName("test"): missing type`,
+		},
+		"schemas": {
+			data: `{"format_version":"1.0","provider_schemas":{"registry.terraform.io/examplecorp/examplecloud":{` +
+				`"provider":{"version":0,"block":{"attributes":{"token":{"type":"string","optional":true,"sensitive":true}}}},` +
+				`"resource_schemas":{"examplecloud_thing":{"version":1,"block":{"attributes":{"id":{"type":"string","computed":true}}}}},` +
+				`"data_source_schemas":{"examplecloud_thing":{"version":0,"block":{"attributes":{"id":{"type":"string","required":true}}}}}` +
+				`}}}`,
+			address: "registry.terraform.io/examplecorp/examplecloud",
+			expected: fwschemadiff.ProviderSchemas{
+				Provider: schema.Schema{
+					Attributes: map[string]schema.Attribute{
+						"token": schema.StringAttribute{
+							Optional:  true,
+							Sensitive: true,
+						},
+					},
+				},
+				ResourceSchemas: map[string]fwschema.Schema{
+					"examplecloud_thing": schema.Schema{
+						Attributes: map[string]schema.Attribute{
+							"id": schema.StringAttribute{
+								Computed: true,
+							},
+						},
+						Version: 1,
+					},
+				},
+				DataSourceSchemas: map[string]fwschema.Schema{
+					"examplecloud_thing": schema.Schema{
+						Attributes: map[string]schema.Attribute{
+							"id": schema.StringAttribute{
+								Required: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := fwschemadiff.ParseProviderSchemas([]byte(testCase.data), testCase.address)
+
+			if err != nil {
+				if testCase.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if diff := cmp.Diff(err.Error(), testCase.expectedError); diff != "" {
+					t.Fatalf("unexpected error difference: %s", diff)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("expected error: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
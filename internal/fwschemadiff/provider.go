@@ -0,0 +1,207 @@
+package fwschemadiff
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// ProviderSchemas contains the schemas of a provider version.
+type ProviderSchemas struct {
+	// Provider is the provider schema, if any.
+	Provider fwschema.Schema
+
+	// ResourceSchemas is the resource schemas by type name.
+	ResourceSchemas map[string]fwschema.Schema
+
+	// DataSourceSchemas is the data source schemas by type name.
+	DataSourceSchemas map[string]fwschema.Schema
+
+	// ResourceStateUpgraders is the resource state upgraders by type name,
+	// which are only used from the current provider version. If nil, the
+	// StateUpgrader checks are skipped.
+	ResourceStateUpgraders map[string]map[int64]resource.StateUpgrader
+}
+
+// ProviderChanges contains the differences between two provider versions.
+type ProviderChanges struct {
+	// Provider is the provider schema differences.
+	Provider Changes
+
+	// Resources is the resource schema differences by type name, for
+	// resources in both versions with differences.
+	Resources map[string]Changes
+
+	// DataSources is the data source schema differences by type name, for
+	// data sources in both versions with differences.
+	DataSources map[string]Changes
+
+	// RemovedResources is the sorted type names of removed resources.
+	RemovedResources []string
+
+	// RemovedDataSources is the sorted type names of removed data sources.
+	RemovedDataSources []string
+
+	// StateUpgradeDiagnostics contains the ResourceStateUpgradeDiagnostics
+	// of all resources in both versions.
+	StateUpgradeDiagnostics diag.Diagnostics
+}
+
+// Providers returns the differences from the prior to the current provider
+// version.
+func Providers(ctx context.Context, prior ProviderSchemas, current ProviderSchemas) ProviderChanges {
+	var result ProviderChanges
+
+	if prior.Provider != nil && current.Provider != nil {
+		result.Provider = Schemas(ctx, prior.Provider, current.Provider)
+	}
+
+	for _, typeName := range sortedTypeNames(prior.ResourceSchemas) {
+		priorSchema := prior.ResourceSchemas[typeName]
+		currentSchema, ok := current.ResourceSchemas[typeName]
+
+		if !ok {
+			result.RemovedResources = append(result.RemovedResources, typeName)
+
+			continue
+		}
+
+		changes := Schemas(ctx, priorSchema, currentSchema)
+
+		if len(changes) > 0 {
+			if result.Resources == nil {
+				result.Resources = make(map[string]Changes)
+			}
+
+			result.Resources[typeName] = changes
+		}
+
+		var upgraders map[int64]resource.StateUpgrader
+
+		if current.ResourceStateUpgraders != nil {
+			upgraders = current.ResourceStateUpgraders[typeName]
+
+			// Panic prevention and distinguish from skipping the checks.
+			if upgraders == nil {
+				upgraders = make(map[int64]resource.StateUpgrader, 0)
+			}
+		}
+
+		result.StateUpgradeDiagnostics.Append(ResourceStateUpgradeDiagnostics(ctx, typeName, priorSchema, currentSchema, changes, upgraders)...)
+	}
+
+	for _, typeName := range sortedTypeNames(prior.DataSourceSchemas) {
+		currentSchema, ok := current.DataSourceSchemas[typeName]
+
+		if !ok {
+			result.RemovedDataSources = append(result.RemovedDataSources, typeName)
+
+			continue
+		}
+
+		changes := Schemas(ctx, prior.DataSourceSchemas[typeName], currentSchema)
+
+		if len(changes) > 0 {
+			if result.DataSources == nil {
+				result.DataSources = make(map[string]Changes)
+			}
+
+			result.DataSources[typeName] = changes
+		}
+	}
+
+	return result
+}
+
+// Breaking returns true if there are any breaking changes or removed
+// resources or data sources.
+func (c ProviderChanges) Breaking() bool {
+	if len(c.RemovedResources) > 0 || len(c.RemovedDataSources) > 0 {
+		return true
+	}
+
+	if len(c.Provider.Breaking()) > 0 {
+		return true
+	}
+
+	for _, changes := range c.Resources {
+		if len(changes.Breaking()) > 0 {
+			return true
+		}
+	}
+
+	for _, changes := range c.DataSources {
+		if len(changes.Breaking()) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Diagnostics returns a warning diagnostic for each removed resource or data
+// source and each schema with breaking changes, followed by the
+// StateUpgradeDiagnostics.
+func (c ProviderChanges) Diagnostics() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, typeName := range c.RemovedResources {
+		diags.AddWarning(
+			"Resource Removed",
+			fmt.Sprintf("The %s resource was removed. Existing configurations and state using it will return errors.", typeName),
+		)
+	}
+
+	for _, typeName := range c.RemovedDataSources {
+		diags.AddWarning(
+			"Data Source Removed",
+			fmt.Sprintf("The %s data source was removed. Existing configurations using it will return errors.", typeName),
+		)
+	}
+
+	if breaking := c.Provider.Breaking(); len(breaking) > 0 {
+		diags.AddWarning(
+			"Breaking Provider Schema Changes",
+			"The provider schema has changes which can break existing configurations:\n\n"+changesList(breaking),
+		)
+	}
+
+	for _, typeName := range sortedTypeNames(c.Resources) {
+		if breaking := c.Resources[typeName].Breaking(); len(breaking) > 0 {
+			diags.AddWarning(
+				"Breaking Resource Schema Changes",
+				fmt.Sprintf("The %s resource schema has changes which can break existing configurations or state:\n\n", typeName)+changesList(breaking),
+			)
+		}
+	}
+
+	for _, typeName := range sortedTypeNames(c.DataSources) {
+		if breaking := c.DataSources[typeName].Breaking(); len(breaking) > 0 {
+			diags.AddWarning(
+				"Breaking Data Source Schema Changes",
+				fmt.Sprintf("The %s data source schema has changes which can break existing configurations:\n\n", typeName)+changesList(breaking),
+			)
+		}
+	}
+
+	diags.Append(c.StateUpgradeDiagnostics...)
+
+	return diags
+}
+
+// sortedTypeNames returns the sorted keys of a map keyed by type name.
+func sortedTypeNames[T any](m map[string]T) []string {
+	result := make([]string, 0, len(m))
+
+	for typeName := range m {
+		result = append(result, typeName)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
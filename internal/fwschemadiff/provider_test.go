@@ -0,0 +1,225 @@
+package fwschemadiff_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadiff"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestProviders(t *testing.T) {
+	t.Parallel()
+
+	optionalString := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"test": testschema.Attribute{
+				Optional: true,
+				Type:     types.StringType,
+			},
+		},
+	}
+
+	requiredString := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"test": testschema.Attribute{
+				Required: true,
+				Type:     types.StringType,
+			},
+		},
+	}
+
+	optionalBool := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"test": testschema.Attribute{
+				Optional: true,
+				Type:     types.BoolType,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		prior               fwschemadiff.ProviderSchemas
+		current             fwschemadiff.ProviderSchemas
+		expected            fwschemadiff.ProviderChanges
+		expectedBreaking    bool
+		expectedDiagnostics diag.Diagnostics
+	}{
+		"no-changes": {
+			prior: fwschemadiff.ProviderSchemas{
+				Provider: optionalString,
+				ResourceSchemas: map[string]fwschema.Schema{
+					"examplecloud_thing": optionalString,
+				},
+			},
+			current: fwschemadiff.ProviderSchemas{
+				Provider: optionalString,
+				ResourceSchemas: map[string]fwschema.Schema{
+					"examplecloud_new":   requiredString,
+					"examplecloud_thing": optionalString,
+				},
+				ResourceStateUpgraders: map[string]map[int64]resource.StateUpgrader{},
+			},
+			expected:            fwschemadiff.ProviderChanges{},
+			expectedBreaking:    false,
+			expectedDiagnostics: nil,
+		},
+		"changes": {
+			prior: fwschemadiff.ProviderSchemas{
+				Provider: optionalString,
+				ResourceSchemas: map[string]fwschema.Schema{
+					"examplecloud_removed": optionalString,
+					"examplecloud_thing":   optionalString,
+				},
+				DataSourceSchemas: map[string]fwschema.Schema{
+					"examplecloud_removed": optionalString,
+					"examplecloud_thing":   optionalString,
+				},
+			},
+			current: fwschemadiff.ProviderSchemas{
+				Provider: requiredString,
+				ResourceSchemas: map[string]fwschema.Schema{
+					"examplecloud_thing": optionalBool,
+				},
+				DataSourceSchemas: map[string]fwschema.Schema{
+					"examplecloud_thing": requiredString,
+				},
+			},
+			expected: fwschemadiff.ProviderChanges{
+				Provider: fwschemadiff.Changes{
+					{
+						Expression: path.MatchRoot("test"),
+						Type:       fwschemadiff.ChangeTypeRequirednessTightened,
+						Detail:     "Optional to Required",
+					},
+				},
+				Resources: map[string]fwschemadiff.Changes{
+					"examplecloud_thing": {
+						{
+							Expression: path.MatchRoot("test"),
+							Type:       fwschemadiff.ChangeTypeTypeChanged,
+							Detail:     "tftypes.String to tftypes.Bool",
+						},
+					},
+				},
+				DataSources: map[string]fwschemadiff.Changes{
+					"examplecloud_thing": {
+						{
+							Expression: path.MatchRoot("test"),
+							Type:       fwschemadiff.ChangeTypeRequirednessTightened,
+							Detail:     "Optional to Required",
+						},
+					},
+				},
+				RemovedResources:   []string{"examplecloud_removed"},
+				RemovedDataSources: []string{"examplecloud_removed"},
+				StateUpgradeDiagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Missing Resource Schema Version Increment",
+						"The examplecloud_thing resource schema has changes which affect saved state, however the schema version was not increased from 0. "+
+							"Increase the resource schema Version and implement a StateUpgrader for version 0 in the resource UpgradeState method.\n\n"+
+							"Changes:\n\n"+
+							"- test: type changed (tftypes.String to tftypes.Bool)",
+					),
+				},
+			},
+			expectedBreaking: true,
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"Resource Removed",
+					"The examplecloud_removed resource was removed. Existing configurations and state using it will return errors.",
+				),
+				diag.NewWarningDiagnostic(
+					"Data Source Removed",
+					"The examplecloud_removed data source was removed. Existing configurations using it will return errors.",
+				),
+				diag.NewWarningDiagnostic(
+					"Breaking Provider Schema Changes",
+					"The provider schema has changes which can break existing configurations:\n\n"+
+						"- test: requiredness tightened (Optional to Required)",
+				),
+				diag.NewWarningDiagnostic(
+					"Breaking Resource Schema Changes",
+					"The examplecloud_thing resource schema has changes which can break existing configurations or state:\n\n"+
+						"- test: type changed (tftypes.String to tftypes.Bool)",
+				),
+				diag.NewWarningDiagnostic(
+					"Breaking Data Source Schema Changes",
+					"The examplecloud_thing data source schema has changes which can break existing configurations:\n\n"+
+						"- test: requiredness tightened (Optional to Required)",
+				),
+				diag.NewErrorDiagnostic(
+					"Missing Resource Schema Version Increment",
+					"The examplecloud_thing resource schema has changes which affect saved state, however the schema version was not increased from 0. "+
+						"Increase the resource schema Version and implement a StateUpgrader for version 0 in the resource UpgradeState method.\n\n"+
+						"Changes:\n\n"+
+						"- test: type changed (tftypes.String to tftypes.Bool)",
+				),
+			},
+		},
+		"missing-state-upgrader": {
+			prior: fwschemadiff.ProviderSchemas{
+				ResourceSchemas: map[string]fwschema.Schema{
+					"examplecloud_thing": optionalString,
+				},
+			},
+			current: fwschemadiff.ProviderSchemas{
+				ResourceSchemas: map[string]fwschema.Schema{
+					"examplecloud_thing": testschema.Schema{
+						Attributes: optionalString.Attributes,
+						Version:    1,
+					},
+				},
+				ResourceStateUpgraders: map[string]map[int64]resource.StateUpgrader{},
+			},
+			expected: fwschemadiff.ProviderChanges{
+				StateUpgradeDiagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Missing Resource State Upgrader",
+						"The examplecloud_thing resource schema version was increased from 0 to 1, "+
+							"however the resource UpgradeState method does not implement a StateUpgrader for version 0. "+
+							"Terraform will return an error when upgrading saved state from version 0.",
+					),
+				},
+			},
+			expectedBreaking: false,
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Missing Resource State Upgrader",
+					"The examplecloud_thing resource schema version was increased from 0 to 1, "+
+						"however the resource UpgradeState method does not implement a StateUpgrader for version 0. "+
+						"Terraform will return an error when upgrading saved state from version 0.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwschemadiff.Providers(context.Background(), testCase.prior, testCase.current)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got.Breaking(), testCase.expectedBreaking); diff != "" {
+				t.Errorf("unexpected breaking difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got.Diagnostics(), testCase.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
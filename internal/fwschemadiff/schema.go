@@ -0,0 +1,318 @@
+package fwschemadiff
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Schemas returns the differences from the prior schema to the current
+// schema, sorted by expression. The schema version is not compared.
+func Schemas(ctx context.Context, prior fwschema.Schema, current fwschema.Schema) Changes {
+	var changes Changes
+
+	changes = append(changes, attributesAndBlocks(
+		ctx,
+		path.Empty().Expression(),
+		prior.GetAttributes(),
+		current.GetAttributes(),
+		prior.GetBlocks(),
+		current.GetBlocks(),
+	)...)
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].Expression.String() != changes[j].Expression.String() {
+			return changes[i].Expression.String() < changes[j].Expression.String()
+		}
+
+		return changes[i].Type < changes[j].Type
+	})
+
+	return changes
+}
+
+// attributesAndBlocks returns the differences between the attributes and
+// blocks of a schema or nested object.
+func attributesAndBlocks(ctx context.Context, expression path.Expression, priorAttributes, currentAttributes map[string]fwschema.Attribute, priorBlocks, currentBlocks map[string]fwschema.Block) Changes {
+	var changes Changes
+
+	for name, priorAttribute := range priorAttributes {
+		nameExpression := expression.AtName(name)
+
+		if currentAttribute, ok := currentAttributes[name]; ok {
+			changes = append(changes, attribute(ctx, nameExpression, priorAttribute, currentAttribute)...)
+
+			continue
+		}
+
+		if currentBlock, ok := currentBlocks[name]; ok {
+			changes = append(changes, swapped(ctx, nameExpression, "attribute to block", priorAttribute.GetType(), currentBlock.Type())...)
+
+			continue
+		}
+
+		changes = append(changes, Change{
+			Expression: nameExpression,
+			Type:       ChangeTypeAttributeRemoved,
+		})
+	}
+
+	for name, currentAttribute := range currentAttributes {
+		if _, ok := priorAttributes[name]; ok {
+			continue
+		}
+
+		nameExpression := expression.AtName(name)
+
+		if priorBlock, ok := priorBlocks[name]; ok {
+			changes = append(changes, swapped(ctx, nameExpression, "block to attribute", priorBlock.Type(), currentAttribute.GetType())...)
+
+			continue
+		}
+
+		if currentAttribute.IsRequired() {
+			changes = append(changes, Change{
+				Expression: nameExpression,
+				Type:       ChangeTypeRequiredAttributeAdded,
+			})
+
+			continue
+		}
+
+		changes = append(changes, Change{
+			Expression: nameExpression,
+			Type:       ChangeTypeAttributeAdded,
+			Detail:     requiredness(currentAttribute),
+		})
+	}
+
+	for name, priorBlock := range priorBlocks {
+		nameExpression := expression.AtName(name)
+
+		if currentBlock, ok := currentBlocks[name]; ok {
+			changes = append(changes, block(ctx, nameExpression, priorBlock, currentBlock)...)
+
+			continue
+		}
+
+		if _, ok := currentAttributes[name]; ok {
+			// Already handled with the current attributes.
+			continue
+		}
+
+		changes = append(changes, Change{
+			Expression: nameExpression,
+			Type:       ChangeTypeBlockRemoved,
+		})
+	}
+
+	for name := range currentBlocks {
+		if _, ok := priorBlocks[name]; ok {
+			continue
+		}
+
+		if _, ok := priorAttributes[name]; ok {
+			// Already handled with the prior attributes.
+			continue
+		}
+
+		changes = append(changes, Change{
+			Expression: expression.AtName(name),
+			Type:       ChangeTypeBlockAdded,
+		})
+	}
+
+	return changes
+}
+
+// attribute returns the differences between two attributes with the same
+// name.
+func attribute(ctx context.Context, expression path.Expression, prior, current fwschema.Attribute) Changes {
+	var changes Changes
+
+	priorRequiredness := requiredness(prior)
+	currentRequiredness := requiredness(current)
+
+	if priorRequiredness != currentRequiredness {
+		change := Change{
+			Expression: expression,
+			Type:       ChangeTypeRequirednessRelaxed,
+			Detail:     priorRequiredness + " to " + currentRequiredness,
+		}
+
+		if (!prior.IsRequired() && current.IsRequired()) || (configurable(prior) && !configurable(current)) {
+			change.Type = ChangeTypeRequirednessTightened
+		}
+
+		changes = append(changes, change)
+	}
+
+	if prior.IsSensitive() != current.IsSensitive() {
+		changes = append(changes, Change{
+			Expression: expression,
+			Type:       ChangeTypeSensitiveChanged,
+			Detail:     fmt.Sprintf("%t to %t", prior.IsSensitive(), current.IsSensitive()),
+		})
+	}
+
+	priorNested, priorOk := prior.(fwschema.NestedAttribute)
+	currentNested, currentOk := current.(fwschema.NestedAttribute)
+
+	if !priorOk || !currentOk {
+		return append(changes, typeChange(ctx, expression, prior.GetType(), current.GetType())...)
+	}
+
+	if priorNested.GetNestingMode() != currentNested.GetNestingMode() {
+		return append(changes, Change{
+			Expression: expression,
+			Type:       ChangeTypeNestingModeChanged,
+			Detail:     nestingMode(priorNested.GetNestingMode()) + " to " + nestingMode(currentNested.GetNestingMode()),
+		})
+	}
+
+	var nestedExpression path.Expression
+
+	switch priorNested.GetNestingMode() {
+	case fwschema.NestingModeList:
+		nestedExpression = expression.AtAnyListIndex()
+	case fwschema.NestingModeMap:
+		nestedExpression = expression.AtAnyMapKey()
+	case fwschema.NestingModeSet:
+		nestedExpression = expression.AtAnySetValue()
+	default:
+		nestedExpression = expression
+	}
+
+	return append(changes, attributesAndBlocks(
+		ctx,
+		nestedExpression,
+		priorNested.GetNestedObject().GetAttributes(),
+		currentNested.GetNestedObject().GetAttributes(),
+		nil,
+		nil,
+	)...)
+}
+
+// block returns the differences between two blocks with the same name.
+func block(ctx context.Context, expression path.Expression, prior, current fwschema.Block) Changes {
+	if prior.GetNestingMode() != current.GetNestingMode() {
+		return Changes{
+			{
+				Expression: expression,
+				Type:       ChangeTypeNestingModeChanged,
+				Detail:     blockNestingMode(prior.GetNestingMode()) + " to " + blockNestingMode(current.GetNestingMode()),
+			},
+		}
+	}
+
+	var nestedExpression path.Expression
+
+	switch prior.GetNestingMode() {
+	case fwschema.BlockNestingModeList:
+		nestedExpression = expression.AtAnyListIndex()
+	case fwschema.BlockNestingModeSet:
+		nestedExpression = expression.AtAnySetValue()
+	default:
+		nestedExpression = expression
+	}
+
+	return attributesAndBlocks(
+		ctx,
+		nestedExpression,
+		prior.GetNestedObject().GetAttributes(),
+		current.GetNestedObject().GetAttributes(),
+		prior.GetNestedObject().GetBlocks(),
+		current.GetNestedObject().GetBlocks(),
+	)
+}
+
+// swapped returns the differences for an attribute which became a block or a
+// block which became an attribute.
+func swapped(ctx context.Context, expression path.Expression, detail string, prior, current attr.Type) Changes {
+	changes := Changes{
+		{
+			Expression: expression,
+			Type:       ChangeTypeAttributeBlockSwapped,
+			Detail:     detail,
+		},
+	}
+
+	return append(changes, typeChange(ctx, expression, prior, current)...)
+}
+
+// typeChange returns a ChangeTypeTypeChanged difference if the underlying
+// Terraform types are not equal. Custom types with the same underlying
+// Terraform type do not affect configuration or state.
+func typeChange(ctx context.Context, expression path.Expression, prior, current attr.Type) Changes {
+	priorType := prior.TerraformType(ctx)
+	currentType := current.TerraformType(ctx)
+
+	if priorType.Equal(currentType) {
+		return nil
+	}
+
+	return Changes{
+		{
+			Expression: expression,
+			Type:       ChangeTypeTypeChanged,
+			Detail:     priorType.String() + " to " + currentType.String(),
+		},
+	}
+}
+
+// configurable returns true if the attribute can be set in configuration.
+func configurable(a fwschema.Attribute) bool {
+	return a.IsRequired() || a.IsOptional()
+}
+
+// requiredness returns a human readable representation of the Required,
+// Optional, and Computed fields of an attribute.
+func requiredness(a fwschema.Attribute) string {
+	switch {
+	case a.IsRequired():
+		return "Required"
+	case a.IsOptional() && a.IsComputed():
+		return "Optional and Computed"
+	case a.IsOptional():
+		return "Optional"
+	case a.IsComputed():
+		return "Computed"
+	default:
+		return "unset"
+	}
+}
+
+// nestingMode returns a human readable representation of a NestingMode.
+func nestingMode(m fwschema.NestingMode) string {
+	switch m {
+	case fwschema.NestingModeSingle:
+		return "single"
+	case fwschema.NestingModeList:
+		return "list"
+	case fwschema.NestingModeSet:
+		return "set"
+	case fwschema.NestingModeMap:
+		return "map"
+	default:
+		return "unknown"
+	}
+}
+
+// blockNestingMode returns a human readable representation of a
+// BlockNestingMode.
+func blockNestingMode(m fwschema.BlockNestingMode) string {
+	switch m {
+	case fwschema.BlockNestingModeList:
+		return "list"
+	case fwschema.BlockNestingModeSet:
+		return "set"
+	case fwschema.BlockNestingModeSingle:
+		return "single"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,382 @@
+package fwschemadiff_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadiff"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	testtypes "github.com/hashicorp/terraform-plugin-framework/internal/testing/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSchemas(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		prior    fwschema.Schema
+		current  fwschema.Schema
+		expected fwschemadiff.Changes
+	}{
+		"no-changes": {
+			prior: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"test": testschema.Attribute{
+						Optional: true,
+						Type:     types.StringType,
+					},
+				},
+			},
+			current: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"test": testschema.Attribute{
+						Description: "Descriptions are ignored.",
+						Optional:    true,
+						Type:        types.StringType,
+					},
+				},
+				Version: 1,
+			},
+			expected: nil,
+		},
+		"custom-type-same-terraform-type": {
+			prior: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"test": testschema.Attribute{
+						Optional: true,
+						Type:     types.StringType,
+					},
+				},
+			},
+			current: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"test": testschema.Attribute{
+						Optional: true,
+						Type:     testtypes.StringType{},
+					},
+				},
+			},
+			expected: nil,
+		},
+		"attributes-added-removed": {
+			prior: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"removed": testschema.Attribute{
+						Optional: true,
+						Type:     types.StringType,
+					},
+				},
+			},
+			current: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"added": testschema.Attribute{
+						Optional: true,
+						Computed: true,
+						Type:     types.StringType,
+					},
+					"added_required": testschema.Attribute{
+						Required: true,
+						Type:     types.StringType,
+					},
+				},
+			},
+			expected: fwschemadiff.Changes{
+				{
+					Expression: path.MatchRoot("added"),
+					Type:       fwschemadiff.ChangeTypeAttributeAdded,
+					Detail:     "Optional and Computed",
+				},
+				{
+					Expression: path.MatchRoot("added_required"),
+					Type:       fwschemadiff.ChangeTypeRequiredAttributeAdded,
+				},
+				{
+					Expression: path.MatchRoot("removed"),
+					Type:       fwschemadiff.ChangeTypeAttributeRemoved,
+				},
+			},
+		},
+		"attribute-changes": {
+			prior: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"computed": testschema.Attribute{
+						Optional: true,
+						Type:     types.StringType,
+					},
+					"relaxed": testschema.Attribute{
+						Required: true,
+						Type:     types.StringType,
+					},
+					"required": testschema.Attribute{
+						Optional: true,
+						Computed: true,
+						Type:     types.StringType,
+					},
+					"sensitive": testschema.Attribute{
+						Optional: true,
+						Type:     types.StringType,
+					},
+					"type": testschema.Attribute{
+						Optional: true,
+						Type:     types.ListType{ElemType: types.StringType},
+					},
+				},
+			},
+			current: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"computed": testschema.Attribute{
+						Computed: true,
+						Type:     types.StringType,
+					},
+					"relaxed": testschema.Attribute{
+						Optional: true,
+						Type:     types.StringType,
+					},
+					"required": testschema.Attribute{
+						Required: true,
+						Type:     types.StringType,
+					},
+					"sensitive": testschema.Attribute{
+						Optional:  true,
+						Sensitive: true,
+						Type:      types.StringType,
+					},
+					"type": testschema.Attribute{
+						Optional: true,
+						Type:     types.SetType{ElemType: types.StringType},
+					},
+				},
+			},
+			expected: fwschemadiff.Changes{
+				{
+					Expression: path.MatchRoot("computed"),
+					Type:       fwschemadiff.ChangeTypeRequirednessTightened,
+					Detail:     "Optional to Computed",
+				},
+				{
+					Expression: path.MatchRoot("relaxed"),
+					Type:       fwschemadiff.ChangeTypeRequirednessRelaxed,
+					Detail:     "Required to Optional",
+				},
+				{
+					Expression: path.MatchRoot("required"),
+					Type:       fwschemadiff.ChangeTypeRequirednessTightened,
+					Detail:     "Optional and Computed to Required",
+				},
+				{
+					Expression: path.MatchRoot("sensitive"),
+					Type:       fwschemadiff.ChangeTypeSensitiveChanged,
+					Detail:     "false to true",
+				},
+				{
+					Expression: path.MatchRoot("type"),
+					Type:       fwschemadiff.ChangeTypeTypeChanged,
+					Detail:     "tftypes.List[tftypes.String] to tftypes.Set[tftypes.String]",
+				},
+			},
+		},
+		"nested-attributes": {
+			prior: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"list": testschema.NestedAttribute{
+						NestedObject: testschema.NestedAttributeObject{
+							Attributes: map[string]fwschema.Attribute{
+								"removed": testschema.Attribute{
+									Optional: true,
+									Type:     types.StringType,
+								},
+							},
+						},
+						NestingMode: fwschema.NestingModeList,
+						Optional:    true,
+					},
+					"single": testschema.NestedAttribute{
+						NestedObject: testschema.NestedAttributeObject{
+							Attributes: map[string]fwschema.Attribute{
+								"test": testschema.Attribute{
+									Optional: true,
+									Type:     types.StringType,
+								},
+							},
+						},
+						NestingMode: fwschema.NestingModeSingle,
+						Optional:    true,
+					},
+				},
+			},
+			current: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"list": testschema.NestedAttribute{
+						NestedObject: testschema.NestedAttributeObject{},
+						NestingMode:  fwschema.NestingModeList,
+						Optional:     true,
+					},
+					"single": testschema.NestedAttribute{
+						NestedObject: testschema.NestedAttributeObject{
+							Attributes: map[string]fwschema.Attribute{
+								"test": testschema.Attribute{
+									Optional: true,
+									Type:     types.StringType,
+								},
+							},
+						},
+						NestingMode: fwschema.NestingModeMap,
+						Optional:    true,
+					},
+				},
+			},
+			expected: fwschemadiff.Changes{
+				{
+					Expression: path.MatchRoot("list").AtAnyListIndex().AtName("removed"),
+					Type:       fwschemadiff.ChangeTypeAttributeRemoved,
+				},
+				{
+					Expression: path.MatchRoot("single"),
+					Type:       fwschemadiff.ChangeTypeNestingModeChanged,
+					Detail:     "single to map",
+				},
+			},
+		},
+		"blocks": {
+			prior: testschema.Schema{
+				Blocks: map[string]fwschema.Block{
+					"list": testschema.Block{
+						NestedObject: testschema.NestedBlockObject{
+							Blocks: map[string]fwschema.Block{
+								"removed": testschema.Block{
+									NestingMode: fwschema.BlockNestingModeSingle,
+								},
+							},
+						},
+						NestingMode: fwschema.BlockNestingModeList,
+					},
+					"set": testschema.Block{
+						NestingMode: fwschema.BlockNestingModeSet,
+					},
+				},
+			},
+			current: testschema.Schema{
+				Blocks: map[string]fwschema.Block{
+					"added": testschema.Block{
+						NestingMode: fwschema.BlockNestingModeList,
+					},
+					"list": testschema.Block{
+						NestedObject: testschema.NestedBlockObject{
+							Attributes: map[string]fwschema.Attribute{
+								"test": testschema.Attribute{
+									Optional: true,
+									Type:     types.StringType,
+								},
+							},
+						},
+						NestingMode: fwschema.BlockNestingModeList,
+					},
+					"set": testschema.Block{
+						NestingMode: fwschema.BlockNestingModeList,
+					},
+				},
+			},
+			expected: fwschemadiff.Changes{
+				{
+					Expression: path.MatchRoot("added"),
+					Type:       fwschemadiff.ChangeTypeBlockAdded,
+				},
+				{
+					Expression: path.MatchRoot("list").AtAnyListIndex().AtName("removed"),
+					Type:       fwschemadiff.ChangeTypeBlockRemoved,
+				},
+				{
+					Expression: path.MatchRoot("list").AtAnyListIndex().AtName("test"),
+					Type:       fwschemadiff.ChangeTypeAttributeAdded,
+					Detail:     "Optional",
+				},
+				{
+					Expression: path.MatchRoot("set"),
+					Type:       fwschemadiff.ChangeTypeNestingModeChanged,
+					Detail:     "set to list",
+				},
+			},
+		},
+		"attribute-block-swapped": {
+			prior: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"attribute_to_block": testschema.NestedAttribute{
+						NestedObject: testschema.NestedAttributeObject{
+							Attributes: map[string]fwschema.Attribute{
+								"test": testschema.Attribute{
+									Optional: true,
+									Type:     types.StringType,
+								},
+							},
+						},
+						NestingMode: fwschema.NestingModeList,
+						Optional:    true,
+					},
+				},
+				Blocks: map[string]fwschema.Block{
+					"block_to_attribute": testschema.Block{
+						NestedObject: testschema.NestedBlockObject{},
+						NestingMode:  fwschema.BlockNestingModeSingle,
+					},
+				},
+			},
+			current: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"block_to_attribute": testschema.Attribute{
+						Optional: true,
+						Type:     types.ObjectType{AttrTypes: map[string]attr.Type{"test": types.StringType}},
+					},
+				},
+				Blocks: map[string]fwschema.Block{
+					"attribute_to_block": testschema.Block{
+						NestedObject: testschema.NestedBlockObject{
+							Attributes: map[string]fwschema.Attribute{
+								"test": testschema.Attribute{
+									Optional: true,
+									Type:     types.StringType,
+								},
+							},
+						},
+						NestingMode: fwschema.BlockNestingModeList,
+					},
+				},
+			},
+			expected: fwschemadiff.Changes{
+				{
+					Expression: path.MatchRoot("attribute_to_block"),
+					Type:       fwschemadiff.ChangeTypeAttributeBlockSwapped,
+					Detail:     "attribute to block",
+				},
+				{
+					Expression: path.MatchRoot("block_to_attribute"),
+					Type:       fwschemadiff.ChangeTypeAttributeBlockSwapped,
+					Detail:     "block to attribute",
+				},
+				{
+					Expression: path.MatchRoot("block_to_attribute"),
+					Type:       fwschemadiff.ChangeTypeTypeChanged,
+					Detail:     `tftypes.Object[] to tftypes.Object["test":tftypes.String]`,
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwschemadiff.Schemas(context.Background(), testCase.prior, testCase.current)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package fwschemadiff
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+)
+
+// stateShapeHashLength is the number of hexadecimal characters kept from each
+// state shape hash. Hashes are only compared within a single resource schema,
+// so collisions are not a practical concern.
+const stateShapeHashLength = 12
+
+// StateShape returns hashes of the parts of the schema which affect saved
+// state. Keys are hashes of the path of each attribute, including attributes
+// of nested objects, without any list, map, or set element steps. Values are
+// hashes of the attribute type, including its list, map, and set nesting
+// modes, but excluding the attributes of any nested objects, which have their
+// own keys.
+//
+// Attributes with a different type hash in the prior and current shapes
+// identify type and nesting mode changes, which affect saved state. Use
+// StateShapeChanged for the comparison.
+func StateShape(ctx context.Context, s fwschema.Schema) map[string]string {
+	shapes := make(map[string]string)
+
+	objectStateShapes(s.Type().TerraformType(ctx), "", shapes)
+
+	return shapes
+}
+
+// StateShapeChanged returns true if any attribute in both the prior and
+// current state shapes, as returned by StateShape, has a different type.
+// Removed attributes are ignored, as the framework drops undefined attributes
+// from saved state of the same schema version, and added attributes have no
+// saved state.
+func StateShapeChanged(prior map[string]string, current map[string]string) bool {
+	for path, priorType := range prior {
+		currentType, ok := current[path]
+
+		if ok && currentType != priorType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// objectStateShapes adds the path and type hashes of each attribute of the
+// given type, if it is an object, and of any nested object attributes.
+func objectStateShapes(typ tftypes.Type, prefix string, shapes map[string]string) {
+	switch typ := typ.(type) {
+	case tftypes.Object:
+		for name, attributeType := range typ.AttributeTypes {
+			path := prefix + "." + name
+
+			shapes[stateShapeHash(path)] = stateShapeHash(stateShapeType(attributeType))
+
+			objectStateShapes(attributeType, path, shapes)
+		}
+	case tftypes.List:
+		objectStateShapes(typ.ElementType, prefix, shapes)
+	case tftypes.Map:
+		objectStateShapes(typ.ElementType, prefix, shapes)
+	case tftypes.Set:
+		objectStateShapes(typ.ElementType, prefix, shapes)
+	case tftypes.Tuple:
+		for _, elementType := range typ.ElementTypes {
+			objectStateShapes(elementType, prefix, shapes)
+		}
+	}
+}
+
+// stateShapeType returns a description of the type, without the attributes
+// of objects.
+func stateShapeType(typ tftypes.Type) string {
+	switch typ := typ.(type) {
+	case tftypes.Object:
+		return "object"
+	case tftypes.List:
+		return "list(" + stateShapeType(typ.ElementType) + ")"
+	case tftypes.Map:
+		return "map(" + stateShapeType(typ.ElementType) + ")"
+	case tftypes.Set:
+		return "set(" + stateShapeType(typ.ElementType) + ")"
+	case tftypes.Tuple:
+		elementTypes := make([]string, 0, len(typ.ElementTypes))
+
+		for _, elementType := range typ.ElementTypes {
+			elementTypes = append(elementTypes, stateShapeType(elementType))
+		}
+
+		return "tuple(" + strings.Join(elementTypes, ",") + ")"
+	default:
+		return typ.String()
+	}
+}
+
+// stateShapeHash returns the truncated hash of the given description.
+func stateShapeHash(description string) string {
+	sum := sha256.Sum256([]byte(description))
+
+	return hex.EncodeToString(sum[:])[:stateShapeHashLength]
+}
@@ -0,0 +1,215 @@
+package fwschemadiff_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadiff"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestStateShapeChanged(t *testing.T) {
+	t.Parallel()
+
+	prior := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"tags": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"rule": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"port": schema.Int64Attribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		current  schema.Schema
+		expected bool
+	}{
+		"unchanged": {
+			current:  prior,
+			expected: false,
+		},
+		"non-state-changes": {
+			current: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed:    true,
+						Description: "test description",
+					},
+					"tags": schema.MapAttribute{
+						ElementType: types.StringType,
+						Required:    true,
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"rule": schema.ListNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"port": schema.Int64Attribute{
+									Optional: true,
+								},
+							},
+						},
+					},
+				},
+				Version: 1,
+			},
+			expected: false,
+		},
+		"attribute-added": {
+			current: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed: true,
+					},
+					"name": schema.StringAttribute{
+						Optional: true,
+					},
+					"tags": schema.MapAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"rule": schema.ListNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"port": schema.Int64Attribute{
+									Required: true,
+								},
+								"protocol": schema.StringAttribute{
+									Optional: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: false,
+		},
+		"attribute-removed": {
+			current: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed: true,
+					},
+				},
+				Blocks: prior.Blocks,
+			},
+			expected: false,
+		},
+		"nested-attribute-removed": {
+			current: schema.Schema{
+				Attributes: prior.Attributes,
+				Blocks: map[string]schema.Block{
+					"rule": schema.ListNestedBlock{},
+				},
+			},
+			expected: false,
+		},
+		"attribute-changed-to-nested": {
+			current: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed: true,
+					},
+					"tags": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"name": schema.StringAttribute{
+								Optional: true,
+							},
+						},
+						Optional: true,
+					},
+				},
+				Blocks: prior.Blocks,
+			},
+			expected: true,
+		},
+		"attribute-type-changed": {
+			current: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed: true,
+					},
+					"tags": schema.MapAttribute{
+						ElementType: types.Int64Type,
+						Optional:    true,
+					},
+				},
+				Blocks: prior.Blocks,
+			},
+			expected: true,
+		},
+		"block-nesting-mode-changed": {
+			current: schema.Schema{
+				Attributes: prior.Attributes,
+				Blocks: map[string]schema.Block{
+					"rule": schema.SetNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"port": schema.Int64Attribute{
+									Required: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: true,
+		},
+		"nested-attribute-type-changed": {
+			current: schema.Schema{
+				Attributes: prior.Attributes,
+				Blocks: map[string]schema.Block{
+					"rule": schema.ListNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"port": schema.StringAttribute{
+									Required: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			priorHashes := fwschemadiff.StateShape(context.Background(), prior)
+
+			if diff := cmp.Diff(priorHashes, fwschemadiff.StateShape(context.Background(), prior)); diff != "" {
+				t.Fatalf("unexpected nondeterministic hashes: %s", diff)
+			}
+
+			got := fwschemadiff.StateShapeChanged(priorHashes, fwschemadiff.StateShape(context.Background(), testCase.current))
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
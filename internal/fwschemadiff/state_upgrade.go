@@ -0,0 +1,96 @@
+package fwschemadiff
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// ResourceStateUpgradeDiagnostics returns diagnostics when the changes from
+// the prior to the current resource schema are not accompanied by the
+// necessary schema version increment and StateUpgrader. The changes should be
+// the result of Schemas for the same prior and current schemas.
+//
+// The StateUpgrader checks are skipped if upgraders is nil, such as when only
+// the schema information is available. Use an empty map for resources which
+// do not implement ResourceWithUpgradeState.
+func ResourceStateUpgradeDiagnostics(ctx context.Context, typeName string, prior fwschema.Schema, current fwschema.Schema, changes Changes, upgraders map[int64]resource.StateUpgrader) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	priorVersion := prior.GetVersion()
+	currentVersion := current.GetVersion()
+
+	if currentVersion < priorVersion {
+		diags.AddError(
+			"Invalid Resource Schema Version",
+			fmt.Sprintf("The %s resource schema version was decreased from %d to %d. ", typeName, priorVersion, currentVersion)+
+				"Terraform will return an error for any saved state with a version newer than the schema version.",
+		)
+
+		return diags
+	}
+
+	stateAffecting := changes.StateAffecting()
+
+	if currentVersion == priorVersion {
+		if len(stateAffecting) > 0 {
+			diags.AddError(
+				"Missing Resource Schema Version Increment",
+				fmt.Sprintf("The %s resource schema has changes which affect saved state, however the schema version was not increased from %d. ", typeName, priorVersion)+
+					fmt.Sprintf("Increase the resource schema Version and implement a StateUpgrader for version %d in the resource UpgradeState method.\n\n", priorVersion)+
+					"Changes:\n\n"+changesList(stateAffecting),
+			)
+		}
+
+		return diags
+	}
+
+	if upgraders == nil {
+		return diags
+	}
+
+	upgrader, ok := upgraders[priorVersion]
+
+	if !ok {
+		diags.AddError(
+			"Missing Resource State Upgrader",
+			fmt.Sprintf("The %s resource schema version was increased from %d to %d, ", typeName, priorVersion, currentVersion)+
+				fmt.Sprintf("however the resource UpgradeState method does not implement a StateUpgrader for version %d. ", priorVersion)+
+				fmt.Sprintf("Terraform will return an error when upgrading saved state from version %d.", priorVersion),
+		)
+
+		return diags
+	}
+
+	if upgrader.PriorSchema == nil {
+		return diags
+	}
+
+	priorSchemaChanges := Schemas(ctx, prior, *upgrader.PriorSchema).StateAffecting()
+
+	if len(priorSchemaChanges) > 0 {
+		diags.AddWarning(
+			"Resource State Upgrader Prior Schema Mismatch",
+			fmt.Sprintf("The %s resource StateUpgrader for version %d defines a PriorSchema which differs from the version %d schema in ways which affect saved state. ", typeName, priorVersion, priorVersion)+
+				"Saved state may not be readable during the upgrade.\n\n"+
+				"Differences:\n\n"+changesList(priorSchemaChanges),
+		)
+	}
+
+	return diags
+}
+
+// changesList returns a human readable list of changes for diagnostics.
+func changesList(changes Changes) string {
+	lines := make([]string, 0, len(changes))
+
+	for _, change := range changes {
+		lines = append(lines, "- "+change.String())
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,176 @@
+package fwschemadiff_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadiff"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func TestResourceStateUpgradeDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	schemaV0 := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"changed": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+
+	schemaV1 := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"changed": schema.Int64Attribute{
+				Optional: true,
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Version: 1,
+	}
+
+	schemaV0NoStateChanges := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"changed": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	schemaV0AttributeRemoved := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		prior     schema.Schema
+		current   schema.Schema
+		upgraders map[int64]resource.StateUpgrader
+		expected  diag.Diagnostics
+	}{
+		"no-state-affecting-changes": {
+			prior:     schemaV0,
+			current:   schemaV0NoStateChanges,
+			upgraders: map[int64]resource.StateUpgrader{},
+			expected:  nil,
+		},
+		"attribute-removed": {
+			prior:     schemaV0,
+			current:   schemaV0AttributeRemoved,
+			upgraders: map[int64]resource.StateUpgrader{},
+			expected:  nil,
+		},
+		"missing-version-increment": {
+			prior:     schemaV0,
+			current:   schema.Schema{Attributes: schemaV1.Attributes},
+			upgraders: map[int64]resource.StateUpgrader{},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Missing Resource Schema Version Increment",
+					"The examplecloud_thing resource schema has changes which affect saved state, however the schema version was not increased from 0. "+
+						"Increase the resource schema Version and implement a StateUpgrader for version 0 in the resource UpgradeState method.\n\n"+
+						"Changes:\n\n"+
+						"- changed: type changed (tftypes.String to tftypes.Number)",
+				),
+			},
+		},
+		"version-decreased": {
+			prior:     schemaV1,
+			current:   schemaV0,
+			upgraders: map[int64]resource.StateUpgrader{},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Resource Schema Version",
+					"The examplecloud_thing resource schema version was decreased from 1 to 0. "+
+						"Terraform will return an error for any saved state with a version newer than the schema version.",
+				),
+			},
+		},
+		"missing-state-upgrader": {
+			prior:     schemaV0,
+			current:   schemaV1,
+			upgraders: map[int64]resource.StateUpgrader{},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Missing Resource State Upgrader",
+					"The examplecloud_thing resource schema version was increased from 0 to 1, "+
+						"however the resource UpgradeState method does not implement a StateUpgrader for version 0. "+
+						"Terraform will return an error when upgrading saved state from version 0.",
+				),
+			},
+		},
+		"unknown-state-upgraders": {
+			prior:     schemaV0,
+			current:   schemaV1,
+			upgraders: nil,
+			expected:  nil,
+		},
+		"state-upgrader": {
+			prior:   schemaV0,
+			current: schemaV1,
+			upgraders: map[int64]resource.StateUpgrader{
+				0: {
+					PriorSchema: &schemaV0,
+				},
+			},
+			expected: nil,
+		},
+		"state-upgrader-without-prior-schema": {
+			prior:   schemaV0,
+			current: schemaV1,
+			upgraders: map[int64]resource.StateUpgrader{
+				0: {},
+			},
+			expected: nil,
+		},
+		"state-upgrader-prior-schema-mismatch": {
+			prior:   schemaV0,
+			current: schemaV1,
+			upgraders: map[int64]resource.StateUpgrader{
+				0: {
+					PriorSchema: &schemaV1,
+				},
+			},
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"Resource State Upgrader Prior Schema Mismatch",
+					"The examplecloud_thing resource StateUpgrader for version 0 defines a PriorSchema which differs from the version 0 schema in ways which affect saved state. "+
+						"Saved state may not be readable during the upgrade.\n\n"+
+						"Differences:\n\n"+
+						"- changed: type changed (tftypes.String to tftypes.Number)",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			changes := fwschemadiff.Schemas(context.Background(), testCase.prior, testCase.current)
+
+			got := fwschemadiff.ResourceStateUpgradeDiagnostics(context.Background(), "examplecloud_thing", testCase.prior, testCase.current, changes, testCase.upgraders)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
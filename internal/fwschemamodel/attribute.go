@@ -0,0 +1,405 @@
+package fwschemamodel
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	internalreflect "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	attrValueType = reflect.TypeOf((*attr.Value)(nil)).Elem()
+	bigFloatType  = reflect.TypeOf(big.NewFloat(0))
+	bigIntType    = reflect.TypeOf(big.NewInt(0))
+)
+
+// Attribute is a schema attribute derived from a model struct field.
+type Attribute struct {
+	// Name is the attribute name.
+	Name string
+
+	// Path is the attribute path, without element steps.
+	Path path.Path
+
+	// Type is the attribute type of a non-nested attribute.
+	Type attr.Type
+
+	// NestingMode is the nesting mode of a nested attribute, otherwise
+	// zero.
+	NestingMode fwschema.NestingMode
+
+	// Attributes are the underlying attributes of a nested attribute, in
+	// struct field order.
+	Attributes []Attribute
+
+	Computed            bool
+	Description         string
+	MarkdownDescription string
+	Optional            bool
+	Required            bool
+	Sensitive           bool
+
+	// Overridden is true if the attribute will be replaced by the caller.
+	// Only the Name and Path fields are set.
+	Overridden bool
+}
+
+// Attributes returns the schema attributes derived from the fields of the
+// given model struct type. Pointer types are dereferenced.
+//
+// Struct fields of attr.Value types use the attribute type of the zero value,
+// which must be fully defined, so collection and object value types such as
+// types.List require an overridden attribute. Other struct fields are mapped
+// to attributes as follows:
+//
+//   - bool: Bool
+//   - int and uint kinds: Int64
+//   - float kinds: Float64
+//   - string: String
+//   - time.Duration, time.Time, and other text types: String, which must
+//     only be computed since values are written in their canonical form
+//   - *big.Float and *big.Int: Number
+//   - struct: single nested attribute
+//   - slice or array of struct: list nested attribute, or set nested with the
+//     set tag option
+//   - map with string keys of struct: map nested attribute
+//   - other slices, arrays, and maps: List, Set, or Map of the element type
+//
+// Nested objects within collection element types are Object types. Field
+// options for paths which do not match a derived attribute are an error.
+func Attributes(ctx context.Context, typ reflect.Type, opts Options) ([]Attribute, error) {
+	attributes, err := structAttributes(ctx, typ, path.Empty(), opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	derived := make(map[string]struct{})
+
+	walkAttributes(attributes, func(a Attribute) {
+		derived[a.Path.String()] = struct{}{}
+	})
+
+	var unknown []string
+
+	for key := range opts.Fields {
+		if _, ok := derived[key]; !ok {
+			unknown = append(unknown, key)
+		}
+	}
+
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+
+		return nil, fmt.Errorf("%s: option path does not match a model field", unknown[0])
+	}
+
+	return attributes, nil
+}
+
+// walkAttributes calls the given function for each attribute, including
+// nested attributes.
+func walkAttributes(attributes []Attribute, f func(Attribute)) {
+	for _, a := range attributes {
+		f(a)
+		walkAttributes(a.Attributes, f)
+	}
+}
+
+func structAttributes(ctx context.Context, typ reflect.Type, p path.Path, opts Options) ([]Attribute, error) {
+	fields, err := internalreflect.StructFields(typ, p)
+
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := make([]Attribute, 0, len(fields))
+
+	for _, field := range fields {
+		attribute, err := fieldAttribute(ctx, field, p.AtName(field.Name), opts)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes = append(attributes, attribute)
+	}
+
+	return attributes, nil
+}
+
+func fieldAttribute(ctx context.Context, field internalreflect.StructField, p path.Path, opts Options) (Attribute, error) {
+	fieldOptions := opts.Fields[p.String()]
+
+	if fieldOptions.Overridden {
+		return Attribute{
+			Name:       field.Name,
+			Path:       p,
+			Overridden: true,
+		}, nil
+	}
+
+	var set bool
+
+	for _, option := range field.Options {
+		switch option {
+		case TagOptionComputed:
+			fieldOptions.Computed = true
+		case TagOptionOptional:
+			fieldOptions.Optional = true
+		case TagOptionRequired:
+			fieldOptions.Required = true
+		case TagOptionSensitive:
+			fieldOptions.Sensitive = true
+		case TagOptionSet:
+			set = true
+		default:
+			return Attribute{}, fmt.Errorf("%s: unknown struct tag option %q on %s", p, option, field.Field.Name)
+		}
+	}
+
+	if !fieldOptions.Required && !fieldOptions.Optional && !fieldOptions.Computed {
+		return Attribute{}, fmt.Errorf("%s: one of required, optional, or computed must be set on %s", p, field.Field.Name)
+	}
+
+	attribute, err := typeAttribute(ctx, field.Field.Type, p, set, opts)
+
+	if err != nil {
+		return Attribute{}, err
+	}
+
+	// Text values are written in their canonical text representation, such
+	// as "1h0m0s" for a time.Duration read from "1h", which Terraform would
+	// report as an inconsistent result for configured values.
+	if attribute.NestingMode == 0 && hasTextType(field.Field.Type) && (fieldOptions.Optional || fieldOptions.Required) {
+		return Attribute{}, fmt.Errorf("%s: %s can only be used for computed attributes, use a string type for configurable attributes", p, field.Field.Type)
+	}
+
+	attribute.Name = field.Name
+	attribute.Path = p
+	attribute.Computed = fieldOptions.Computed
+	attribute.Description = fieldOptions.Description
+	attribute.MarkdownDescription = fieldOptions.MarkdownDescription
+	attribute.Optional = fieldOptions.Optional
+	attribute.Required = fieldOptions.Required
+	attribute.Sensitive = fieldOptions.Sensitive
+
+	return attribute, nil
+}
+
+// typeAttribute returns the attribute with only the Type or the NestingMode
+// and Attributes fields set for a struct field type.
+func typeAttribute(ctx context.Context, typ reflect.Type, p path.Path, set bool, opts Options) (Attribute, error) {
+	typ = dereference(typ)
+
+	if isNestedObject(typ) {
+		attributes, err := structAttributes(ctx, typ, p, opts)
+
+		if err != nil {
+			return Attribute{}, err
+		}
+
+		return Attribute{NestingMode: fwschema.NestingModeSingle, Attributes: attributes}, nil
+	}
+
+	if set && typ.Kind() != reflect.Slice && typ.Kind() != reflect.Array {
+		return Attribute{}, fmt.Errorf("%s: set struct tag option requires a slice, got %s", p, typ)
+	}
+
+	switch typ.Kind() {
+	case reflect.Slice, reflect.Array:
+		if !isNestedObject(dereference(typ.Elem())) {
+			break
+		}
+
+		attributes, err := structAttributes(ctx, typ.Elem(), p, opts)
+
+		if err != nil {
+			return Attribute{}, err
+		}
+
+		if set {
+			return Attribute{NestingMode: fwschema.NestingModeSet, Attributes: attributes}, nil
+		}
+
+		return Attribute{NestingMode: fwschema.NestingModeList, Attributes: attributes}, nil
+	case reflect.Map:
+		if typ.Key().Kind() != reflect.String || !isNestedObject(dereference(typ.Elem())) {
+			break
+		}
+
+		attributes, err := structAttributes(ctx, typ.Elem(), p, opts)
+
+		if err != nil {
+			return Attribute{}, err
+		}
+
+		return Attribute{NestingMode: fwschema.NestingModeMap, Attributes: attributes}, nil
+	}
+
+	attrType, err := Type(ctx, typ, p)
+
+	if err != nil {
+		return Attribute{}, err
+	}
+
+	if set {
+		listType, ok := attrType.(types.ListType)
+
+		if !ok {
+			return Attribute{}, fmt.Errorf("%s: set struct tag option cannot be used with %s", p, typ)
+		}
+
+		attrType = types.SetType{ElemType: listType.ElemType}
+	}
+
+	return Attribute{Type: attrType}, nil
+}
+
+// Type returns the attribute type for a Go type, where structs are Object
+// types. Errors are prefixed with the given path.
+func Type(ctx context.Context, typ reflect.Type, p path.Path) (attr.Type, error) {
+	typ = dereference(typ)
+
+	if typ.Implements(attrValueType) {
+		return valueType(ctx, typ, p)
+	}
+
+	if typ == bigFloatType || typ == bigIntType {
+		return types.NumberType, nil
+	}
+
+	if internalreflect.IsTextType(typ) {
+		return types.StringType, nil
+	}
+
+	switch typ.Kind() {
+	case reflect.Bool:
+		return types.BoolType, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return types.Int64Type, nil
+	case reflect.Float32, reflect.Float64:
+		return types.Float64Type, nil
+	case reflect.String:
+		return types.StringType, nil
+	case reflect.Slice, reflect.Array:
+		elemType, err := Type(ctx, typ.Elem(), p)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return types.ListType{ElemType: elemType}, nil
+	case reflect.Map:
+		if typ.Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("%s: map key type must be string, got %s", p, typ.Key())
+		}
+
+		elemType, err := Type(ctx, typ.Elem(), p)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return types.MapType{ElemType: elemType}, nil
+	case reflect.Struct:
+		fields, err := internalreflect.StructFields(typ, p)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attrTypes := make(map[string]attr.Type, len(fields))
+
+		for _, field := range fields {
+			attrType, err := Type(ctx, field.Field.Type, p.AtName(field.Name))
+
+			if err != nil {
+				return nil, err
+			}
+
+			attrTypes[field.Name] = attrType
+		}
+
+		return types.ObjectType{AttrTypes: attrTypes}, nil
+	}
+
+	return nil, fmt.Errorf("%s: unsupported type %s", p, typ)
+}
+
+// valueType returns the attribute type of the zero value of an attr.Value
+// implementation, if the type is fully defined.
+func valueType(ctx context.Context, typ reflect.Type, p path.Path) (attr.Type, error) {
+	attrType := reflect.Zero(typ).Interface().(attr.Value).Type(ctx)
+
+	if attrType == nil {
+		return nil, fmt.Errorf("%s: attribute type of %s cannot be derived, override the attribute instead", p, typ)
+	}
+
+	if t, ok := attrType.(attr.TypeWithElementType); ok && t.ElementType() == nil {
+		return nil, fmt.Errorf("%s: element type of %s cannot be derived, override the attribute instead", p, typ)
+	}
+
+	if t, ok := attrType.(attr.TypeWithAttributeTypes); ok && len(t.AttributeTypes()) == 0 {
+		return nil, fmt.Errorf("%s: attribute types of %s cannot be derived, override the attribute instead", p, typ)
+	}
+
+	return attrType, nil
+}
+
+// dereference returns the underlying type of pointer types, except for
+// *big.Float and *big.Int, which are handled as numbers.
+func dereference(typ reflect.Type) reflect.Type {
+	for typ.Kind() == reflect.Ptr && typ != bigFloatType && typ != bigIntType {
+		typ = typ.Elem()
+	}
+
+	return typ
+}
+
+// hasTextType returns true if the type, or any element or object attribute
+// type within it, is converted using its text representation.
+func hasTextType(typ reflect.Type) bool {
+	typ = dereference(typ)
+
+	if internalreflect.IsTextType(typ) {
+		return true
+	}
+
+	switch typ.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice:
+		return hasTextType(typ.Elem())
+	case reflect.Struct:
+		if typ.Implements(attrValueType) {
+			return false
+		}
+
+		fields, err := internalreflect.StructFields(typ, path.Empty())
+
+		if err != nil {
+			return false
+		}
+
+		for _, field := range fields {
+			if hasTextType(field.Field.Type) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// isNestedObject returns true if the type is a struct which is not an
+// attr.Value implementation or converted from text, such as time.Time.
+func isNestedObject(typ reflect.Type) bool {
+	return typ.Kind() == reflect.Struct && !typ.Implements(attrValueType) && !internalreflect.IsTextType(typ)
+}
@@ -0,0 +1,327 @@
+package fwschemamodel_test
+
+import (
+	"context"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemamodel"
+	testtypes "github.com/hashicorp/terraform-plugin-framework/internal/testing/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestAttributes(t *testing.T) {
+	t.Parallel()
+
+	type nestedModel struct {
+		Value string `tfsdk:"value,required"`
+	}
+
+	testCases := map[string]struct {
+		model         any
+		opts          fwschemamodel.Options
+		expected      []fwschemamodel.Attribute
+		expectedError string
+	}{
+		"primitives": {
+			model: struct {
+				Bool     bool           `tfsdk:"bool,optional"`
+				Float64  float32        `tfsdk:"float64,optional"`
+				Int64    uint8          `tfsdk:"int64,optional"`
+				Number   *big.Float     `tfsdk:"number,optional"`
+				String   *string        `tfsdk:"string,required,sensitive"`
+				Computed string         `tfsdk:"computed,optional,computed"`
+				Excluded string         `tfsdk:"-"`
+				Created  time.Time      `tfsdk:"created,computed"`
+				Timeout  *time.Duration `tfsdk:"timeout,computed"`
+			}{},
+			expected: []fwschemamodel.Attribute{
+				{Name: "bool", Path: path.Root("bool"), Type: types.BoolType, Optional: true},
+				{Name: "float64", Path: path.Root("float64"), Type: types.Float64Type, Optional: true},
+				{Name: "int64", Path: path.Root("int64"), Type: types.Int64Type, Optional: true},
+				{Name: "number", Path: path.Root("number"), Type: types.NumberType, Optional: true},
+				{Name: "string", Path: path.Root("string"), Type: types.StringType, Required: true, Sensitive: true},
+				{Name: "computed", Path: path.Root("computed"), Type: types.StringType, Optional: true, Computed: true},
+				{Name: "created", Path: path.Root("created"), Type: types.StringType, Computed: true},
+				{Name: "timeout", Path: path.Root("timeout"), Type: types.StringType, Computed: true},
+			},
+		},
+		"values": {
+			model: &struct {
+				String *types.String     `tfsdk:"string,computed"`
+				Custom customStringValue `tfsdk:"custom,computed"`
+			}{},
+			expected: []fwschemamodel.Attribute{
+				{Name: "string", Path: path.Root("string"), Type: types.StringType, Computed: true},
+				{Name: "custom", Path: path.Root("custom"), Type: customStringType{}, Computed: true},
+			},
+		},
+		"collections": {
+			model: struct {
+				List   []string            `tfsdk:"list,optional"`
+				Map    map[string]int64    `tfsdk:"map,optional"`
+				Set    []types.String      `tfsdk:"set,optional,set"`
+				Object []map[string]nested `tfsdk:"object,optional"`
+				Array  [2]float64          `tfsdk:"array,optional"`
+			}{},
+			expected: []fwschemamodel.Attribute{
+				{Name: "list", Path: path.Root("list"), Type: types.ListType{ElemType: types.StringType}, Optional: true},
+				{Name: "map", Path: path.Root("map"), Type: types.MapType{ElemType: types.Int64Type}, Optional: true},
+				{Name: "set", Path: path.Root("set"), Type: types.SetType{ElemType: types.StringType}, Optional: true},
+				{
+					Name: "object",
+					Path: path.Root("object"),
+					Type: types.ListType{
+						ElemType: types.MapType{
+							ElemType: types.ObjectType{
+								AttrTypes: map[string]attr.Type{
+									"value": types.StringType,
+								},
+							},
+						},
+					},
+					Optional: true,
+				},
+				{Name: "array", Path: path.Root("array"), Type: types.ListType{ElemType: types.Float64Type}, Optional: true},
+			},
+		},
+		"nested": {
+			model: struct {
+				List   []nestedModel          `tfsdk:"list,optional"`
+				Map    map[string]nestedModel `tfsdk:"map,optional"`
+				Set    []*nestedModel         `tfsdk:"set,optional,set"`
+				Single *nestedModel           `tfsdk:"single,optional"`
+			}{},
+			expected: []fwschemamodel.Attribute{
+				{
+					Name:        "list",
+					Path:        path.Root("list"),
+					NestingMode: fwschema.NestingModeList,
+					Attributes: []fwschemamodel.Attribute{
+						{Name: "value", Path: path.Root("list").AtName("value"), Type: types.StringType, Required: true},
+					},
+					Optional: true,
+				},
+				{
+					Name:        "map",
+					Path:        path.Root("map"),
+					NestingMode: fwschema.NestingModeMap,
+					Attributes: []fwschemamodel.Attribute{
+						{Name: "value", Path: path.Root("map").AtName("value"), Type: types.StringType, Required: true},
+					},
+					Optional: true,
+				},
+				{
+					Name:        "set",
+					Path:        path.Root("set"),
+					NestingMode: fwschema.NestingModeSet,
+					Attributes: []fwschemamodel.Attribute{
+						{Name: "value", Path: path.Root("set").AtName("value"), Type: types.StringType, Required: true},
+					},
+					Optional: true,
+				},
+				{
+					Name:        "single",
+					Path:        path.Root("single"),
+					NestingMode: fwschema.NestingModeSingle,
+					Attributes: []fwschemamodel.Attribute{
+						{Name: "value", Path: path.Root("single").AtName("value"), Type: types.StringType, Required: true},
+					},
+					Optional: true,
+				},
+			},
+		},
+		"options": {
+			model: struct {
+				Name   string        `tfsdk:"name"`
+				List   types.List    `tfsdk:"list"`
+				Nested []nestedModel `tfsdk:"nested,optional"`
+			}{},
+			opts: optionsWith(
+				func(o *fwschemamodel.Options) {
+					o.FieldOptions(path.Root("name"), func(f *fwschemamodel.FieldOptions) {
+						f.Required = true
+						f.Description = "plain"
+						f.MarkdownDescription = "*markdown*"
+					})
+				},
+				func(o *fwschemamodel.Options) {
+					o.FieldOptions(path.Root("list"), func(f *fwschemamodel.FieldOptions) { f.Overridden = true })
+				},
+				func(o *fwschemamodel.Options) {
+					o.FieldOptions(path.Root("nested").AtListIndex(0).AtName("value"), func(f *fwschemamodel.FieldOptions) { f.Sensitive = true })
+				},
+			),
+			expected: []fwschemamodel.Attribute{
+				{Name: "name", Path: path.Root("name"), Type: types.StringType, Required: true, Description: "plain", MarkdownDescription: "*markdown*"},
+				{Name: "list", Path: path.Root("list"), Overridden: true},
+				{
+					Name:        "nested",
+					Path:        path.Root("nested"),
+					NestingMode: fwschema.NestingModeList,
+					Attributes: []fwschemamodel.Attribute{
+						{Name: "value", Path: path.Root("nested").AtName("value"), Type: types.StringType, Required: true, Sensitive: true},
+					},
+					Optional: true,
+				},
+			},
+		},
+		"embedded": {
+			model: struct {
+				*EmbeddedModel
+				Name string `tfsdk:"name,required"`
+			}{},
+			expected: []fwschemamodel.Attribute{
+				{Name: "id", Path: path.Root("id"), Type: types.StringType, Computed: true},
+				{Name: "name", Path: path.Root("name"), Type: types.StringType, Required: true},
+			},
+		},
+		"error-not-struct": {
+			model:         "test",
+			expectedError: `: can't get struct tags of string, is not a struct`,
+		},
+		"error-missing-requiredness": {
+			model: struct {
+				Name string `tfsdk:"name,sensitive"`
+			}{},
+			expectedError: `name: one of required, optional, or computed must be set on Name`,
+		},
+		"error-unknown-tag-option": {
+			model: struct {
+				Name string `tfsdk:"name,requried"`
+			}{},
+			expectedError: `name: unknown struct tag option "requried" on Name`,
+		},
+		"error-set-not-slice": {
+			model: struct {
+				Name string `tfsdk:"name,optional,set"`
+			}{},
+			expectedError: `name: set struct tag option requires a slice, got string`,
+		},
+		"error-map-key": {
+			model: struct {
+				Map map[int]string `tfsdk:"map,optional"`
+			}{},
+			expectedError: `map: map key type must be string, got int`,
+		},
+		"error-text-optional": {
+			model: struct {
+				Timeout *time.Duration `tfsdk:"timeout,optional,computed"`
+			}{},
+			expectedError: `timeout: *time.Duration can only be used for computed attributes, use a string type for configurable attributes`,
+		},
+		"error-text-element-required": {
+			model: struct {
+				Times []time.Time `tfsdk:"times,required"`
+			}{},
+			expectedError: `times: []time.Time can only be used for computed attributes, use a string type for configurable attributes`,
+		},
+		"error-unsupported-type": {
+			model: struct {
+				Func func() `tfsdk:"func,optional"`
+			}{},
+			expectedError: `func: unsupported type func()`,
+		},
+		"error-value-element-type": {
+			model: struct {
+				List types.List `tfsdk:"list,optional"`
+			}{},
+			expectedError: `list: element type of basetypes.ListValue cannot be derived, override the attribute instead`,
+		},
+		"error-value-type": {
+			model: struct {
+				String testtypes.String `tfsdk:"string,optional"`
+			}{},
+			expectedError: `string: attribute type of types.String cannot be derived, override the attribute instead`,
+		},
+		"error-value-attribute-types": {
+			model: struct {
+				Object types.Object `tfsdk:"object,optional"`
+			}{},
+			expectedError: `object: attribute types of basetypes.ObjectValue cannot be derived, override the attribute instead`,
+		},
+		"error-option-path": {
+			model: struct {
+				Name string `tfsdk:"name,optional"`
+			}{},
+			opts: optionsWith(func(o *fwschemamodel.Options) {
+				o.FieldOptions(path.Root("nmae"), func(f *fwschemamodel.FieldOptions) { f.Sensitive = true })
+			}),
+			expectedError: `nmae: option path does not match a model field`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := fwschemamodel.Attributes(context.Background(), reflect.TypeOf(testCase.model), testCase.opts)
+
+			if err != nil {
+				if testCase.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if err.Error() != testCase.expectedError {
+					t.Fatalf("expected error %q, got %q", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("expected error %q, got none", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+type customStringType struct {
+	basetypes.StringType
+}
+
+func (t customStringType) Equal(o attr.Type) bool {
+	_, ok := o.(customStringType)
+
+	return ok
+}
+
+type customStringValue struct {
+	basetypes.StringValue
+}
+
+func (v customStringValue) Type(_ context.Context) attr.Type {
+	return customStringType{}
+}
+
+type EmbeddedModel struct {
+	ID types.String `tfsdk:"id,computed"`
+}
+
+type nested struct {
+	Value string `tfsdk:"value"`
+}
+
+func optionsWith(fs ...func(*fwschemamodel.Options)) fwschemamodel.Options {
+	var opts fwschemamodel.Options
+
+	for _, f := range fs {
+		f(&opts)
+	}
+
+	return opts
+}
@@ -0,0 +1,199 @@
+package fwschemamodel
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Constructors create the schema package specific attribute, such as the
+// resource/schema package Attribute, of each kind of derived attribute. Custom
+// type arguments are nil when the derived type is the framework base type.
+type Constructors[A any] struct {
+	Bool    func(a Attribute, customType basetypes.BoolTypable) A
+	Float64 func(a Attribute, customType basetypes.Float64Typable) A
+	Int64   func(a Attribute, customType basetypes.Int64Typable) A
+	Number  func(a Attribute, customType basetypes.NumberTypable) A
+	String  func(a Attribute, customType basetypes.StringTypable) A
+
+	List   func(a Attribute, elementType attr.Type, customType basetypes.ListTypable) A
+	Map    func(a Attribute, elementType attr.Type, customType basetypes.MapTypable) A
+	Set    func(a Attribute, elementType attr.Type, customType basetypes.SetTypable) A
+	Object func(a Attribute, attributeTypes map[string]attr.Type, customType basetypes.ObjectTypable) A
+
+	ListNested   func(a Attribute, attributes map[string]A) A
+	MapNested    func(a Attribute, attributes map[string]A) A
+	SetNested    func(a Attribute, attributes map[string]A) A
+	SingleNested func(a Attribute, attributes map[string]A) A
+
+	// Validate, if set, is called with each derived attribute which is not
+	// overridden, before it is converted. Returning an error stops the
+	// conversion.
+	Validate func(a Attribute) error
+}
+
+// FromModel returns the schema attributes derived from the given model
+// struct, or pointer to struct, converted with the given constructors.
+// Overridden attributes are taken from the replacements, which are keyed by
+// attribute path string.
+func FromModel[A any](ctx context.Context, model any, opts Options, replacements map[string]A, c Constructors[A]) (map[string]A, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes, err := fromModel(ctx, model, opts, replacements, c)
+
+	if err != nil {
+		diags.AddError(
+			"Invalid Schema Model",
+			"Unable to derive the schema from the model type. This is always an error in the provider. "+
+				"Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	return attributes, diags
+}
+
+func fromModel[A any](ctx context.Context, model any, opts Options, replacements map[string]A, c Constructors[A]) (map[string]A, error) {
+	if model == nil {
+		return nil, fmt.Errorf("model must be a struct or pointer to struct, got nil")
+	}
+
+	derived, err := Attributes(ctx, reflect.TypeOf(model), opts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return convertAttributes(derived, replacements, c)
+}
+
+// convertAttributes returns the schema attributes of the derived attributes,
+// using the replacements of overridden attributes.
+func convertAttributes[A any](derived []Attribute, replacements map[string]A, c Constructors[A]) (map[string]A, error) {
+	attributes := make(map[string]A, len(derived))
+
+	for _, a := range derived {
+		if a.Overridden {
+			attributes[a.Name] = replacements[a.Path.String()]
+
+			continue
+		}
+
+		attribute, err := convertAttribute(a, replacements, c)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[a.Name] = attribute
+	}
+
+	return attributes, nil
+}
+
+func convertAttribute[A any](a Attribute, replacements map[string]A, c Constructors[A]) (A, error) {
+	var zero A
+
+	if c.Validate != nil {
+		if err := c.Validate(a); err != nil {
+			return zero, err
+		}
+	}
+
+	if a.NestingMode != 0 {
+		attributes, err := convertAttributes(a.Attributes, replacements, c)
+
+		if err != nil {
+			return zero, err
+		}
+
+		switch a.NestingMode {
+		case fwschema.NestingModeList:
+			return c.ListNested(a, attributes), nil
+		case fwschema.NestingModeMap:
+			return c.MapNested(a, attributes), nil
+		case fwschema.NestingModeSet:
+			return c.SetNested(a, attributes), nil
+		default:
+			return c.SingleNested(a, attributes), nil
+		}
+	}
+
+	switch t := a.Type.(type) {
+	case basetypes.BoolTypable:
+		return c.Bool(a, customType[basetypes.BoolTypable](t, types.BoolType)), nil
+	case basetypes.Float64Typable:
+		return c.Float64(a, customType[basetypes.Float64Typable](t, types.Float64Type)), nil
+	case basetypes.Int64Typable:
+		return c.Int64(a, customType[basetypes.Int64Typable](t, types.Int64Type)), nil
+	case basetypes.NumberTypable:
+		return c.Number(a, customType[basetypes.NumberTypable](t, types.NumberType)), nil
+	case basetypes.StringTypable:
+		return c.String(a, customType[basetypes.StringTypable](t, types.StringType)), nil
+	case basetypes.ListTypable:
+		elemType, err := elementType(a)
+
+		if err != nil {
+			return zero, err
+		}
+
+		return c.List(a, elemType, customType[basetypes.ListTypable](t, types.ListType{})), nil
+	case basetypes.MapTypable:
+		elemType, err := elementType(a)
+
+		if err != nil {
+			return zero, err
+		}
+
+		return c.Map(a, elemType, customType[basetypes.MapTypable](t, types.MapType{})), nil
+	case basetypes.SetTypable:
+		elemType, err := elementType(a)
+
+		if err != nil {
+			return zero, err
+		}
+
+		return c.Set(a, elemType, customType[basetypes.SetTypable](t, types.SetType{})), nil
+	case basetypes.ObjectTypable:
+		typeWithAttributeTypes, ok := t.(attr.TypeWithAttributeTypes)
+
+		if !ok {
+			return zero, fmt.Errorf("%s: attribute type %T does not define attribute types", a.Path, a.Type)
+		}
+
+		return c.Object(a, typeWithAttributeTypes.AttributeTypes(), customType[basetypes.ObjectTypable](t, types.ObjectType{})), nil
+	}
+
+	return zero, fmt.Errorf("%s: unsupported attribute type %T", a.Path, a.Type)
+}
+
+// customType returns the type if it is not of the same Go type as the given
+// base type, otherwise nil.
+func customType[T attr.Type](t T, base attr.Type) T {
+	var zero T
+
+	if reflect.TypeOf(t) == reflect.TypeOf(base) {
+		return zero
+	}
+
+	return t
+}
+
+// elementType returns the element type of a derived collection attribute
+// type.
+func elementType(a Attribute) (attr.Type, error) {
+	typeWithElementType, ok := a.Type.(attr.TypeWithElementType)
+
+	if !ok {
+		return nil, fmt.Errorf("%s: attribute type %T does not define an element type", a.Path, a.Type)
+	}
+
+	return typeWithElementType.ElementType(), nil
+}
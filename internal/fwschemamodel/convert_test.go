@@ -0,0 +1,185 @@
+package fwschemamodel_test
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemamodel"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestFromModel(t *testing.T) {
+	t.Parallel()
+
+	type nestedModel struct {
+		Value string `tfsdk:"value,required"`
+	}
+
+	testCases := map[string]struct {
+		model         any
+		opts          fwschemamodel.Options
+		replacements  map[string]string
+		validate      func(fwschemamodel.Attribute) error
+		expected      map[string]string
+		expectedDiags diag.Diagnostics
+	}{
+		"nil": {
+			model: nil,
+			expectedDiags: diag.Diagnostics{
+				invalidModelDiagnostic("model must be a struct or pointer to struct, got nil"),
+			},
+		},
+		"attributes": {
+			model: &struct {
+				Bool     bool                   `tfsdk:"bool,optional"`
+				Custom   customStringValue      `tfsdk:"custom,computed"`
+				List     []string               `tfsdk:"list,optional"`
+				Nested   nestedModel            `tfsdk:"nested,optional"`
+				Set      []nestedModel          `tfsdk:"set,optional,set"`
+				Map      map[string]nestedModel `tfsdk:"map,optional"`
+				Replaced types.List             `tfsdk:"replaced"`
+				Strings  map[string]string      `tfsdk:"strings,optional"`
+			}{},
+			opts: optionsWith(func(o *fwschemamodel.Options) {
+				o.FieldOptions(path.Root("replaced"), func(f *fwschemamodel.FieldOptions) { f.Overridden = true })
+			}),
+			replacements: map[string]string{
+				"replaced": "replacement",
+			},
+			expected: map[string]string{
+				"bool":     "Bool(custom=false,optional)",
+				"custom":   "String(custom=true,computed)",
+				"list":     "List(types.ListType[basetypes.StringType],custom=false,optional)",
+				"nested":   "SingleNested({value: String(custom=false,required)},optional)",
+				"set":      "SetNested({value: String(custom=false,required)},optional)",
+				"map":      "MapNested({value: String(custom=false,required)},optional)",
+				"replaced": "replacement",
+				"strings":  "Map(types.MapType[basetypes.StringType],custom=false,optional)",
+			},
+		},
+		"validate": {
+			model: &struct {
+				Nested nestedModel `tfsdk:"nested,optional"`
+			}{},
+			validate: func(a fwschemamodel.Attribute) error {
+				if a.Required {
+					return fmt.Errorf("%s: required", a.Path)
+				}
+
+				return nil
+			},
+			expectedDiags: diag.Diagnostics{
+				invalidModelDiagnostic("nested.value: required"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			constructors := testConstructors()
+			constructors.Validate = testCase.validate
+
+			got, diags := fwschemamodel.FromModel(context.Background(), testCase.model, testCase.opts, testCase.replacements, constructors)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func invalidModelDiagnostic(detail string) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid Schema Model",
+		"Unable to derive the schema from the model type. This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+detail,
+	)
+}
+
+// testConstructors returns constructors which describe each attribute as a
+// string.
+func testConstructors() fwschemamodel.Constructors[string] {
+	flags := func(a fwschemamodel.Attribute) string {
+		var result []string
+
+		for flag, ok := range map[string]bool{
+			"computed":  a.Computed,
+			"optional":  a.Optional,
+			"required":  a.Required,
+			"sensitive": a.Sensitive,
+		} {
+			if ok {
+				result = append(result, flag)
+			}
+		}
+
+		sort.Strings(result)
+
+		return strings.Join(result, ",")
+	}
+
+	nested := func(kind string) func(fwschemamodel.Attribute, map[string]string) string {
+		return func(a fwschemamodel.Attribute, attributes map[string]string) string {
+			var result []string
+
+			for name, attribute := range attributes {
+				result = append(result, name+": "+attribute)
+			}
+
+			sort.Strings(result)
+
+			return fmt.Sprintf("%s({%s},%s)", kind, strings.Join(result, ", "), flags(a))
+		}
+	}
+
+	return fwschemamodel.Constructors[string]{
+		Bool: func(a fwschemamodel.Attribute, customType basetypes.BoolTypable) string {
+			return fmt.Sprintf("Bool(custom=%t,%s)", customType != nil, flags(a))
+		},
+		Float64: func(a fwschemamodel.Attribute, customType basetypes.Float64Typable) string {
+			return fmt.Sprintf("Float64(custom=%t,%s)", customType != nil, flags(a))
+		},
+		Int64: func(a fwschemamodel.Attribute, customType basetypes.Int64Typable) string {
+			return fmt.Sprintf("Int64(custom=%t,%s)", customType != nil, flags(a))
+		},
+		Number: func(a fwschemamodel.Attribute, customType basetypes.NumberTypable) string {
+			return fmt.Sprintf("Number(custom=%t,%s)", customType != nil, flags(a))
+		},
+		String: func(a fwschemamodel.Attribute, customType basetypes.StringTypable) string {
+			return fmt.Sprintf("String(custom=%t,%s)", customType != nil, flags(a))
+		},
+		List: func(a fwschemamodel.Attribute, elementType attr.Type, customType basetypes.ListTypable) string {
+			return fmt.Sprintf("List(%s,custom=%t,%s)", types.ListType{ElemType: elementType}, customType != nil, flags(a))
+		},
+		Map: func(a fwschemamodel.Attribute, elementType attr.Type, customType basetypes.MapTypable) string {
+			return fmt.Sprintf("Map(%s,custom=%t,%s)", types.MapType{ElemType: elementType}, customType != nil, flags(a))
+		},
+		Set: func(a fwschemamodel.Attribute, elementType attr.Type, customType basetypes.SetTypable) string {
+			return fmt.Sprintf("Set(%s,custom=%t,%s)", types.SetType{ElemType: elementType}, customType != nil, flags(a))
+		},
+		Object: func(a fwschemamodel.Attribute, attributeTypes map[string]attr.Type, customType basetypes.ObjectTypable) string {
+			return fmt.Sprintf("Object(%v,custom=%t,%s)", attributeTypes, customType != nil, flags(a))
+		},
+		ListNested:   nested("ListNested"),
+		MapNested:    nested("MapNested"),
+		SetNested:    nested("SetNested"),
+		SingleNested: nested("SingleNested"),
+	}
+}
@@ -0,0 +1,6 @@
+// Package fwschemamodel implements the derivation of schema attributes from
+// Go model struct types with "tfsdk" struct tags, and the verification that a
+// schema and its model struct type agree. The resource, data source, and
+// provider schema packages convert the derived attributes into their own
+// attribute types.
+package fwschemamodel
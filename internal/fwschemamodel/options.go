@@ -0,0 +1,71 @@
+package fwschemamodel
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Tag options supported after the attribute name in "tfsdk" struct tags, such
+// as `tfsdk:"example,optional,sensitive"`.
+const (
+	TagOptionComputed  = "computed"
+	TagOptionOptional  = "optional"
+	TagOptionRequired  = "required"
+	TagOptionSensitive = "sensitive"
+
+	// TagOptionSet derives a set attribute, instead of a list attribute,
+	// from a slice or array field.
+	TagOptionSet = "set"
+)
+
+// Options contains additional attribute information which cannot be, or
+// was not, defined by struct tags.
+type Options struct {
+	// Fields contains additional attribute information keyed by the
+	// attribute path string. Use the FieldOptions method to modify.
+	Fields map[string]FieldOptions
+}
+
+// FieldOptions contains additional information for a single attribute. The
+// boolean fields are combined with any struct tag options.
+type FieldOptions struct {
+	Computed            bool
+	Description         string
+	MarkdownDescription string
+	Optional            bool
+	Required            bool
+	Sensitive           bool
+
+	// Overridden signals that the caller will replace the derived
+	// attribute, so the struct field type is not inspected.
+	Overridden bool
+}
+
+// FieldOptions calls the given function to modify the field options of the
+// attribute at the given path. Any element steps in the path are ignored, so
+// the path of a nested attribute can be given either with or without them.
+func (o *Options) FieldOptions(p path.Path, f func(*FieldOptions)) {
+	if o.Fields == nil {
+		o.Fields = make(map[string]FieldOptions)
+	}
+
+	key := AttributePath(p).String()
+	fieldOptions := o.Fields[key]
+
+	f(&fieldOptions)
+
+	o.Fields[key] = fieldOptions
+}
+
+// AttributePath returns the given path with only its attribute name steps,
+// which is the path of the attribute definition in a schema.
+func AttributePath(p path.Path) path.Path {
+	result := path.Empty()
+
+	for _, step := range p.Steps() {
+		if name, ok := step.(path.PathStepAttributeName); ok {
+			result = result.AtName(string(name))
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,214 @@
+package fwschemamodel
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	internalreflect "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+var valueConverterType = reflect.TypeOf((*tftypes.ValueConverter)(nil)).Elem()
+
+// Diagnostics returns error diagnostics for each mismatch between the given
+// schema type, typically the Type method result of a schema, and the Go model
+// type, which is typically a struct. Mismatches include schema attributes
+// without a model struct field, model struct fields without a schema
+// attribute, and model field types which cannot hold the attribute value.
+func Diagnostics(ctx context.Context, schemaType attr.Type, model reflect.Type) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, mismatch := range mismatches(ctx, schemaType, model, path.Empty()) {
+		diags.AddError(
+			"Schema Model Mismatch",
+			"The schema and its Go model type do not agree. This is always an error in the provider. "+
+				"Please report the following to the provider developer:\n\n"+mismatch,
+		)
+	}
+
+	return diags
+}
+
+// ValidateModel returns Diagnostics for the type of the given model, or an
+// error diagnostic if the model is nil.
+func ValidateModel(ctx context.Context, schemaType attr.Type, model any) diag.Diagnostics {
+	if model == nil {
+		return diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"Invalid Schema Model",
+				"The model must be a struct or pointer to struct, got nil. This is always an error in the provider.",
+			),
+		}
+	}
+
+	return Diagnostics(ctx, schemaType, reflect.TypeOf(model))
+}
+
+// hasDerivedType returns true if the attr.Value model type is an
+// xattr.ValueWithDerivedType which can be populated from values of the
+// schema type.
+func hasDerivedType(ctx context.Context, schemaType attr.Type, model reflect.Type) bool {
+	value, ok := reflect.Zero(model).Interface().(xattr.ValueWithDerivedType)
+
+	if !ok {
+		return false
+	}
+
+	derivedType := value.DerivedType(ctx)
+
+	return derivedType != nil && derivedType.TerraformType(ctx).Equal(schemaType.TerraformType(ctx))
+}
+
+// mismatches returns a description of each mismatch between the schema type
+// and the model type at the given path.
+func mismatches(ctx context.Context, schemaType attr.Type, model reflect.Type, p path.Path) []string {
+	model = dereference(model)
+
+	if model.Implements(attrValueType) {
+		valueType := reflect.TypeOf(schemaType.ValueType(ctx))
+
+		if !valueType.AssignableTo(model) && !hasDerivedType(ctx, schemaType, model) {
+			return []string{fmt.Sprintf("%s: model type %s cannot hold schema value type %s", pathString(p), model, valueType)}
+		}
+
+		return nil
+	}
+
+	// Custom conversion logic cannot be inspected.
+	if model.Implements(valueConverterType) || reflect.PtrTo(model).Implements(valueConverterType) {
+		return nil
+	}
+
+	tfType := schemaType.TerraformType(ctx)
+	incompatible := []string{fmt.Sprintf("%s: model type %s is incompatible with schema type %s", pathString(p), model, tfType)}
+
+	if model == bigFloatType || model == bigIntType {
+		if !tfType.Is(tftypes.Number) {
+			return incompatible
+		}
+
+		return nil
+	}
+
+	if internalreflect.IsTextType(model) && tfType.Is(tftypes.String) {
+		return nil
+	}
+
+	switch model.Kind() {
+	case reflect.Bool:
+		if !tfType.Is(tftypes.Bool) {
+			return incompatible
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		if !tfType.Is(tftypes.Number) {
+			return incompatible
+		}
+	case reflect.String:
+		if !tfType.Is(tftypes.String) {
+			return incompatible
+		}
+	case reflect.Slice, reflect.Array:
+		if !tfType.Is(tftypes.List{}) && !tfType.Is(tftypes.Set{}) {
+			return incompatible
+		}
+
+		return elementMismatches(ctx, schemaType, model, p)
+	case reflect.Map:
+		if !tfType.Is(tftypes.Map{}) || model.Key().Kind() != reflect.String {
+			return incompatible
+		}
+
+		return elementMismatches(ctx, schemaType, model, p)
+	case reflect.Struct:
+		if !tfType.Is(tftypes.Object{}) {
+			return incompatible
+		}
+
+		return objectMismatches(ctx, schemaType, model, p)
+	default:
+		return incompatible
+	}
+
+	return nil
+}
+
+// elementMismatches returns the mismatches of the element type of a
+// collection schema type and a slice, array, or map model type.
+func elementMismatches(ctx context.Context, schemaType attr.Type, model reflect.Type, p path.Path) []string {
+	typeWithElementType, ok := schemaType.(attr.TypeWithElementType)
+
+	if !ok {
+		return []string{fmt.Sprintf("%s: schema type %T does not define an element type", pathString(p), schemaType)}
+	}
+
+	return mismatches(ctx, typeWithElementType.ElementType(), model.Elem(), p)
+}
+
+// objectMismatches returns the mismatches between the attribute types of an
+// object schema type and the fields of a struct model type.
+func objectMismatches(ctx context.Context, schemaType attr.Type, model reflect.Type, p path.Path) []string {
+	typeWithAttributeTypes, ok := schemaType.(attr.TypeWithAttributeTypes)
+
+	if !ok {
+		return []string{fmt.Sprintf("%s: schema type %T does not define attribute types", pathString(p), schemaType)}
+	}
+
+	fields, err := internalreflect.StructFields(model, p)
+
+	if err != nil {
+		return []string{err.Error()}
+	}
+
+	attrTypes := typeWithAttributeTypes.AttributeTypes()
+	fieldsByName := make(map[string]internalreflect.StructField, len(fields))
+
+	var result []string
+
+	for _, field := range fields {
+		fieldsByName[field.Name] = field
+
+		if _, ok := attrTypes[field.Name]; !ok {
+			result = append(result, fmt.Sprintf("%s: model field %s has no schema attribute", pathString(p.AtName(field.Name)), field.Field.Name))
+		}
+	}
+
+	names := make([]string, 0, len(attrTypes))
+
+	for name := range attrTypes {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		field, ok := fieldsByName[name]
+
+		if !ok {
+			result = append(result, fmt.Sprintf("%s: schema attribute has no model field", pathString(p.AtName(name))))
+
+			continue
+		}
+
+		result = append(result, mismatches(ctx, attrTypes[name], field.Field.Type, p.AtName(name))...)
+	}
+
+	return result
+}
+
+// pathString returns a readable path, since the root path string is empty.
+func pathString(p path.Path) string {
+	if len(p.Steps()) == 0 {
+		return "(root)"
+	}
+
+	return p.String()
+}
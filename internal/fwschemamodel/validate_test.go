@@ -0,0 +1,144 @@
+package fwschemamodel_test
+
+import (
+	"context"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemamodel"
+	testtypes "github.com/hashicorp/terraform-plugin-framework/internal/testing/types"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	schemaType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"bool":   types.BoolType,
+			"custom": testtypes.StringType{},
+			"list":   types.ListType{ElemType: types.StringType},
+			"nested": types.ListType{
+				ElemType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"value": types.Int64Type,
+					},
+				},
+			},
+			"number": types.NumberType,
+			"string": types.StringType,
+		},
+	}
+
+	type nestedModel struct {
+		Value int64 `tfsdk:"value"`
+	}
+
+	testCases := map[string]struct {
+		schemaType attr.Type
+		model      any
+		expected   diag.Diagnostics
+	}{
+		"match": {
+			schemaType: schemaType,
+			model: &struct {
+				Bool   *bool            `tfsdk:"bool"`
+				Custom testtypes.String `tfsdk:"custom"`
+				List   types.List       `tfsdk:"list"`
+				Nested []nestedModel    `tfsdk:"nested"`
+				Number *big.Float       `tfsdk:"number"`
+				String *time.Time       `tfsdk:"string,computed"`
+			}{},
+		},
+		"derived-type": {
+			schemaType: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"list": types.ListType{ElemType: types.StringType},
+				},
+			},
+			model: struct {
+				List basetypes.ListValueOf[types.String] `tfsdk:"list"`
+			}{},
+		},
+		"derived-type-mismatch": {
+			schemaType: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"list": types.ListType{ElemType: types.Int64Type},
+				},
+			},
+			model: struct {
+				List basetypes.ListValueOf[types.String] `tfsdk:"list"`
+			}{},
+			expected: diag.Diagnostics{
+				mismatchDiagnostic("list: model type basetypes.ListValueOf[github.com/hashicorp/terraform-plugin-framework/types/basetypes.StringValue] cannot hold schema value type basetypes.ListValue"),
+			},
+		},
+		"mismatch": {
+			schemaType: schemaType,
+			model: struct {
+				Bool   string       `tfsdk:"bool"`
+				Custom types.String `tfsdk:"custom"`
+				List   []bool       `tfsdk:"list"`
+				Nested []struct {
+					Other int64 `tfsdk:"other"`
+				} `tfsdk:"nested"`
+				String string `tfsdk:"string"`
+				Extra  string `tfsdk:"extra"`
+			}{},
+			expected: diag.Diagnostics{
+				mismatchDiagnostic("extra: model field Extra has no schema attribute"),
+				mismatchDiagnostic("bool: model type string is incompatible with schema type tftypes.Bool"),
+				mismatchDiagnostic("custom: model type basetypes.StringValue cannot hold schema value type types.String"),
+				mismatchDiagnostic("list: model type bool is incompatible with schema type tftypes.String"),
+				mismatchDiagnostic("nested.other: model field Other has no schema attribute"),
+				mismatchDiagnostic("nested.value: schema attribute has no model field"),
+				mismatchDiagnostic("number: schema attribute has no model field"),
+			},
+		},
+		"not-struct": {
+			schemaType: schemaType,
+			model:      "test",
+			expected: diag.Diagnostics{
+				mismatchDiagnostic("(root): model type string is incompatible with schema type tftypes.Object[\"bool\":tftypes.Bool, \"custom\":tftypes.String, \"list\":tftypes.List[tftypes.String], \"nested\":tftypes.List[tftypes.Object[\"value\":tftypes.Number]], \"number\":tftypes.Number, \"string\":tftypes.String]"),
+			},
+		},
+		"invalid-tag": {
+			schemaType: schemaType,
+			model: struct {
+				Bool bool
+			}{},
+			expected: diag.Diagnostics{
+				mismatchDiagnostic(`: need a struct tag for "tfsdk" on Bool`),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwschemamodel.Diagnostics(context.Background(), testCase.schemaType, reflect.TypeOf(testCase.model))
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func mismatchDiagnostic(detail string) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Schema Model Mismatch",
+		"The schema and its Go model type do not agree. This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+detail,
+	)
+}
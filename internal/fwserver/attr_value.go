@@ -167,6 +167,54 @@ func setElemObject(ctx context.Context, schemaPath path.Path, set types.Set, ind
 	return coerceObjectValue(ctx, schemaPath, set.Elements()[index])
 }
 
+// setElemObjectByKey returns the set element object with key attribute values
+// equal to those of the given object. If the given object has null or unknown
+// key attribute values or no element matches, a null object is returned.
+// Without key attributes, the set element at the given index is returned.
+func setElemObjectByKey(ctx context.Context, schemaPath path.Path, set types.Set, index int, object types.Object, keyAttributes []string, description fwschemadata.DataDescription) (types.Object, diag.Diagnostics) {
+	if len(keyAttributes) == 0 || set.IsNull() || set.IsUnknown() {
+		return setElemObject(ctx, schemaPath, set, index, description)
+	}
+
+	for _, elem := range set.Elements() {
+		elemObject, diags := coerceObjectValue(ctx, schemaPath, elem)
+
+		if diags.HasError() {
+			return elemObject, diags
+		}
+
+		if objectKeyAttributesEqual(object, elemObject, keyAttributes) {
+			return elemObject, nil
+		}
+	}
+
+	return setElemObjectFromTerraformValue(ctx, schemaPath, set, description, nil)
+}
+
+// objectKeyAttributesEqual returns true if all the key attribute values of
+// the objects are known, not null, and equal.
+func objectKeyAttributesEqual(a types.Object, b types.Object, keyAttributes []string) bool {
+	if a.IsNull() || a.IsUnknown() || b.IsNull() || b.IsUnknown() {
+		return false
+	}
+
+	for _, keyAttribute := range keyAttributes {
+		aValue, ok := a.Attributes()[keyAttribute]
+
+		if !ok || aValue.IsNull() || aValue.IsUnknown() {
+			return false
+		}
+
+		bValue, ok := b.Attributes()[keyAttribute]
+
+		if !ok || !aValue.Equal(bValue) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func setElemObjectFromTerraformValue(ctx context.Context, schemaPath path.Path, set types.Set, description fwschemadata.DataDescription, tfValue any) (types.Object, diag.Diagnostics) {
 	elemType := set.ElementType(ctx)
 	elemValue, err := elemType.ValueFromTerraform(ctx, tftypes.NewValue(elemType.TerraformType(ctx), tfValue))
@@ -236,12 +236,18 @@ func AttributeModifyPlan(ctx context.Context, a fwschema.Attribute, req ModifyAt
 			return
 		}
 
+		var keyAttributes []string
+
+		if aWithKeyAttributes, ok := a.(fwxschema.AttributeWithKeyAttributes); ok {
+			keyAttributes = aWithKeyAttributes.GetKeyAttributes()
+		}
+
 		planElements := planSet.Elements()
 
 		for idx, planElem := range planElements {
 			attrPath := req.AttributePath.AtSetValue(planElem)
 
-			configObject, diags := setElemObject(ctx, attrPath, configSet, idx, fwschemadata.DataDescriptionConfiguration)
+			planObject, diags := coerceObjectValue(ctx, attrPath, planElem)
 
 			resp.Diagnostics.Append(diags...)
 
@@ -249,7 +255,7 @@ func AttributeModifyPlan(ctx context.Context, a fwschema.Attribute, req ModifyAt
 				return
 			}
 
-			planObject, diags := coerceObjectValue(ctx, attrPath, planElem)
+			configObject, diags := setElemObjectByKey(ctx, attrPath, configSet, idx, planObject, keyAttributes, fwschemadata.DataDescriptionConfiguration)
 
 			resp.Diagnostics.Append(diags...)
 
@@ -257,7 +263,7 @@ func AttributeModifyPlan(ctx context.Context, a fwschema.Attribute, req ModifyAt
 				return
 			}
 
-			stateObject, diags := setElemObject(ctx, attrPath, stateSet, idx, fwschemadata.DataDescriptionState)
+			stateObject, diags := setElemObjectByKey(ctx, attrPath, stateSet, idx, planObject, keyAttributes, fwschemadata.DataDescriptionState)
 
 			resp.Diagnostics.Append(diags...)
 
@@ -156,12 +156,18 @@ func BlockModifyPlan(ctx context.Context, b fwschema.Block, req ModifyAttributeP
 			return
 		}
 
+		var keyAttributes []string
+
+		if bWithKeyAttributes, ok := b.(fwxschema.BlockWithKeyAttributes); ok {
+			keyAttributes = bWithKeyAttributes.GetKeyAttributes()
+		}
+
 		planElements := planSet.Elements()
 
 		for idx, planElem := range planElements {
 			attrPath := req.AttributePath.AtSetValue(planElem)
 
-			configObject, diags := setElemObject(ctx, attrPath, configSet, idx, fwschemadata.DataDescriptionConfiguration)
+			planObject, diags := coerceObjectValue(ctx, attrPath, planElem)
 
 			resp.Diagnostics.Append(diags...)
 
@@ -169,7 +175,7 @@ func BlockModifyPlan(ctx context.Context, b fwschema.Block, req ModifyAttributeP
 				return
 			}
 
-			planObject, diags := coerceObjectValue(ctx, attrPath, planElem)
+			configObject, diags := setElemObjectByKey(ctx, attrPath, configSet, idx, planObject, keyAttributes, fwschemadata.DataDescriptionConfiguration)
 
 			resp.Diagnostics.Append(diags...)
 
@@ -177,7 +183,7 @@ func BlockModifyPlan(ctx context.Context, b fwschema.Block, req ModifyAttributeP
 				return
 			}
 
-			stateObject, diags := setElemObject(ctx, attrPath, stateSet, idx, fwschemadata.DataDescriptionState)
+			stateObject, diags := setElemObjectByKey(ctx, attrPath, stateSet, idx, planObject, keyAttributes, fwschemadata.DataDescriptionState)
 
 			resp.Diagnostics.Append(diags...)
 
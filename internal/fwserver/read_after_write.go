@@ -0,0 +1,240 @@
+package fwserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// readAfterWrite calls the resource Read method with the new state of a
+// successful create or update operation, if the resource implements
+// ResourceWithReadAfterWrite and enables it. Known planned values are kept in
+// the returned state, while unknown planned values are set from the state
+// returned by Read.
+//
+// The given new state and private state data are returned unmodified if
+// Read is not called, returns an error, or removes the resource. A
+// ResourceNotFoundDiagnostic returned by Read is replaced by a single error.
+func readAfterWrite(ctx context.Context, operation string, r resource.Resource, plannedState *tfsdk.Plan, providerMeta *tfsdk.Config, newState *tfsdk.State, private *privatestate.Data) (*tfsdk.State, *privatestate.Data, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	resourceWithReadAfterWrite, ok := r.(resource.ResourceWithReadAfterWrite)
+
+	if !ok || newState == nil {
+		return newState, private, nil
+	}
+
+	logging.FrameworkTrace(ctx, "Resource implements ResourceWithReadAfterWrite")
+
+	if !resourceWithReadAfterWrite.ReadAfterWrite(ctx) {
+		return newState, private, nil
+	}
+
+	readReq := resource.ReadRequest{
+		State: tfsdk.State{
+			Schema: newState.Schema,
+			Raw:    newState.Raw.Copy(),
+		},
+	}
+	readResp := resource.ReadResponse{
+		State: tfsdk.State{
+			Schema: newState.Schema,
+			Raw:    newState.Raw.Copy(),
+		},
+	}
+
+	if providerMeta != nil {
+		readReq.ProviderMeta = *providerMeta
+	}
+
+	privateProviderData := privatestate.EmptyProviderData(ctx)
+
+	if private != nil && private.Provider != nil {
+		privateProviderData = private.Provider
+	}
+
+	readReq.Private = privateProviderData
+	readResp.Private = privateProviderData
+
+	logging.FrameworkDebug(ctx, "Calling provider defined Resource Read after "+operation)
+	r.Read(ctx, readReq, &readResp)
+	logging.FrameworkDebug(ctx, "Called provider defined Resource Read after "+operation)
+
+	if readResp.Diagnostics.HasResourceNotFound() {
+		logging.FrameworkDebug(ctx, "Resource Read returned a resource not found diagnostic after "+operation)
+
+		diags.Append(resourceNotFoundAfterWriteErrors(operation, readResp.Diagnostics)...)
+
+		return newState, private, diags
+	}
+
+	diags.Append(readResp.Diagnostics...)
+
+	if diags.HasError() {
+		return newState, private, diags
+	}
+
+	if readResp.State.Raw.IsNull() {
+		diags.AddError(
+			"Missing Resource State After Read",
+			fmt.Sprintf("The Terraform Provider unexpectedly removed the resource when reading it after the resource %s. ", operation)+
+				"The remote object may not be available yet or may have been removed by another process.\n\n"+
+				"This is always an issue in the Terraform Provider and should be reported to the provider developers.",
+		)
+
+		return newState, private, diags
+	}
+
+	mergedState := readResp.State.Raw
+
+	if plannedState != nil {
+		var err error
+
+		mergedState, err = mergePlannedValue(plannedState.Raw, readResp.State.Raw)
+
+		if err != nil {
+			diags.AddError(
+				"Error Merging Resource State After Read",
+				fmt.Sprintf("An unexpected error was encountered when merging the planned state with the state returned by Read after the resource %s: %s\n\n", operation, err)+
+					"This is always a problem with Terraform or terraform-plugin-framework. Please report this to the provider developer.",
+			)
+
+			return newState, private, diags
+		}
+	}
+
+	if private == nil {
+		private = &privatestate.Data{}
+	}
+
+	private.Provider = readResp.Private
+
+	return &tfsdk.State{
+		Schema: newState.Schema,
+		Raw:    mergedState,
+	}, private, diags
+}
+
+// mergePlannedValue returns the planned value with any unknown values
+// replaced by the value at the same path of the read value. Sets are
+// replaced by the read value if they contain unknown values, as their
+// elements cannot be correlated.
+func mergePlannedValue(planned tftypes.Value, read tftypes.Value) (tftypes.Value, error) {
+	if !planned.IsKnown() {
+		return read, nil
+	}
+
+	if planned.IsNull() || planned.IsFullyKnown() {
+		return planned, nil
+	}
+
+	readKnown := read.IsKnown() && !read.IsNull()
+
+	switch typ := planned.Type().(type) {
+	case tftypes.Object:
+		var plannedAttributes, readAttributes map[string]tftypes.Value
+
+		if err := planned.As(&plannedAttributes); err != nil {
+			return planned, err
+		}
+
+		if readKnown {
+			if err := read.As(&readAttributes); err != nil {
+				return planned, err
+			}
+		}
+
+		attributes := make(map[string]tftypes.Value, len(plannedAttributes))
+
+		for name, plannedAttribute := range plannedAttributes {
+			readAttribute, ok := readAttributes[name]
+
+			if !ok {
+				readAttribute = tftypes.NewValue(plannedAttribute.Type(), nil)
+			}
+
+			attribute, err := mergePlannedValue(plannedAttribute, readAttribute)
+
+			if err != nil {
+				return planned, err
+			}
+
+			attributes[name] = attribute
+		}
+
+		return tftypes.NewValue(typ, attributes), nil
+	case tftypes.List, tftypes.Tuple:
+		var plannedElements, readElements []tftypes.Value
+
+		if err := planned.As(&plannedElements); err != nil {
+			return planned, err
+		}
+
+		if readKnown {
+			if err := read.As(&readElements); err != nil {
+				return planned, err
+			}
+		}
+
+		elements := make([]tftypes.Value, 0, len(plannedElements))
+
+		for index, plannedElement := range plannedElements {
+			readElement := tftypes.NewValue(plannedElement.Type(), nil)
+
+			if index < len(readElements) {
+				readElement = readElements[index]
+			}
+
+			element, err := mergePlannedValue(plannedElement, readElement)
+
+			if err != nil {
+				return planned, err
+			}
+
+			elements = append(elements, element)
+		}
+
+		return tftypes.NewValue(typ, elements), nil
+	case tftypes.Map:
+		var plannedElements, readElements map[string]tftypes.Value
+
+		if err := planned.As(&plannedElements); err != nil {
+			return planned, err
+		}
+
+		if readKnown {
+			if err := read.As(&readElements); err != nil {
+				return planned, err
+			}
+		}
+
+		elements := make(map[string]tftypes.Value, len(plannedElements))
+
+		for key, plannedElement := range plannedElements {
+			readElement, ok := readElements[key]
+
+			if !ok {
+				readElement = tftypes.NewValue(plannedElement.Type(), nil)
+			}
+
+			element, err := mergePlannedValue(plannedElement, readElement)
+
+			if err != nil {
+				return planned, err
+			}
+
+			elements[key] = element
+		}
+
+		return tftypes.NewValue(typ, elements), nil
+	default:
+		return read, nil
+	}
+}
@@ -0,0 +1,113 @@
+package fwserver
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestMergePlannedValue(t *testing.T) {
+	t.Parallel()
+
+	nestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"computed": tftypes.String,
+			"required": tftypes.String,
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"list": tftypes.List{ElementType: nestedType},
+			"map":  tftypes.Map{ElementType: nestedType},
+			"set":  tftypes.Set{ElementType: nestedType},
+		},
+	}
+
+	nestedValue := func(computed any, required string) tftypes.Value {
+		return tftypes.NewValue(nestedType, map[string]tftypes.Value{
+			"computed": tftypes.NewValue(tftypes.String, computed),
+			"required": tftypes.NewValue(tftypes.String, required),
+		})
+	}
+
+	testCases := map[string]struct {
+		planned  tftypes.Value
+		read     tftypes.Value
+		expected tftypes.Value
+	}{
+		"unknown": {
+			planned:  tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			read:     tftypes.NewValue(tftypes.String, "read"),
+			expected: tftypes.NewValue(tftypes.String, "read"),
+		},
+		"known": {
+			planned:  tftypes.NewValue(tftypes.String, "planned"),
+			read:     tftypes.NewValue(tftypes.String, "read"),
+			expected: tftypes.NewValue(tftypes.String, "planned"),
+		},
+		"null": {
+			planned:  tftypes.NewValue(tftypes.String, nil),
+			read:     tftypes.NewValue(tftypes.String, "read"),
+			expected: tftypes.NewValue(tftypes.String, nil),
+		},
+		"nested": {
+			planned: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"list": tftypes.NewValue(tftypes.List{ElementType: nestedType}, []tftypes.Value{
+					nestedValue(tftypes.UnknownValue, "planned-one"),
+					nestedValue(tftypes.UnknownValue, "planned-two"),
+				}),
+				"map": tftypes.NewValue(tftypes.Map{ElementType: nestedType}, map[string]tftypes.Value{
+					"key-one": nestedValue(tftypes.UnknownValue, "planned-one"),
+					"key-two": nestedValue(tftypes.UnknownValue, "planned-two"),
+				}),
+				"set": tftypes.NewValue(tftypes.Set{ElementType: nestedType}, []tftypes.Value{
+					nestedValue(tftypes.UnknownValue, "planned-one"),
+				}),
+			}),
+			read: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"list": tftypes.NewValue(tftypes.List{ElementType: nestedType}, []tftypes.Value{
+					nestedValue("read-one", "read-one"),
+				}),
+				"map": tftypes.NewValue(tftypes.Map{ElementType: nestedType}, map[string]tftypes.Value{
+					"key-one": nestedValue("read-one", "read-one"),
+				}),
+				"set": tftypes.NewValue(tftypes.Set{ElementType: nestedType}, []tftypes.Value{
+					nestedValue("read-one", "read-one"),
+				}),
+			}),
+			expected: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"list": tftypes.NewValue(tftypes.List{ElementType: nestedType}, []tftypes.Value{
+					nestedValue("read-one", "planned-one"),
+					nestedValue(nil, "planned-two"),
+				}),
+				"map": tftypes.NewValue(tftypes.Map{ElementType: nestedType}, map[string]tftypes.Value{
+					"key-one": nestedValue("read-one", "planned-one"),
+					"key-two": nestedValue(nil, "planned-two"),
+				}),
+				"set": tftypes.NewValue(tftypes.Set{ElementType: nestedType}, []tftypes.Value{
+					nestedValue("read-one", "read-one"),
+				}),
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := mergePlannedValue(testCase.planned, testCase.read)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
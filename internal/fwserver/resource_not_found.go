@@ -0,0 +1,77 @@
+package fwserver
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// resourceNotFoundWarnings returns the diagnostics with any
+// ResourceNotFoundDiagnostic replaced by a warning diagnostic, which explains
+// that the resource was removed from the Terraform state.
+func resourceNotFoundWarnings(diags diag.Diagnostics) diag.Diagnostics {
+	var result diag.Diagnostics
+
+	for _, d := range diags {
+		if _, ok := d.(diag.ResourceNotFoundDiagnostic); !ok {
+			result.Append(d)
+
+			continue
+		}
+
+		detail := "The resource was not found and has been removed from the Terraform state. " +
+			"Terraform will plan to create the resource again if it remains in the configuration."
+
+		if d.Detail() != "" {
+			detail += "\n\n" + d.Detail()
+		}
+
+		result.AddWarning("Resource Not Found", detail)
+	}
+
+	return result
+}
+
+// withoutResourceNotFound returns the diagnostics without any
+// ResourceNotFoundDiagnostic.
+func withoutResourceNotFound(diags diag.Diagnostics) diag.Diagnostics {
+	var result diag.Diagnostics
+
+	for _, d := range diags {
+		if _, ok := d.(diag.ResourceNotFoundDiagnostic); ok {
+			continue
+		}
+
+		result.Append(d)
+	}
+
+	return result
+}
+
+// resourceNotFoundAfterWriteErrors returns the diagnostics with all
+// ResourceNotFoundDiagnostic replaced by a single error diagnostic, which
+// explains that the resource was not found when Read was called after the
+// create or update operation and that its state was saved.
+func resourceNotFoundAfterWriteErrors(operation string, diags diag.Diagnostics) diag.Diagnostics {
+	var result diag.Diagnostics
+
+	detail := fmt.Sprintf("The resource was not found when reading it after the resource %s. ", operation) +
+		"The remote object may not be available yet or may have been removed by another process. " +
+		fmt.Sprintf("The resource state returned by the %s has been saved.", operation)
+
+	for _, d := range diags {
+		if _, ok := d.(diag.ResourceNotFoundDiagnostic); !ok {
+			result.Append(d)
+
+			continue
+		}
+
+		if d.Detail() != "" {
+			detail += "\n\n" + d.Detail()
+		}
+	}
+
+	result.AddError("Resource Not Found", detail)
+
+	return result
+}
@@ -123,4 +123,16 @@ func (s *Server) CreateResource(ctx context.Context, req *CreateResourceRequest,
 
 		resp.Private.Provider = createResp.Private
 	}
+
+	if !resp.Diagnostics.HasError() {
+		var readDiags diag.Diagnostics
+
+		resp.NewState, resp.Private, readDiags = readAfterWrite(ctx, "create", req.Resource, req.PlannedState, req.ProviderMeta, resp.NewState, resp.Private)
+
+		resp.Diagnostics.Append(readDiags...)
+	}
+
+	if !resp.Diagnostics.HasError() {
+		resp.Private = privateWithStateShape(ctx, resp.Private, req.ResourceSchema)
+	}
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider/metaschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -419,3 +420,195 @@ func TestServerCreateResource(t *testing.T) {
 		})
 	}
 }
+
+func TestServerCreateResource_readAfterWrite(t *testing.T) {
+	t.Parallel()
+
+	testSchemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_computed": tftypes.String,
+			"test_id":       tftypes.String,
+			"test_required": tftypes.String,
+		},
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_computed": schema.StringAttribute{
+				Computed: true,
+			},
+			"test_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"test_required": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testPlannedState := &tfsdk.Plan{
+		Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+			"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"test_id":       tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+		}),
+		Schema: testSchema,
+	}
+
+	testCreatedState := &tfsdk.State{
+		Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+			"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"test_id":       tftypes.NewValue(tftypes.String, "test-id-value"),
+			"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+		}),
+		Schema: testSchema,
+	}
+
+	testCreateMethod := func(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+		resp.State.Raw = req.Plan.Raw.Copy()
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_id"), "test-id-value")...)
+	}
+
+	testEmptyProviderData := privatestate.EmptyProviderData(context.Background())
+
+	testCases := map[string]struct {
+		readAfterWrite   bool
+		readMethod       func(context.Context, resource.ReadRequest, *resource.ReadResponse)
+		expectedResponse *fwserver.CreateResourceResponse
+	}{
+		"read": {
+			readAfterWrite: true,
+			readMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+				var id types.String
+
+				resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("test_id"), &id)...)
+
+				if id.ValueString() != "test-id-value" {
+					resp.Diagnostics.AddError("Unexpected req.State Value", "Got: "+id.String())
+				}
+
+				// Known planned values are kept in the new state.
+				resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_required"), "TEST-CONFIG-VALUE")...)
+				resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_computed"), "test-read-value")...)
+				resp.Diagnostics.Append(resp.Private.SetKey(ctx, "providerKeyOne", []byte(`{"pKeyOne": {"k0": "zero", "k1": 1}}`))...)
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				NewState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, "test-read-value"),
+						"test_id":       tftypes.NewValue(tftypes.String, "test-id-value"),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				Private: &privatestate.Data{
+					Provider: privatestate.MustProviderData(context.Background(), privatestate.MustMarshalToJson(map[string][]byte{
+						"providerKeyOne": []byte(`{"pKeyOne": {"k0": "zero", "k1": 1}}`),
+					})),
+				},
+			},
+		},
+		"read-disabled": {
+			readAfterWrite: false,
+			readMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+				resp.Diagnostics.AddError("Unexpected Read Call", "Read should not be called.")
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				NewState: testCreatedState,
+				Private: &privatestate.Data{
+					Provider: testEmptyProviderData,
+				},
+			},
+		},
+		"read-diagnostics": {
+			readAfterWrite: true,
+			readMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+				resp.Diagnostics.AddError("test summary", "test detail")
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic("test summary", "test detail"),
+				},
+				NewState: testCreatedState,
+				Private: &privatestate.Data{
+					Provider: testEmptyProviderData,
+				},
+			},
+		},
+		"read-resourcenotfound": {
+			readAfterWrite: true,
+			readMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+				resp.Diagnostics.AddWarning("test warning summary", "test warning detail")
+				resp.Diagnostics.AddResourceNotFound("API returned 404.")
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic("test warning summary", "test warning detail"),
+					diag.NewErrorDiagnostic(
+						"Resource Not Found",
+						"The resource was not found when reading it after the resource create. "+
+							"The remote object may not be available yet or may have been removed by another process. "+
+							"The resource state returned by the create has been saved.\n\n"+
+							"API returned 404.",
+					),
+				},
+				NewState: testCreatedState,
+				Private: &privatestate.Data{
+					Provider: testEmptyProviderData,
+				},
+			},
+		},
+		"read-removeresource": {
+			readAfterWrite: true,
+			readMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+				resp.State.RemoveResource(ctx)
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Missing Resource State After Read",
+						"The Terraform Provider unexpectedly removed the resource when reading it after the resource create. "+
+							"The remote object may not be available yet or may have been removed by another process.\n\n"+
+							"This is always an issue in the Terraform Provider and should be reported to the provider developers.",
+					),
+				},
+				NewState: testCreatedState,
+				Private: &privatestate.Data{
+					Provider: testEmptyProviderData,
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			}
+
+			response := &fwserver.CreateResourceResponse{}
+			server.CreateResource(context.Background(), &fwserver.CreateResourceRequest{
+				PlannedState:   testPlannedState,
+				ResourceSchema: testSchema,
+				Resource: &testprovider.ResourceWithReadAfterWrite{
+					Resource: &testprovider.Resource{
+						CreateMethod: testCreateMethod,
+						ReadMethod:   testCase.readMethod,
+					},
+					ReadAfterWriteMethod: func(_ context.Context) bool {
+						return testCase.readAfterWrite
+					},
+				},
+			}, response)
+
+			if diff := cmp.Diff(response, testCase.expectedResponse, cmp.AllowUnexported(privatestate.ProviderData{})); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
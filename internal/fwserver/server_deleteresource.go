@@ -87,6 +87,12 @@ func (s *Server) DeleteResource(ctx context.Context, req *DeleteResourceRequest,
 	req.Resource.Delete(ctx, deleteReq, &deleteResp)
 	logging.FrameworkDebug(ctx, "Called provider defined Resource Delete")
 
+	if deleteResp.Diagnostics.HasResourceNotFound() {
+		logging.FrameworkDebug(ctx, "Resource Delete returned a resource not found diagnostic, treating resource as deleted")
+
+		deleteResp.Diagnostics = withoutResourceNotFound(deleteResp.Diagnostics)
+	}
+
 	if !deleteResp.Diagnostics.HasError() {
 		logging.FrameworkTrace(ctx, "No provider defined Delete errors detected, ensuring State is cleared")
 		deleteResp.State.RemoveResource(ctx)
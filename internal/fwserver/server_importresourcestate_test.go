@@ -3,6 +3,7 @@ package fwserver_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -313,3 +314,239 @@ func TestServerImportResourceState(t *testing.T) {
 		})
 	}
 }
+
+func TestServerImportResourceState_compositeID(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"enabled": tftypes.Bool,
+			"number":  tftypes.Number,
+			"project": tftypes.String,
+		},
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Required: true,
+			},
+			"number": schema.Int64Attribute{
+				Required: true,
+			},
+			"project": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testEmptyState := tfsdk.State{
+		Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+			"enabled": tftypes.NewValue(tftypes.Bool, nil),
+			"number":  tftypes.NewValue(tftypes.Number, nil),
+			"project": tftypes.NewValue(tftypes.String, nil),
+		}),
+		Schema: testSchema,
+	}
+
+	testState := tfsdk.State{
+		Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+			"enabled": tftypes.NewValue(tftypes.Bool, true),
+			"number":  tftypes.NewValue(tftypes.Number, 42),
+			"project": tftypes.NewValue(tftypes.String, "test-project"),
+		}),
+		Schema: testSchema,
+	}
+
+	testSegments := []resource.ImportStateIDSegment{
+		{
+			Path: path.Root("project"),
+		},
+		{
+			Path: path.Root("number"),
+			Type: resource.ImportStateIDSegmentTypeInt64,
+		},
+		{
+			Path: path.Root("enabled"),
+			Name: "is_enabled",
+			Type: resource.ImportStateIDSegmentTypeBool,
+		},
+	}
+
+	testPattern := regexp.MustCompile(`^projects/([^/]+)/numbers/(\d+)/(true|false)$`)
+
+	testCompositeID := func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+		resource.ImportStateCompositeID(ctx, "/", testSegments, req, resp)
+	}
+
+	testCompositeIDPattern := func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+		resource.ImportStateCompositeIDPattern(ctx, testPattern, testSegments, req, resp)
+	}
+
+	testImportedResources := []fwserver.ImportedResource{
+		{
+			State:    testState,
+			TypeName: "test_resource",
+			Private: &privatestate.Data{
+				Provider: privatestate.EmptyProviderData(context.Background()),
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		id                string
+		importStateMethod func(context.Context, resource.ImportStateRequest, *resource.ImportStateResponse)
+		expectedResponse  *fwserver.ImportResourceStateResponse
+	}{
+		"separator": {
+			id:                "test-project/42/true",
+			importStateMethod: testCompositeID,
+			expectedResponse: &fwserver.ImportResourceStateResponse{
+				ImportedResources: testImportedResources,
+			},
+		},
+		"separator-segment-count-mismatch": {
+			id:                "test-project/42",
+			importStateMethod: testCompositeID,
+			expectedResponse: &fwserver.ImportResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Import Identifier",
+						"The import identifier must have the format: project/number/is_enabled\n\n"+
+							`Got: "test-project/42"`,
+					),
+				},
+			},
+		},
+		"separator-segment-empty": {
+			id:                "test-project//true",
+			importStateMethod: testCompositeID,
+			expectedResponse: &fwserver.ImportResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Import Identifier",
+						"The import identifier must have the format: project/number/is_enabled\n\n"+
+							`Got: "test-project//true"`,
+					),
+				},
+			},
+		},
+		"separator-segment-type-invalid": {
+			id:                "test-project/forty-two/true",
+			importStateMethod: testCompositeID,
+			expectedResponse: &fwserver.ImportResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Import Identifier",
+						`The number segment of the import identifier must be a valid integer, got: "forty-two"`+"\n\n"+
+							"The import identifier must have the format: project/number/is_enabled\n\n"+
+							`Got: "test-project/forty-two/true"`,
+					),
+				},
+			},
+		},
+		"separator-missing": {
+			id: "test-project/42/true",
+			importStateMethod: func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+				resource.ImportStateCompositeID(ctx, "", testSegments, req, resp)
+			},
+			expectedResponse: &fwserver.ImportResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Resource Import Composite ID Invalid Arguments",
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+							"Resource ImportState method call to ImportStateCompositeID must set a non-empty separator and at least one segment with a valid attribute path.",
+					),
+				},
+			},
+		},
+		"pattern": {
+			id:                "projects/test-project/numbers/42/true",
+			importStateMethod: testCompositeIDPattern,
+			expectedResponse: &fwserver.ImportResourceStateResponse{
+				ImportedResources: testImportedResources,
+			},
+		},
+		"pattern-alternation": {
+			id: "projects/test-project/numbers/42/true",
+			importStateMethod: func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+				// The leftmost-first match of the unanchored pattern ends
+				// after "t", so the entire identifier must be matched.
+				resource.ImportStateCompositeIDPattern(ctx, regexp.MustCompile(`projects/([^/]+)/numbers/(\d+)/(t|true)`), testSegments, req, resp)
+			},
+			expectedResponse: &fwserver.ImportResourceStateResponse{
+				ImportedResources: testImportedResources,
+			},
+		},
+		"pattern-partial-match": {
+			id: "projects/test-project/numbers/42/true/extra",
+			importStateMethod: func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+				resource.ImportStateCompositeIDPattern(ctx, regexp.MustCompile(`projects/([^/]+)/numbers/(\d+)/(true|false)`), testSegments, req, resp)
+			},
+			expectedResponse: &fwserver.ImportResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Import Identifier",
+						`The import identifier must have the format: projects/([^/]+)/numbers/(\d+)/(true|false)`+"\n\n"+
+							`Got: "projects/test-project/numbers/42/true/extra"`,
+					),
+				},
+			},
+		},
+		"pattern-mismatch": {
+			id:                "projects/test-project/numbers/forty-two/true",
+			importStateMethod: testCompositeIDPattern,
+			expectedResponse: &fwserver.ImportResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid Import Identifier",
+						`The import identifier must have the format: ^projects/([^/]+)/numbers/(\d+)/(true|false)$`+"\n\n"+
+							`Got: "projects/test-project/numbers/forty-two/true"`,
+					),
+				},
+			},
+		},
+		"pattern-capturing-groups-mismatch": {
+			id: "projects/test-project/numbers/42/true",
+			importStateMethod: func(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+				resource.ImportStateCompositeIDPattern(ctx, regexp.MustCompile(`^projects/([^/]+)$`), testSegments, req, resp)
+			},
+			expectedResponse: &fwserver.ImportResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Resource Import Composite ID Invalid Arguments",
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+							"Resource ImportState method call to ImportStateCompositeIDPattern must set a pattern with one capturing group per segment and at least one segment with a valid attribute path.",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			}
+
+			response := &fwserver.ImportResourceStateResponse{}
+			server.ImportResourceState(context.Background(), &fwserver.ImportResourceStateRequest{
+				EmptyState: testEmptyState,
+				ID:         testCase.id,
+				Resource: &testprovider.ResourceWithImportState{
+					Resource:          &testprovider.Resource{},
+					ImportStateMethod: testCase.importStateMethod,
+				},
+				TypeName: "test_resource",
+			}, response)
+
+			if diff := cmp.Diff(response, testCase.expectedResponse, cmp.AllowUnexported(privatestate.ProviderData{})); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
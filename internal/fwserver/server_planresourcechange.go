@@ -10,7 +10,11 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwdiag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -103,6 +107,10 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 		}
 	}
 
+	if !req.PriorState.Raw.IsNull() {
+		resp.Diagnostics.Append(StateShapeDiagnostics(ctx, req.Resource, req.ResourceSchema, req.PriorPrivate, diag.SeverityWarning)...)
+	}
+
 	resp.PlannedState = planToState(*req.ProposedNewState)
 
 	// Execute any AttributePlanModifiers.
@@ -199,7 +207,7 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 
 		logging.FrameworkDebug(ctx, "Marking Computed attributes with null configuration values as unknown (known after apply) in the plan to prevent potential Terraform errors")
 
-		modifiedPlan, err := tftypes.Transform(resp.PlannedState.Raw, MarkComputedNilsAsUnknown(ctx, req.Config.Raw, req.ResourceSchema))
+		modifiedPlan, err := tftypes.Transform(resp.PlannedState.Raw, MarkComputedNilsAsUnknown(ctx, req.Config.Raw, resp.PlannedState.Raw, req.PriorState.Raw, req.ResourceSchema))
 
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -305,7 +313,18 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 	}
 }
 
-func MarkComputedNilsAsUnknown(ctx context.Context, config tftypes.Value, resourceSchema fwschema.Schema) func(*tftypes.AttributePath, tftypes.Value) (tftypes.Value, error) {
+// MarkComputedNilsAsUnknown returns a tftypes.Transform function which marks
+// Computed attribute values that are null in the configuration as unknown.
+// Attributes with a ComputedValuePolicyPreserve policy instead keep the prior
+// state value, if it matches the planned value. Attributes with computed
+// dependencies keep the prior state value unless a dependency value differs
+// between the plan and prior state.
+//
+// The prior state is null when the resource is being created, in which case
+// every such value is marked unknown. Schemas without policies or computed
+// dependencies also have every such value marked unknown, so prior state
+// values are only kept when a schema opts in.
+func MarkComputedNilsAsUnknown(ctx context.Context, config tftypes.Value, plan tftypes.Value, priorState tftypes.Value, resourceSchema fwschema.Schema) func(*tftypes.AttributePath, tftypes.Value) (tftypes.Value, error) {
 	return func(path *tftypes.AttributePath, val tftypes.Value) (tftypes.Value, error) {
 		ctx = logging.FrameworkWithAttributePath(ctx, path.String())
 
@@ -361,12 +380,214 @@ func MarkComputedNilsAsUnknown(ctx context.Context, config tftypes.Value, resour
 			return val, nil
 		}
 
+		preserve := computedValuePolicyAtTerraformPath(ctx, resourceSchema, path) == fwschema.ComputedValuePolicyPreserve
+
+		if attributeWithDependencies, ok := attribute.(fwxschema.AttributeWithComputedDependencies); ok && len(attributeWithDependencies.GetComputedDependencies()) > 0 {
+			changed, err := computedDependenciesChanged(ctx, plan, priorState, resourceSchema, path, attributeWithDependencies.GetComputedDependencies())
+
+			if err != nil {
+				logging.FrameworkError(ctx,
+					"Error checking computed dependencies during unknown marking",
+					map[string]any{
+						logging.KeyError: err.Error(),
+					},
+				)
+
+				return val, fmt.Errorf("error checking computed dependencies during unknown marking: %w", err)
+			}
+
+			preserve = !changed
+		}
+
+		if preserve {
+			priorValIface, _, err := tftypes.WalkAttributePath(priorState, path)
+
+			if priorVal, ok := priorValIface.(tftypes.Value); err == nil && ok && priorStateMatchesPlan(priorVal, val) {
+				logging.FrameworkTrace(ctx, "attribute preserves prior state value, not marking unknown")
+
+				return val, nil
+			}
+		}
+
 		logging.FrameworkDebug(ctx, "marking computed attribute that is null in the config as unknown")
 
 		return tftypes.NewValue(val.Type(), tftypes.UnknownValue), nil
 	}
 }
 
+// computedValuePolicyAtTerraformPath returns the ComputedValuePolicy for the
+// attribute at the given path. Attributes without a policy inherit the policy
+// of the nearest parent attribute, otherwise the policy of the schema.
+func computedValuePolicyAtTerraformPath(ctx context.Context, resourceSchema fwschema.Schema, path *tftypes.AttributePath) fwschema.ComputedValuePolicy {
+	for ; len(path.Steps()) > 0; path = path.WithoutLastStep() {
+		if _, ok := path.LastStep().(tftypes.AttributeName); !ok {
+			continue
+		}
+
+		attribute, err := resourceSchema.AttributeAtTerraformPath(ctx, path)
+
+		if err != nil {
+			continue
+		}
+
+		attributeWithPolicy, ok := attribute.(fwxschema.AttributeWithComputedValuePolicy)
+
+		if !ok {
+			continue
+		}
+
+		if policy := attributeWithPolicy.GetComputedValuePolicy(); policy != fwschema.ComputedValuePolicyUnset {
+			return policy
+		}
+	}
+
+	if schemaWithPolicy, ok := resourceSchema.(fwxschema.SchemaWithComputedValuePolicy); ok {
+		return schemaWithPolicy.GetComputedValuePolicy()
+	}
+
+	return fwschema.ComputedValuePolicyUnset
+}
+
+// computedDependenciesChanged returns true if any value matching the computed
+// dependencies of the attribute at the given path differs between the plan
+// and prior state. Relative dependency expressions are merged with the path.
+func computedDependenciesChanged(ctx context.Context, plan tftypes.Value, priorState tftypes.Value, resourceSchema fwschema.Schema, tfPath *tftypes.AttributePath, dependencies path.Expressions) (bool, error) {
+	if priorState.IsNull() {
+		return true, nil
+	}
+
+	attributePath, diags := fromtftypes.AttributePath(ctx, tfPath, resourceSchema)
+
+	if diags.HasError() {
+		return false, fwdiag.Error(diags)
+	}
+
+	planData := fwschemadata.Data{
+		Description:    fwschemadata.DataDescriptionPlan,
+		Schema:         resourceSchema,
+		TerraformValue: plan,
+	}
+
+	stateData := fwschemadata.Data{
+		Description:    fwschemadata.DataDescriptionState,
+		Schema:         resourceSchema,
+		TerraformValue: priorState,
+	}
+
+	for _, expression := range attributePath.Expression().MergeExpressions(dependencies...) {
+		var matchedPaths path.Paths
+
+		for _, data := range []fwschemadata.Data{planData, stateData} {
+			paths, diags := data.PathMatches(ctx, expression)
+
+			if diags.HasError() {
+				return false, fwdiag.Error(diags)
+			}
+
+			matchedPaths.Append(paths...)
+		}
+
+		for _, matchedPath := range matchedPaths {
+			planValue, diags := planData.ValueAtPath(ctx, matchedPath)
+
+			if diags.HasError() {
+				return false, fwdiag.Error(diags)
+			}
+
+			priorValue, diags := stateData.ValueAtPath(ctx, matchedPath)
+
+			if diags.HasError() {
+				return false, fwdiag.Error(diags)
+			}
+
+			if !planValue.Equal(priorValue) {
+				logging.FrameworkTrace(ctx, "Computed dependency value changed", map[string]any{logging.KeyAttributePath: matchedPath.String()})
+
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// priorStateMatchesPlan returns true if the planned value is the prior state
+// value, where unknown planned values match any prior state value. Values
+// nested under a Computed attribute without configuration can only be unknown
+// due to unknown marking of nested attributes, so those are ignored.
+func priorStateMatchesPlan(prior tftypes.Value, plan tftypes.Value) bool {
+	if !plan.IsKnown() {
+		return true
+	}
+
+	if !prior.Type().Equal(plan.Type()) || !prior.IsKnown() || prior.IsNull() != plan.IsNull() {
+		return false
+	}
+
+	if prior.IsNull() || plan.IsFullyKnown() {
+		return prior.Equal(plan)
+	}
+
+	switch {
+	case plan.Type().Is(tftypes.List{}), plan.Type().Is(tftypes.Tuple{}):
+		var priorElems, planElems []tftypes.Value
+
+		if prior.As(&priorElems) != nil || plan.As(&planElems) != nil || len(priorElems) != len(planElems) {
+			return false
+		}
+
+		for i := range planElems {
+			if !priorStateMatchesPlan(priorElems[i], planElems[i]) {
+				return false
+			}
+		}
+
+		return true
+	case plan.Type().Is(tftypes.Set{}):
+		var priorElems, planElems []tftypes.Value
+
+		if prior.As(&priorElems) != nil || plan.As(&planElems) != nil || len(priorElems) != len(planElems) {
+			return false
+		}
+
+		for _, planElem := range planElems {
+			found := false
+
+			for _, priorElem := range priorElems {
+				if priorStateMatchesPlan(priorElem, planElem) {
+					found = true
+
+					break
+				}
+			}
+
+			if !found {
+				return false
+			}
+		}
+
+		return true
+	case plan.Type().Is(tftypes.Map{}), plan.Type().Is(tftypes.Object{}):
+		var priorElems, planElems map[string]tftypes.Value
+
+		if prior.As(&priorElems) != nil || plan.As(&planElems) != nil || len(priorElems) != len(planElems) {
+			return false
+		}
+
+		for key, planElem := range planElems {
+			priorElem, ok := priorElems[key]
+
+			if !ok || !priorStateMatchesPlan(priorElem, planElem) {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return false
+	}
+}
+
 // NormaliseRequiresReplace sorts and deduplicates the slice of AttributePaths
 // used in the RequiresReplace response field.
 // Sorting is lexical based on the string representation of each AttributePath.
@@ -267,7 +267,7 @@ func TestMarkComputedNilsAsUnknown(t *testing.T) {
 		}),
 	})
 
-	got, err := tftypes.Transform(input, fwserver.MarkComputedNilsAsUnknown(context.Background(), input, s))
+	got, err := tftypes.Transform(input, fwserver.MarkComputedNilsAsUnknown(context.Background(), input, input, tftypes.NewValue(input.Type(), nil), s))
 	if err != nil {
 		t.Errorf("Unexpected error: %s", err)
 		return
@@ -283,6 +283,356 @@ func TestMarkComputedNilsAsUnknown(t *testing.T) {
 	}
 }
 
+func TestMarkComputedNilsAsUnknown_ComputedValuePolicy(t *testing.T) {
+	t.Parallel()
+
+	nestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"arn":  tftypes.String,
+			"etag": tftypes.String,
+		},
+	}
+	listNestedType := tftypes.List{
+		ElementType: tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"id":   tftypes.String,
+				"name": tftypes.String,
+			},
+		},
+	}
+	schemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":          tftypes.String,
+			"list_nested": listNestedType,
+			"name":        tftypes.String,
+			"nested":      nestedType,
+			"updated":     tftypes.String,
+		},
+	}
+
+	testSchema := func(policy schema.ComputedValuePolicy) schema.Schema {
+		return schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Computed: true,
+				},
+				"list_nested": schema.ListNestedAttribute{
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"id": schema.StringAttribute{
+								Computed: true,
+							},
+							"name": schema.StringAttribute{
+								Required: true,
+							},
+						},
+					},
+					Optional: true,
+				},
+				"name": schema.StringAttribute{
+					Required: true,
+				},
+				"nested": schema.SingleNestedAttribute{
+					Attributes: map[string]schema.Attribute{
+						"arn": schema.StringAttribute{
+							Computed: true,
+						},
+						"etag": schema.StringAttribute{
+							Computed:            true,
+							ComputedValuePolicy: schema.ComputedValuePolicyVolatile,
+						},
+					},
+					Computed: true,
+				},
+				"updated": schema.StringAttribute{
+					Computed:            true,
+					ComputedValuePolicy: schema.ComputedValuePolicyVolatile,
+				},
+			},
+			ComputedValuePolicy: policy,
+		}
+	}
+
+	listNestedValue := func(elements ...[2]any) tftypes.Value {
+		values := make([]tftypes.Value, 0, len(elements))
+
+		for _, element := range elements {
+			values = append(values, tftypes.NewValue(listNestedType.ElementType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, element[0]),
+				"name": tftypes.NewValue(tftypes.String, element[1]),
+			}))
+		}
+
+		return tftypes.NewValue(listNestedType, values)
+	}
+
+	testValue := func(id, arn, etag, updated any, listNested tftypes.Value) tftypes.Value {
+		nested := tftypes.NewValue(nestedType, nil)
+
+		if arn != nil || etag != nil {
+			nested = tftypes.NewValue(nestedType, map[string]tftypes.Value{
+				"arn":  tftypes.NewValue(tftypes.String, arn),
+				"etag": tftypes.NewValue(tftypes.String, etag),
+			})
+		}
+
+		return tftypes.NewValue(schemaType, map[string]tftypes.Value{
+			"id":          tftypes.NewValue(tftypes.String, id),
+			"list_nested": listNested,
+			"name":        tftypes.NewValue(tftypes.String, "new"),
+			"nested":      nested,
+			"updated":     tftypes.NewValue(tftypes.String, updated),
+		})
+	}
+
+	config := tftypes.NewValue(schemaType, map[string]tftypes.Value{
+		"id":          tftypes.NewValue(tftypes.String, nil),
+		"list_nested": listNestedValue([2]any{nil, "one"}, [2]any{nil, "two"}),
+		"name":        tftypes.NewValue(tftypes.String, "new"),
+		"nested":      tftypes.NewValue(nestedType, nil),
+		"updated":     tftypes.NewValue(tftypes.String, nil),
+	})
+	priorState := tftypes.NewValue(schemaType, map[string]tftypes.Value{
+		"id":          tftypes.NewValue(tftypes.String, "test-id"),
+		"list_nested": listNestedValue([2]any{"one-id", "one"}),
+		"name":        tftypes.NewValue(tftypes.String, "old"),
+		"nested": tftypes.NewValue(nestedType, map[string]tftypes.Value{
+			"arn":  tftypes.NewValue(tftypes.String, "test-arn"),
+			"etag": tftypes.NewValue(tftypes.String, "test-etag"),
+		}),
+		"updated": tftypes.NewValue(tftypes.String, "test-updated"),
+	})
+	plan := testValue(
+		"test-id",
+		"test-arn",
+		"test-etag",
+		"test-updated",
+		listNestedValue([2]any{"one-id", "one"}, [2]any{nil, "two"}),
+	)
+
+	testCases := map[string]struct {
+		schema     schema.Schema
+		priorState tftypes.Value
+		plan       tftypes.Value
+		expected   tftypes.Value
+	}{
+		"unset": {
+			schema:     testSchema(schema.ComputedValuePolicyUnset),
+			priorState: priorState,
+			plan:       plan,
+			expected: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+				"id":          tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"list_nested": listNestedValue([2]any{tftypes.UnknownValue, "one"}, [2]any{tftypes.UnknownValue, "two"}),
+				"name":        tftypes.NewValue(tftypes.String, "new"),
+				"nested":      tftypes.NewValue(nestedType, tftypes.UnknownValue),
+				"updated":     tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+		},
+		"volatile": {
+			schema:     testSchema(schema.ComputedValuePolicyVolatile),
+			priorState: priorState,
+			plan:       plan,
+			expected: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+				"id":          tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"list_nested": listNestedValue([2]any{tftypes.UnknownValue, "one"}, [2]any{tftypes.UnknownValue, "two"}),
+				"name":        tftypes.NewValue(tftypes.String, "new"),
+				"nested":      tftypes.NewValue(nestedType, tftypes.UnknownValue),
+				"updated":     tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+		},
+		"preserve": {
+			schema:     testSchema(schema.ComputedValuePolicyPreserve),
+			priorState: priorState,
+			plan:       plan,
+			expected: testValue(
+				"test-id",
+				"test-arn",
+				tftypes.UnknownValue,
+				tftypes.UnknownValue,
+				listNestedValue([2]any{"one-id", "one"}, [2]any{tftypes.UnknownValue, "two"}),
+			),
+		},
+		"preserve-create": {
+			schema:     testSchema(schema.ComputedValuePolicyPreserve),
+			priorState: tftypes.NewValue(schemaType, nil),
+			plan:       testValue(nil, nil, nil, nil, listNestedValue([2]any{nil, "one"}, [2]any{nil, "two"})),
+			expected: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+				"id":          tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"list_nested": listNestedValue([2]any{tftypes.UnknownValue, "one"}, [2]any{tftypes.UnknownValue, "two"}),
+				"name":        tftypes.NewValue(tftypes.String, "new"),
+				"nested":      tftypes.NewValue(nestedType, tftypes.UnknownValue),
+				"updated":     tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+		},
+		"preserve-prior-state-mismatch": {
+			schema:     testSchema(schema.ComputedValuePolicyPreserve),
+			priorState: priorState,
+			plan: testValue(
+				"other-id",
+				"test-arn",
+				"test-etag",
+				"test-updated",
+				listNestedValue([2]any{nil, "one"}, [2]any{nil, "two"}),
+			),
+			expected: testValue(
+				tftypes.UnknownValue,
+				"test-arn",
+				tftypes.UnknownValue,
+				tftypes.UnknownValue,
+				listNestedValue([2]any{tftypes.UnknownValue, "one"}, [2]any{tftypes.UnknownValue, "two"}),
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tftypes.Transform(testCase.plan, fwserver.MarkComputedNilsAsUnknown(context.Background(), config, testCase.plan, testCase.priorState, testCase.schema))
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestMarkComputedNilsAsUnknown_ComputedDependencies(t *testing.T) {
+	t.Parallel()
+
+	listNestedType := tftypes.List{
+		ElementType: tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"id":      tftypes.String,
+				"version": tftypes.String,
+			},
+		},
+	}
+	schemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"endpoint":       tftypes.String,
+			"engine_version": tftypes.String,
+			"list_nested":    listNestedType,
+			"name":           tftypes.String,
+		},
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"endpoint": schema.StringAttribute{
+				Computed: true,
+				ComputedDependencies: path.Expressions{
+					path.MatchRoot("engine_version"),
+				},
+			},
+			"engine_version": schema.StringAttribute{
+				Required: true,
+			},
+			"list_nested": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+							ComputedDependencies: path.Expressions{
+								path.MatchRelative().AtParent().AtName("version"),
+							},
+						},
+						"version": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+				Required: true,
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testValue := func(endpoint, engineVersion, name any, listNested ...[2]any) tftypes.Value {
+		elements := make([]tftypes.Value, 0, len(listNested))
+
+		for _, element := range listNested {
+			elements = append(elements, tftypes.NewValue(listNestedType.ElementType, map[string]tftypes.Value{
+				"id":      tftypes.NewValue(tftypes.String, element[0]),
+				"version": tftypes.NewValue(tftypes.String, element[1]),
+			}))
+		}
+
+		return tftypes.NewValue(schemaType, map[string]tftypes.Value{
+			"endpoint":       tftypes.NewValue(tftypes.String, endpoint),
+			"engine_version": tftypes.NewValue(tftypes.String, engineVersion),
+			"list_nested":    tftypes.NewValue(listNestedType, elements),
+			"name":           tftypes.NewValue(tftypes.String, name),
+		})
+	}
+
+	priorState := testValue("test-endpoint", "1", "old", [2]any{"id-0", "1"}, [2]any{"id-1", "1"})
+
+	testCases := map[string]struct {
+		config     tftypes.Value
+		plan       tftypes.Value
+		priorState tftypes.Value
+		expected   tftypes.Value
+	}{
+		"dependencies-unchanged": {
+			config:     testValue(nil, "1", "new", [2]any{nil, "1"}, [2]any{nil, "1"}),
+			plan:       testValue("test-endpoint", "1", "new", [2]any{"id-0", "1"}, [2]any{"id-1", "1"}),
+			priorState: priorState,
+			expected:   testValue("test-endpoint", "1", "new", [2]any{"id-0", "1"}, [2]any{"id-1", "1"}),
+		},
+		"dependency-changed": {
+			config:     testValue(nil, "2", "old", [2]any{nil, "1"}, [2]any{nil, "1"}),
+			plan:       testValue("test-endpoint", "2", "old", [2]any{"id-0", "1"}, [2]any{"id-1", "1"}),
+			priorState: priorState,
+			expected:   testValue(tftypes.UnknownValue, "2", "old", [2]any{"id-0", "1"}, [2]any{"id-1", "1"}),
+		},
+		"relative-dependency-changed": {
+			config:     testValue(nil, "1", "old", [2]any{nil, "1"}, [2]any{nil, "2"}),
+			plan:       testValue("test-endpoint", "1", "old", [2]any{"id-0", "1"}, [2]any{"id-1", "2"}),
+			priorState: priorState,
+			expected:   testValue("test-endpoint", "1", "old", [2]any{"id-0", "1"}, [2]any{tftypes.UnknownValue, "2"}),
+		},
+		"relative-dependency-added": {
+			config:     testValue(nil, "1", "old", [2]any{nil, "1"}, [2]any{nil, "1"}, [2]any{nil, "1"}),
+			plan:       testValue("test-endpoint", "1", "old", [2]any{"id-0", "1"}, [2]any{"id-1", "1"}, [2]any{nil, "1"}),
+			priorState: priorState,
+			expected:   testValue("test-endpoint", "1", "old", [2]any{"id-0", "1"}, [2]any{"id-1", "1"}, [2]any{tftypes.UnknownValue, "1"}),
+		},
+		"create": {
+			config:     testValue(nil, "1", "new", [2]any{nil, "1"}),
+			plan:       testValue(nil, "1", "new", [2]any{nil, "1"}),
+			priorState: tftypes.NewValue(schemaType, nil),
+			expected:   testValue(tftypes.UnknownValue, "1", "new", [2]any{tftypes.UnknownValue, "1"}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tftypes.Transform(testCase.plan, fwserver.MarkComputedNilsAsUnknown(context.Background(), testCase.config, testCase.plan, testCase.priorState, testSchema))
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestNormaliseRequiresReplace(t *testing.T) {
 	t.Parallel()
 
@@ -379,6 +729,30 @@ func TestServerPlanResourceChange(t *testing.T) {
 		},
 	}
 
+	testSchemaStateShape := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_computed": schema.StringAttribute{
+				Computed: true,
+			},
+			"test_required": schema.StringAttribute{
+				Required: true,
+			},
+		},
+		DetectStateShapeChanges: true,
+	}
+
+	// The test_required attribute type differs from testSchemaStateShape.
+	testStateShapeChanged := testStateShapeJSON(t, schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_computed": schema.StringAttribute{
+				Computed: true,
+			},
+			"test_required": schema.Int64Attribute{
+				Required: true,
+			},
+		},
+	})
+
 	testSchemaBlock := schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"test_required": schema.StringAttribute{
@@ -1675,6 +2049,66 @@ func TestServerPlanResourceChange(t *testing.T) {
 				PlannedPrivate: testEmptyPrivate,
 			},
 		},
+		"update-prior-private-state-shape-changed": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-new-value"),
+					}),
+					Schema: testSchemaStateShape,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-new-value"),
+					}),
+					Schema: testSchemaStateShape,
+				},
+				PriorState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-old-value"),
+					}),
+					Schema: testSchemaStateShape,
+				},
+				PriorPrivate: &privatestate.Data{
+					Framework: map[string][]byte{
+						".state_shape": testStateShapeChanged,
+					},
+				},
+				ResourceSchema: testSchemaStateShape,
+				Resource:       &testprovider.Resource{},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic(
+						"Resource Schema Changed Without Version Increment",
+						"The resource schema has changes which affect saved state, such as attribute type or nesting mode changes, "+
+							"however the schema version was not increased from 0. "+
+							"Existing resources may fail to read or upgrade their saved state.\n\n"+
+							"This is always an issue in the Terraform Provider and should be reported to the provider developers. "+
+							"Increase the resource schema Version and implement a StateUpgrader for version 0 in the resource UpgradeState method.",
+					),
+				},
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, "test-new-value"),
+					}),
+					Schema: testSchemaStateShape,
+				},
+				PlannedPrivate: &privatestate.Data{
+					Framework: map[string][]byte{
+						".state_shape": testStateShapeChanged,
+					},
+					Provider: testEmptyProviderData,
+				},
+			},
+		},
 		"update-attributeplanmodifier-request-private": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -93,11 +93,40 @@ func (s *Server) ReadResource(ctx context.Context, req *ReadResourceRequest, res
 		resp.Private = req.Private
 	}
 
+	var privateMigrationDiags diag.Diagnostics
+
+	if resourceWithPrivateStateMigrations, ok := req.Resource.(resource.ResourceWithPrivateStateMigrations); ok {
+		logging.FrameworkTrace(ctx, "Resource implements ResourceWithPrivateStateMigrations")
+
+		logging.FrameworkDebug(ctx, "Migrating provider defined private state keys")
+
+		for _, migrator := range resourceWithPrivateStateMigrations.PrivateStateMigrations(ctx) {
+			privateMigrationDiags.Append(migrator.Migrate(ctx, readReq.Private)...)
+		}
+
+		if privateMigrationDiags.HasError() {
+			resp.Diagnostics.Append(privateMigrationDiags...)
+
+			return
+		}
+	}
+
+	stateShapeDiags := StateShapeDiagnostics(ctx, req.Resource, req.CurrentState.Schema, req.Private, diag.SeverityWarning)
+
 	logging.FrameworkDebug(ctx, "Calling provider defined Resource Read")
 	req.Resource.Read(ctx, readReq, &readResp)
 	logging.FrameworkDebug(ctx, "Called provider defined Resource Read")
 
+	if readResp.Diagnostics.HasResourceNotFound() {
+		logging.FrameworkDebug(ctx, "Resource Read returned a resource not found diagnostic, removing resource from state")
+
+		readResp.Diagnostics = resourceNotFoundWarnings(readResp.Diagnostics)
+		readResp.State.RemoveResource(ctx)
+	}
+
 	resp.Diagnostics = readResp.Diagnostics
+	resp.Diagnostics.Append(privateMigrationDiags...)
+	resp.Diagnostics.Append(stateShapeDiags...)
 	resp.NewState = &readResp.State
 
 	if readResp.Private != nil {
@@ -107,4 +136,8 @@ func (s *Server) ReadResource(ctx context.Context, req *ReadResourceRequest, res
 
 		resp.Private.Provider = readResp.Private
 	}
+
+	if !resp.Diagnostics.HasError() && !resp.NewState.Raw.IsNull() {
+		resp.Private = privateWithReadStateShape(ctx, req.Resource, resp.Private, req.CurrentState.Schema)
+	}
 }
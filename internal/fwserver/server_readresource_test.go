@@ -3,6 +3,7 @@ package fwserver_test
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"testing"
 
@@ -10,10 +11,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadiff"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/private"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -99,6 +102,59 @@ func TestServerReadResource(t *testing.T) {
 		Provider: testEmptyProviderData,
 	}
 
+	testPrivateKey := private.Key[map[string]string]{
+		Name:    "providerKeyOne",
+		Version: 1,
+		Migrations: map[int64]private.Migration{
+			0: func(_ context.Context, value []byte) ([]byte, diag.Diagnostics) {
+				return []byte(`{"k0":"migrated"}`), nil
+			},
+		},
+	}
+
+	testSchemaStateShape := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_computed": schema.StringAttribute{
+				Computed: true,
+			},
+			"test_required": schema.StringAttribute{
+				Required: true,
+			},
+		},
+		DetectStateShapeChanges: true,
+	}
+
+	testCurrentStateStateShape := &tfsdk.State{
+		Raw:    testCurrentStateValue,
+		Schema: testSchemaStateShape,
+	}
+
+	testSchemaStateShapeV1 := schema.Schema{
+		Attributes:              testSchemaStateShape.Attributes,
+		DetectStateShapeChanges: true,
+		Version:                 1,
+	}
+
+	testCurrentStateStateShapeV1 := &tfsdk.State{
+		Raw:    testCurrentStateValue,
+		Schema: testSchemaStateShapeV1,
+	}
+
+	testStateShape := testStateShapeJSON(t, testSchemaStateShape)
+	testStateShapeV1 := testStateShapeJSON(t, testSchemaStateShapeV1)
+
+	// The test_required attribute type differs from the current schema.
+	testStateShapeChanged := testStateShapeJSON(t, schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_computed": schema.StringAttribute{
+				Computed: true,
+			},
+			"test_required": schema.Int64Attribute{
+				Required: true,
+			},
+		},
+	})
+
 	testCases := map[string]struct {
 		server           *fwserver.Server
 		request          *fwserver.ReadResourceRequest
@@ -343,6 +399,33 @@ func TestServerReadResource(t *testing.T) {
 				Private:  testEmptyPrivate,
 			},
 		},
+		"response-diagnostics-resourcenotfound": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentState,
+				Resource: &testprovider.Resource{
+					ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+						resp.Diagnostics.AddWarning("warning summary", "warning detail")
+						resp.Diagnostics.AddResourceNotFound("test detail")
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic("warning summary", "warning detail"),
+					diag.NewWarningDiagnostic(
+						"Resource Not Found",
+						"The resource was not found and has been removed from the Terraform state. "+
+							"Terraform will plan to create the resource again if it remains in the configuration.\n\n"+
+							"test detail",
+					),
+				},
+				NewState: testNewStateRemoved,
+				Private:  testEmptyPrivate,
+			},
+		},
 		"response-private": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -362,6 +445,82 @@ func TestServerReadResource(t *testing.T) {
 				Private:  testPrivateProvider,
 			},
 		},
+		"response-private-migrated": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentState,
+				Resource: &testprovider.ResourceWithPrivateStateMigrations{
+					Resource: &testprovider.Resource{
+						ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+							value, diags := req.Private.GetKey(ctx, "providerKeyOne")
+
+							resp.Diagnostics.Append(diags...)
+
+							if string(value) != `{"version":1,"value":{"k0":"migrated"}}` {
+								resp.Diagnostics.AddError("unexpected req.Private value", string(value))
+							}
+						},
+					},
+					PrivateStateMigrationsMethod: func(_ context.Context) []private.Migrator {
+						return []private.Migrator{testPrivateKey}
+					},
+				},
+				Private: &privatestate.Data{
+					Provider: privatestate.MustProviderData(context.Background(), privatestate.MustMarshalToJson(map[string][]byte{
+						"providerKeyOne": []byte(`{"k0":"zero"}`),
+					})),
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewState: testCurrentState,
+				Private: &privatestate.Data{
+					Provider: privatestate.MustProviderData(context.Background(), privatestate.MustMarshalToJson(map[string][]byte{
+						"providerKeyOne": []byte(`{"version":1,"value":{"k0":"migrated"}}`),
+					})),
+				},
+			},
+		},
+		"response-private-migration-error": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentState,
+				Resource: &testprovider.ResourceWithPrivateStateMigrations{
+					Resource: &testprovider.Resource{
+						ReadMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+							resp.Diagnostics.AddError("unexpected Read call", "")
+						},
+					},
+					PrivateStateMigrationsMethod: func(_ context.Context) []private.Migrator {
+						return []private.Migrator{
+							private.Key[map[string]string]{Name: "providerKeyOne", Version: 1},
+						}
+					},
+				},
+				Private: &privatestate.Data{
+					Provider: privatestate.MustProviderData(context.Background(), privatestate.MustMarshalToJson(map[string][]byte{
+						"providerKeyOne": []byte(`{"k0":"zero"}`),
+					})),
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Error Decoding Private State",
+						`The private state value for key "providerKeyOne" has version 0, however no Migration is defined for the version. `+
+							"This is always an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+				Private: &privatestate.Data{
+					Provider: privatestate.MustProviderData(context.Background(), privatestate.MustMarshalToJson(map[string][]byte{
+						"providerKeyOne": []byte(`{"k0":"zero"}`),
+					})),
+				},
+			},
+		},
 		"response-private-updated": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -382,6 +541,89 @@ func TestServerReadResource(t *testing.T) {
 				Private:  testPrivate,
 			},
 		},
+		"response-private-state-shape": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentStateStateShape,
+				Resource:     &testprovider.Resource{},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewState: testCurrentStateStateShape,
+				Private: &privatestate.Data{
+					Framework: map[string][]byte{
+						".state_shape": testStateShape,
+					},
+					Provider: testEmptyProviderData,
+				},
+			},
+		},
+		"response-private-state-shape-changed": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentStateStateShape,
+				Resource:     &testprovider.Resource{},
+				Private: &privatestate.Data{
+					Framework: map[string][]byte{
+						".frameworkKey": []byte(`{"fk": "framework value"}`),
+						".state_shape":  testStateShapeChanged,
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic(
+						"Resource Schema Changed Without Version Increment",
+						"The resource schema has changes which affect saved state, such as attribute type or nesting mode changes, "+
+							"however the schema version was not increased from 0. "+
+							"Existing resources may fail to read or upgrade their saved state.\n\n"+
+							"This is always an issue in the Terraform Provider and should be reported to the provider developers. "+
+							"Increase the resource schema Version and implement a StateUpgrader for version 0 in the resource UpgradeState method.",
+					),
+				},
+				NewState: testCurrentStateStateShape,
+				Private: &privatestate.Data{
+					Framework: map[string][]byte{
+						".frameworkKey": []byte(`{"fk": "framework value"}`),
+						".state_shape":  testStateShapeChanged,
+					},
+					Provider: testEmptyProviderData,
+				},
+			},
+		},
+		"response-private-state-shape-upgraded": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentStateStateShapeV1,
+				Resource: &testprovider.ResourceWithUpgradeState{
+					Resource: &testprovider.Resource{},
+					UpgradeStateMethod: func(_ context.Context) map[int64]resource.StateUpgrader {
+						return map[int64]resource.StateUpgrader{
+							0: {},
+						}
+					},
+				},
+				Private: &privatestate.Data{
+					Framework: map[string][]byte{
+						".state_shape": testStateShapeChanged,
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewState: testCurrentStateStateShapeV1,
+				Private: &privatestate.Data{
+					Framework: map[string][]byte{
+						".state_shape": testStateShapeV1,
+					},
+					Provider: testEmptyProviderData,
+				},
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -399,3 +641,89 @@ func TestServerReadResource(t *testing.T) {
 		})
 	}
 }
+
+func TestServerReadResource_stateShapeRefresh(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_required": schema.StringAttribute{
+				Required: true,
+			},
+		},
+		DetectStateShapeChanges: true,
+	}
+
+	testState := &tfsdk.State{
+		Raw: tftypes.NewValue(testSchema.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+			"test_required": tftypes.NewValue(tftypes.String, "test-value"),
+		}),
+		Schema: testSchema,
+	}
+
+	// The test_required attribute type differs from the current schema.
+	private := &privatestate.Data{
+		Framework: map[string][]byte{
+			".state_shape": testStateShapeJSON(t, schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"test_required": schema.Int64Attribute{
+						Required: true,
+					},
+				},
+			}),
+		},
+	}
+
+	expectedDiags := diag.Diagnostics{
+		diag.NewWarningDiagnostic(
+			"Resource Schema Changed Without Version Increment",
+			"The resource schema has changes which affect saved state, such as attribute type or nesting mode changes, "+
+				"however the schema version was not increased from 0. "+
+				"Existing resources may fail to read or upgrade their saved state.\n\n"+
+				"This is always an issue in the Terraform Provider and should be reported to the provider developers. "+
+				"Increase the resource schema Version and implement a StateUpgrader for version 0 in the resource UpgradeState method.",
+		),
+	}
+
+	server := &fwserver.Server{
+		Provider: &testprovider.Provider{},
+	}
+
+	// The saved state shape must be kept, so the warning is returned on
+	// every refresh rather than only the first.
+	for refresh := 1; refresh <= 2; refresh++ {
+		response := &fwserver.ReadResourceResponse{}
+
+		server.ReadResource(context.Background(), &fwserver.ReadResourceRequest{
+			CurrentState: testState,
+			Private:      private,
+			Resource:     &testprovider.Resource{},
+		}, response)
+
+		if diff := cmp.Diff(response.Diagnostics, expectedDiags); diff != "" {
+			t.Fatalf("unexpected diagnostics difference on refresh %d: %s", refresh, diff)
+		}
+
+		private = response.Private
+	}
+}
+
+// testStateShapeJSON returns the private state data of the state shape of the
+// given schema.
+func testStateShapeJSON(t *testing.T, s schema.Schema) []byte {
+	t.Helper()
+
+	value, err := json.Marshal(struct {
+		Version int64             `json:"version"`
+		Shapes  map[string]string `json:"shapes"`
+	}{
+		Version: s.Version,
+		Shapes:  fwschemadiff.StateShape(context.Background(), s),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return value
+}
@@ -136,4 +136,16 @@ func (s *Server) UpdateResource(ctx context.Context, req *UpdateResourceRequest,
 
 		resp.Private.Provider = updateResp.Private
 	}
+
+	if !resp.Diagnostics.HasError() {
+		var readDiags diag.Diagnostics
+
+		resp.NewState, resp.Private, readDiags = readAfterWrite(ctx, "update", req.Resource, req.PlannedState, req.ProviderMeta, resp.NewState, resp.Private)
+
+		resp.Diagnostics.Append(readDiags...)
+	}
+
+	if !resp.Diagnostics.HasError() {
+		resp.Private = privateWithStateShape(ctx, resp.Private, req.ResourceSchema)
+	}
 }
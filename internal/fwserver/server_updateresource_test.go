@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider/metaschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -688,3 +689,200 @@ func TestServerUpdateResource(t *testing.T) {
 		})
 	}
 }
+
+func TestServerUpdateResource_readAfterWrite(t *testing.T) {
+	t.Parallel()
+
+	testSchemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_computed": tftypes.String,
+			"test_id":       tftypes.String,
+			"test_required": tftypes.String,
+		},
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"test_computed": schema.StringAttribute{
+				Computed: true,
+			},
+			"test_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"test_required": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	testPriorState := &tfsdk.State{
+		Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+			"test_computed": tftypes.NewValue(tftypes.String, "test-prior-value"),
+			"test_id":       tftypes.NewValue(tftypes.String, "test-id-value"),
+			"test_required": tftypes.NewValue(tftypes.String, "test-old-value"),
+		}),
+		Schema: testSchema,
+	}
+
+	testPlannedState := &tfsdk.Plan{
+		Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+			"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"test_id":       tftypes.NewValue(tftypes.String, "test-id-value"),
+			"test_required": tftypes.NewValue(tftypes.String, "test-new-value"),
+		}),
+		Schema: testSchema,
+	}
+
+	testUpdatedState := &tfsdk.State{
+		Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+			"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"test_id":       tftypes.NewValue(tftypes.String, "test-id-value"),
+			"test_required": tftypes.NewValue(tftypes.String, "test-new-value"),
+		}),
+		Schema: testSchema,
+	}
+
+	testUpdateMethod := func(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+		resp.State.Raw = req.Plan.Raw.Copy()
+	}
+
+	testEmptyProviderData := privatestate.EmptyProviderData(context.Background())
+
+	testCases := map[string]struct {
+		readAfterWrite   bool
+		readMethod       func(context.Context, resource.ReadRequest, *resource.ReadResponse)
+		expectedResponse *fwserver.UpdateResourceResponse
+	}{
+		"read": {
+			readAfterWrite: true,
+			readMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+				var required types.String
+
+				resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("test_required"), &required)...)
+
+				if required.ValueString() != "test-new-value" {
+					resp.Diagnostics.AddError("Unexpected req.State Value", "Got: "+required.String())
+				}
+
+				// Known planned values are kept in the new state.
+				resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_required"), "TEST-NEW-VALUE")...)
+				resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_computed"), "test-read-value")...)
+			},
+			expectedResponse: &fwserver.UpdateResourceResponse{
+				NewState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, "test-read-value"),
+						"test_id":       tftypes.NewValue(tftypes.String, "test-id-value"),
+						"test_required": tftypes.NewValue(tftypes.String, "test-new-value"),
+					}),
+					Schema: testSchema,
+				},
+				Private: &privatestate.Data{
+					Provider: testEmptyProviderData,
+				},
+			},
+		},
+		"read-disabled": {
+			readAfterWrite: false,
+			readMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+				resp.Diagnostics.AddError("Unexpected Read Call", "Read should not be called.")
+			},
+			expectedResponse: &fwserver.UpdateResourceResponse{
+				NewState: testUpdatedState,
+				Private: &privatestate.Data{
+					Provider: testEmptyProviderData,
+				},
+			},
+		},
+		"read-diagnostics": {
+			readAfterWrite: true,
+			readMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+				resp.Diagnostics.AddError("test summary", "test detail")
+			},
+			expectedResponse: &fwserver.UpdateResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic("test summary", "test detail"),
+				},
+				NewState: testUpdatedState,
+				Private: &privatestate.Data{
+					Provider: testEmptyProviderData,
+				},
+			},
+		},
+		"read-resourcenotfound": {
+			readAfterWrite: true,
+			readMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+				resp.Diagnostics.AddWarning("test warning summary", "test warning detail")
+				resp.Diagnostics.AddResourceNotFound("API returned 404.")
+			},
+			expectedResponse: &fwserver.UpdateResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic("test warning summary", "test warning detail"),
+					diag.NewErrorDiagnostic(
+						"Resource Not Found",
+						"The resource was not found when reading it after the resource update. "+
+							"The remote object may not be available yet or may have been removed by another process. "+
+							"The resource state returned by the update has been saved.\n\n"+
+							"API returned 404.",
+					),
+				},
+				NewState: testUpdatedState,
+				Private: &privatestate.Data{
+					Provider: testEmptyProviderData,
+				},
+			},
+		},
+		"read-removeresource": {
+			readAfterWrite: true,
+			readMethod: func(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+				resp.State.RemoveResource(ctx)
+			},
+			expectedResponse: &fwserver.UpdateResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Missing Resource State After Read",
+						"The Terraform Provider unexpectedly removed the resource when reading it after the resource update. "+
+							"The remote object may not be available yet or may have been removed by another process.\n\n"+
+							"This is always an issue in the Terraform Provider and should be reported to the provider developers.",
+					),
+				},
+				NewState: testUpdatedState,
+				Private: &privatestate.Data{
+					Provider: testEmptyProviderData,
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			}
+
+			response := &fwserver.UpdateResourceResponse{}
+			server.UpdateResource(context.Background(), &fwserver.UpdateResourceRequest{
+				PlannedState:   testPlannedState,
+				PriorState:     testPriorState,
+				ResourceSchema: testSchema,
+				Resource: &testprovider.ResourceWithReadAfterWrite{
+					Resource: &testprovider.Resource{
+						UpdateMethod: testUpdateMethod,
+						ReadMethod:   testCase.readMethod,
+					},
+					ReadAfterWriteMethod: func(_ context.Context) bool {
+						return testCase.readAfterWrite
+					},
+				},
+			}, response)
+
+			if diff := cmp.Diff(response, testCase.expectedResponse, cmp.AllowUnexported(privatestate.ProviderData{})); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromflatmap"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -78,13 +79,12 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 
 		resourceSchemaType := req.ResourceSchema.Type().TerraformType(ctx)
 
-		rawStateValue, err := req.RawState.UnmarshalWithOpts(resourceSchemaType, unmarshalOpts)
+		rawStateValue, err := unmarshalRawState(req.RawState, resourceSchemaType, unmarshalOpts)
 
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to Read Previously Saved State for UpgradeResourceState",
 				"There was an error reading the saved resource state using the current resource schema.\n\n"+
-					"If this resource state was last refreshed with Terraform CLI 0.11 and earlier, it must be refreshed or applied with an older provider version first. "+
 					"If you manually modified the resource state, you will need to manually modify it to match the current resource schema. "+
 					"Otherwise, please report this to the provider developer:\n\n"+err.Error(),
 			)
@@ -141,20 +141,107 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 		resourceStateUpgraders = make(map[int64]resource.StateUpgrader, 0)
 	}
 
-	resourceStateUpgrader, ok := resourceStateUpgraders[req.Version]
+	currentVersion := req.ResourceSchema.GetVersion()
+	rawState := req.RawState
+	version := req.Version
 
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unable to Upgrade Resource State",
-			"This resource was implemented with an UpgradeState() method, "+
-				fmt.Sprintf("however Terraform was expecting an implementation for version %d upgrade.\n\n", req.Version)+
-				"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
-		)
-		return
+	// Chained state upgraders are applied in sequence, each upgrading the
+	// state to the PriorSchema of the next state upgrader, until a state
+	// upgrader upgrades the state to the current schema version.
+	for {
+		resourceStateUpgrader, ok := resourceStateUpgraders[version]
+
+		if !ok {
+			resp.Diagnostics.AddError(
+				"Unable to Upgrade Resource State",
+				"This resource was implemented with an UpgradeState() method, "+
+					fmt.Sprintf("however Terraform was expecting an implementation for version %d upgrade.\n\n", version)+
+					"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+			)
+			return
+		}
+
+		targetVersion := currentVersion
+		targetSchema := req.ResourceSchema
+
+		if resourceStateUpgrader.TargetVersion != 0 && resourceStateUpgrader.TargetVersion != currentVersion {
+			targetVersion = resourceStateUpgrader.TargetVersion
+
+			if targetVersion <= version || targetVersion > currentVersion {
+				resp.Diagnostics.AddError(
+					"Unable to Upgrade Resource State",
+					fmt.Sprintf("The state upgrader for version %d has an invalid target version %d. ", version, targetVersion)+
+						fmt.Sprintf("The target version must be greater than %d and not greater than the current schema version %d.\n\n", version, currentVersion)+
+						"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+				)
+				return
+			}
+
+			targetStateUpgrader, ok := resourceStateUpgraders[targetVersion]
+
+			if !ok || targetStateUpgrader.PriorSchema == nil {
+				resp.Diagnostics.AddError(
+					"Unable to Upgrade Resource State",
+					fmt.Sprintf("The state upgrader for version %d has a target version of %d, ", version, targetVersion)+
+						fmt.Sprintf("however there is no state upgrader for version %d with a PriorSchema to continue the upgrade.\n\n", targetVersion)+
+						"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+				)
+				return
+			}
+
+			targetSchema = *targetStateUpgrader.PriorSchema
+		}
+
+		logging.FrameworkTrace(ctx, "Upgrading resource state", map[string]any{
+			"from_version": version,
+			"to_version":   targetVersion,
+		})
+
+		upgradedState, diags := upgradeResourceStateStep(ctx, resourceStateUpgrader, rawState, version, targetVersion, targetSchema, unmarshalOpts)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			if version != req.Version || targetVersion != currentVersion {
+				resp.Diagnostics.AddError(
+					"Unable to Upgrade Resource State",
+					fmt.Sprintf("The resource state upgrade from version %d to version %d failed, ", version, targetVersion)+
+						fmt.Sprintf("while upgrading the prior state from version %d to the current schema version %d. ", req.Version, currentVersion)+
+						"Refer to the other error diagnostics for details.",
+				)
+			}
+
+			return
+		}
+
+		if targetVersion == currentVersion {
+			resp.UpgradedState = upgradedState
+
+			return
+		}
+
+		upgradedStateJSON, diags := upgradedState.ToJSON(ctx)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			return
+		}
+
+		rawState = &tfprotov6.RawState{
+			JSON: upgradedStateJSON,
+		}
+		version = targetVersion
 	}
+}
+
+// upgradeResourceStateStep calls the state upgrader with the raw state of the
+// given version and returns the upgraded state of the target version.
+func upgradeResourceStateStep(ctx context.Context, resourceStateUpgrader resource.StateUpgrader, rawState *tfprotov6.RawState, version int64, targetVersion int64, targetSchema fwschema.Schema, unmarshalOpts tfprotov6.UnmarshalOpts) (*tfsdk.State, diag.Diagnostics) {
+	var diags diag.Diagnostics
 
 	upgradeResourceStateRequest := resource.UpgradeStateRequest{
-		RawState: req.RawState,
+		RawState: rawState,
 	}
 
 	if resourceStateUpgrader.PriorSchema != nil {
@@ -162,15 +249,15 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 
 		priorSchemaType := resourceStateUpgrader.PriorSchema.Type().TerraformType(ctx)
 
-		rawStateValue, err := req.RawState.UnmarshalWithOpts(priorSchemaType, unmarshalOpts)
+		rawStateValue, err := unmarshalRawState(rawState, priorSchemaType, unmarshalOpts)
 
 		if err != nil {
-			resp.Diagnostics.AddError(
+			diags.AddError(
 				"Unable to Read Previously Saved State for UpgradeResourceState",
-				fmt.Sprintf("There was an error reading the saved resource state using the prior resource schema defined for version %d upgrade.\n\n", req.Version)+
+				fmt.Sprintf("There was an error reading the saved resource state using the prior resource schema defined for version %d upgrade.\n\n", version)+
 					"Please report this to the provider developer:\n\n"+err.Error(),
 			)
-			return
+			return nil, diags
 		}
 
 		upgradeResourceStateRequest.State = &tfsdk.State{
@@ -181,7 +268,7 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 
 	upgradeResourceStateResponse := resource.UpgradeStateResponse{
 		State: tfsdk.State{
-			Schema: req.ResourceSchema,
+			Schema: targetSchema,
 			// Raw is intentionally not set.
 		},
 	}
@@ -195,43 +282,52 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 	resourceStateUpgrader.StateUpgrader(ctx, upgradeResourceStateRequest, &upgradeResourceStateResponse)
 	logging.FrameworkDebug(ctx, "Called provider defined StateUpgrader")
 
-	resp.Diagnostics.Append(upgradeResourceStateResponse.Diagnostics...)
+	diags.Append(upgradeResourceStateResponse.Diagnostics...)
 
-	if resp.Diagnostics.HasError() {
-		return
+	if diags.HasError() {
+		return nil, diags
 	}
 
 	if upgradeResourceStateResponse.DynamicValue != nil {
 		logging.FrameworkTrace(ctx, "UpgradeResourceStateResponse DynamicValue set, overriding State")
 
-		upgradedStateValue, err := upgradeResourceStateResponse.DynamicValue.Unmarshal(req.ResourceSchema.Type().TerraformType(ctx))
+		upgradedStateValue, err := upgradeResourceStateResponse.DynamicValue.Unmarshal(targetSchema.Type().TerraformType(ctx))
 
 		if err != nil {
-			resp.Diagnostics.AddError(
+			diags.AddError(
 				"Unable to Upgrade Resource State",
-				fmt.Sprintf("After attempting a resource state upgrade to version %d, the provider returned state data that was not compatible with the current schema.\n\n", req.Version)+
+				fmt.Sprintf("After attempting a resource state upgrade to version %d, the provider returned state data that was not compatible with the current schema.\n\n", version)+
 					"This is always an issue with the Terraform Provider and should be reported to the provider developer:\n\n"+err.Error(),
 			)
-			return
+			return nil, diags
 		}
 
-		resp.UpgradedState = &tfsdk.State{
-			Schema: req.ResourceSchema,
+		return &tfsdk.State{
+			Schema: targetSchema,
 			Raw:    upgradedStateValue,
-		}
-
-		return
+		}, diags
 	}
 
 	if upgradeResourceStateResponse.State.Raw.Type() == nil || upgradeResourceStateResponse.State.Raw.IsNull() {
-		resp.Diagnostics.AddError(
+		diags.AddError(
 			"Missing Upgraded Resource State",
-			fmt.Sprintf("After attempting a resource state upgrade to version %d, the provider did not return any state data. ", req.Version)+
+			fmt.Sprintf("After attempting a resource state upgrade to version %d, the provider did not return any state data. ", version)+
 				"Preventing the unexpected loss of resource state data. "+
 				"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
 		)
-		return
+		return nil, diags
+	}
+
+	return &upgradeResourceStateResponse.State, diags
+}
+
+// unmarshalRawState returns the value of the given type from the raw state.
+// Unlike the RawState Unmarshal methods, this supports the flatmap format of
+// raw state written by Terraform CLI 0.11 and earlier.
+func unmarshalRawState(rawState *tfprotov6.RawState, typ tftypes.Type, unmarshalOpts tfprotov6.UnmarshalOpts) (tftypes.Value, error) {
+	if rawState.JSON == nil && rawState.Flatmap != nil {
+		return fromflatmap.Value(rawState.Flatmap, typ)
 	}
 
-	resp.UpgradedState = &upgradeResourceStateResponse.State
+	return rawState.UnmarshalWithOpts(typ, unmarshalOpts)
 }
@@ -514,6 +514,92 @@ func TestServerUpgradeResourceState(t *testing.T) {
 				},
 			},
 		},
+		"PriorSchema-and-State-flatmap": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpgradeResourceStateRequest{
+				RawState: &tfprotov6.RawState{
+					Flatmap: map[string]string{
+						"id":                 "test-id-value",
+						"required_attribute": "true",
+						"rule.#":             "2",
+						"rule.1111.port":     "80",
+						"rule.2222.port":     "443",
+					},
+				},
+				ResourceSchema: testSchema,
+				Resource: &testprovider.ResourceWithUpgradeState{
+					Resource: &testprovider.Resource{},
+					UpgradeStateMethod: func(ctx context.Context) map[int64]resource.StateUpgrader {
+						return map[int64]resource.StateUpgrader{
+							0: {
+								PriorSchema: &schema.Schema{
+									Attributes: map[string]schema.Attribute{
+										"id": schema.StringAttribute{
+											Computed: true,
+										},
+										"required_attribute": schema.BoolAttribute{
+											Required: true,
+										},
+									},
+									Blocks: map[string]schema.Block{
+										"rule": schema.SetNestedBlock{
+											NestedObject: schema.NestedBlockObject{
+												Attributes: map[string]schema.Attribute{
+													"port": schema.Int64Attribute{
+														Required: true,
+													},
+												},
+											},
+										},
+									},
+								},
+								StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+									var priorStateData struct {
+										Id                string `tfsdk:"id"`
+										RequiredAttribute bool   `tfsdk:"required_attribute"`
+										Rule              []struct {
+											Port int64 `tfsdk:"port"`
+										} `tfsdk:"rule"`
+									}
+
+									resp.Diagnostics.Append(req.State.Get(ctx, &priorStateData)...)
+
+									if resp.Diagnostics.HasError() {
+										return
+									}
+
+									optionalAttribute := fmt.Sprintf("%d rules", len(priorStateData.Rule))
+									upgradedStateData := struct {
+										Id                string  `tfsdk:"id"`
+										OptionalAttribute *string `tfsdk:"optional_attribute"`
+										RequiredAttribute string  `tfsdk:"required_attribute"`
+									}{
+										Id:                priorStateData.Id,
+										OptionalAttribute: &optionalAttribute,
+										RequiredAttribute: fmt.Sprintf("%t", priorStateData.RequiredAttribute),
+									}
+
+									resp.Diagnostics.Append(resp.State.Set(ctx, upgradedStateData)...)
+								},
+							},
+						}
+					},
+				},
+				Version: 0,
+			},
+			expectedResponse: &fwserver.UpgradeResourceStateResponse{
+				UpgradedState: &tfsdk.State{
+					Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+						"id":                 tftypes.NewValue(tftypes.String, "test-id-value"),
+						"optional_attribute": tftypes.NewValue(tftypes.String, "2 rules"),
+						"required_attribute": tftypes.NewValue(tftypes.String, "true"),
+					}),
+					Schema: testSchema,
+				},
+			},
+		},
 		"PriorSchema-and-State-json-mismatch": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -646,7 +732,8 @@ func TestServerUpgradeResourceState(t *testing.T) {
 			request: &fwserver.UpgradeResourceStateRequest{
 				RawState: &tfprotov6.RawState{
 					Flatmap: map[string]string{
-						"flatmap": "is not supported",
+						"id":                 "test-id-value",
+						"required_attribute": "true",
 					},
 				},
 				ResourceSchema: testSchema,
@@ -654,15 +741,13 @@ func TestServerUpgradeResourceState(t *testing.T) {
 				Version:        1, // Must match current tfsdk.Schema version to trigger framework implementation
 			},
 			expectedResponse: &fwserver.UpgradeResourceStateResponse{
-				Diagnostics: diag.Diagnostics{
-					diag.NewErrorDiagnostic(
-						"Unable to Read Previously Saved State for UpgradeResourceState",
-						"There was an error reading the saved resource state using the current resource schema.\n\n"+
-							"If this resource state was last refreshed with Terraform CLI 0.11 and earlier, it must be refreshed or applied with an older provider version first. "+
-							"If you manually modified the resource state, you will need to manually modify it to match the current resource schema. "+
-							"Otherwise, please report this to the provider developer:\n\n"+
-							"flatmap states cannot be unmarshaled, only states written by Terraform 0.12 and higher can be unmarshaled",
-					),
+				UpgradedState: &tfsdk.State{
+					Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+						"id":                 tftypes.NewValue(tftypes.String, "test-id-value"),
+						"optional_attribute": tftypes.NewValue(tftypes.String, nil),
+						"required_attribute": tftypes.NewValue(tftypes.String, "true"),
+					}),
+					Schema: testSchema,
 				},
 			},
 		},
@@ -776,3 +861,248 @@ func TestServerUpgradeResourceState(t *testing.T) {
 		})
 	}
 }
+
+func TestServerUpgradeResourceState_chained(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaV0 := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"enabled": schema.BoolAttribute{
+				Required: true,
+			},
+		},
+	}
+	schemaV1 := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"enabled": schema.StringAttribute{
+				Required: true,
+			},
+		},
+		Version: 1,
+	}
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"status": schema.StringAttribute{
+				Required: true,
+			},
+		},
+		Version: 2,
+	}
+	schemaType := testSchema.Type().TerraformType(ctx)
+
+	upgraderV0 := resource.StateUpgrader{
+		PriorSchema: &schemaV0,
+		StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+			var priorStateData struct {
+				Id      string `tfsdk:"id"`
+				Enabled bool   `tfsdk:"enabled"`
+			}
+
+			resp.Diagnostics.Append(req.State.Get(ctx, &priorStateData)...)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			upgradedStateData := struct {
+				Id      string `tfsdk:"id"`
+				Enabled string `tfsdk:"enabled"`
+			}{
+				Id:      priorStateData.Id,
+				Enabled: fmt.Sprintf("%t", priorStateData.Enabled),
+			}
+
+			resp.Diagnostics.Append(resp.State.Set(ctx, upgradedStateData)...)
+		},
+		TargetVersion: 1,
+	}
+	upgraderV1 := resource.StateUpgrader{
+		PriorSchema: &schemaV1,
+		StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+			var priorStateData struct {
+				Id      string `tfsdk:"id"`
+				Enabled string `tfsdk:"enabled"`
+			}
+
+			resp.Diagnostics.Append(req.State.Get(ctx, &priorStateData)...)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			upgradedStateData := struct {
+				Id     string `tfsdk:"id"`
+				Status string `tfsdk:"status"`
+			}{
+				Id:     priorStateData.Id,
+				Status: "disabled",
+			}
+
+			if priorStateData.Enabled == "true" {
+				upgradedStateData.Status = "enabled"
+			}
+
+			resp.Diagnostics.Append(resp.State.Set(ctx, upgradedStateData)...)
+		},
+		TargetVersion: 2,
+	}
+
+	testCases := map[string]struct {
+		rawState         map[string]interface{}
+		upgraders        map[int64]resource.StateUpgrader
+		version          int64
+		expectedResponse *fwserver.UpgradeResourceStateResponse
+	}{
+		"version-0": {
+			rawState: map[string]interface{}{
+				"id":      "test-id-value",
+				"enabled": true,
+			},
+			upgraders: map[int64]resource.StateUpgrader{
+				0: upgraderV0,
+				1: upgraderV1,
+			},
+			version: 0,
+			expectedResponse: &fwserver.UpgradeResourceStateResponse{
+				UpgradedState: &tfsdk.State{
+					Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+						"id":     tftypes.NewValue(tftypes.String, "test-id-value"),
+						"status": tftypes.NewValue(tftypes.String, "enabled"),
+					}),
+					Schema: testSchema,
+				},
+			},
+		},
+		"version-1": {
+			rawState: map[string]interface{}{
+				"id":      "test-id-value",
+				"enabled": "false",
+			},
+			upgraders: map[int64]resource.StateUpgrader{
+				0: upgraderV0,
+				1: upgraderV1,
+			},
+			version: 1,
+			expectedResponse: &fwserver.UpgradeResourceStateResponse{
+				UpgradedState: &tfsdk.State{
+					Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+						"id":     tftypes.NewValue(tftypes.String, "test-id-value"),
+						"status": tftypes.NewValue(tftypes.String, "disabled"),
+					}),
+					Schema: testSchema,
+				},
+			},
+		},
+		"step-error": {
+			rawState: map[string]interface{}{
+				"id":      "test-id-value",
+				"enabled": true,
+			},
+			upgraders: map[int64]resource.StateUpgrader{
+				0: upgraderV0,
+				1: {
+					PriorSchema: &schemaV1,
+					StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+						resp.Diagnostics.AddError("Test Error", "test")
+					},
+				},
+			},
+			version: 0,
+			expectedResponse: &fwserver.UpgradeResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic("Test Error", "test"),
+					diag.NewErrorDiagnostic(
+						"Unable to Upgrade Resource State",
+						"The resource state upgrade from version 1 to version 2 failed, "+
+							"while upgrading the prior state from version 0 to the current schema version 2. "+
+							"Refer to the other error diagnostics for details.",
+					),
+				},
+			},
+		},
+		"target-version-missing-upgrader": {
+			rawState: map[string]interface{}{
+				"id":      "test-id-value",
+				"enabled": true,
+			},
+			upgraders: map[int64]resource.StateUpgrader{
+				0: upgraderV0,
+			},
+			version: 0,
+			expectedResponse: &fwserver.UpgradeResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Unable to Upgrade Resource State",
+						"The state upgrader for version 0 has a target version of 1, "+
+							"however there is no state upgrader for version 1 with a PriorSchema to continue the upgrade.\n\n"+
+							"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+					),
+				},
+			},
+		},
+		"target-version-invalid": {
+			rawState: map[string]interface{}{
+				"id":      "test-id-value",
+				"enabled": "true",
+			},
+			upgraders: map[int64]resource.StateUpgrader{
+				1: {
+					PriorSchema:   &schemaV1,
+					StateUpgrader: upgraderV1.StateUpgrader,
+					TargetVersion: 1,
+				},
+			},
+			version: 1,
+			expectedResponse: &fwserver.UpgradeResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Unable to Upgrade Resource State",
+						"The state upgrader for version 1 has an invalid target version 1. "+
+							"The target version must be greater than 1 and not greater than the current schema version 2.\n\n"+
+							"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			}
+			request := &fwserver.UpgradeResourceStateRequest{
+				RawState:       testNewRawState(t, testCase.rawState),
+				ResourceSchema: testSchema,
+				Resource: &testprovider.ResourceWithUpgradeState{
+					Resource: &testprovider.Resource{},
+					UpgradeStateMethod: func(ctx context.Context) map[int64]resource.StateUpgrader {
+						return testCase.upgraders
+					},
+				},
+				Version: testCase.version,
+			}
+			response := &fwserver.UpgradeResourceStateResponse{}
+
+			server.UpgradeResourceState(context.Background(), request, response)
+
+			if diff := cmp.Diff(response, testCase.expectedResponse); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
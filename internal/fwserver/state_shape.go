@@ -0,0 +1,196 @@
+package fwserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadiff"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// privateStateKeyStateShape is the framework private state key which stores
+// the resource schema version and state shape hashes.
+const privateStateKeyStateShape = ".state_shape"
+
+// stateShape is the JSON encoded private state data for detecting resource
+// schema changes which affect saved state.
+type stateShape struct {
+	Version int64             `json:"version"`
+	Shapes  map[string]string `json:"shapes"`
+}
+
+// stateShapeDetectionEnabled returns true if the schema opts into state
+// shape detection.
+func stateShapeDetectionEnabled(s fwschema.Schema) bool {
+	sWithDetection, ok := s.(fwxschema.SchemaWithStateShapeDetection)
+
+	return ok && sWithDetection.GetDetectStateShapeChanges()
+}
+
+// privateWithStateShape returns a copy of the private state data with the
+// state shape of the given schema, if the schema enables state shape
+// detection. Otherwise, the private state data is returned unmodified.
+func privateWithStateShape(ctx context.Context, private *privatestate.Data, s fwschema.Schema) *privatestate.Data {
+	if s == nil || !stateShapeDetectionEnabled(s) {
+		return private
+	}
+
+	value, err := json.Marshal(stateShape{
+		Version: s.GetVersion(),
+		Shapes:  fwschemadiff.StateShape(ctx, s),
+	})
+
+	if err != nil {
+		logging.FrameworkError(ctx, "Error encoding resource state shape", map[string]interface{}{logging.KeyError: err.Error()})
+
+		return private
+	}
+
+	result := &privatestate.Data{
+		Framework: map[string][]byte{
+			privateStateKeyStateShape: value,
+		},
+	}
+
+	if private != nil {
+		for k, v := range private.Framework {
+			if k == privateStateKeyStateShape {
+				continue
+			}
+
+			result.Framework[k] = v
+		}
+
+		result.Provider = private.Provider
+	}
+
+	return result
+}
+
+// privateWithReadStateShape returns a copy of the private state data with the
+// state shape of the given schema after ReadResource, if no state shape was
+// saved or the saved state shape is for a prior schema version with a
+// StateUpgrader, which Terraform calls before ReadResource. Otherwise, the
+// saved state shape is kept, so changes without a schema version increment
+// are reported on every refresh until the resource is created or updated.
+func privateWithReadStateShape(ctx context.Context, r resource.Resource, private *privatestate.Data, s fwschema.Schema) *privatestate.Data {
+	if s == nil || !stateShapeDetectionEnabled(s) {
+		return private
+	}
+
+	saved, ok := savedStateShape(ctx, private)
+
+	if ok && !(saved.Version < s.GetVersion() && hasStateUpgrader(ctx, r, saved.Version)) {
+		return private
+	}
+
+	return privateWithStateShape(ctx, private, s)
+}
+
+// savedStateShape returns the state shape saved in the private state data, if
+// any. Invalid saved state shapes are ignored.
+func savedStateShape(ctx context.Context, private *privatestate.Data) (stateShape, bool) {
+	var saved stateShape
+
+	if private == nil {
+		return saved, false
+	}
+
+	value, ok := private.Framework[privateStateKeyStateShape]
+
+	if !ok {
+		return saved, false
+	}
+
+	if err := json.Unmarshal(value, &saved); err != nil {
+		logging.FrameworkWarn(ctx, "Discarding invalid resource state shape", map[string]interface{}{logging.KeyError: err.Error()})
+
+		return saved, false
+	}
+
+	return saved, true
+}
+
+// hasStateUpgrader returns true if the resource implements a StateUpgrader
+// for the given schema version.
+func hasStateUpgrader(ctx context.Context, r resource.Resource, version int64) bool {
+	resourceWithUpgradeState, ok := r.(resource.ResourceWithUpgradeState)
+
+	if !ok {
+		return false
+	}
+
+	_, ok = resourceWithUpgradeState.UpgradeState(ctx)[version]
+
+	return ok
+}
+
+// StateShapeDiagnostics compares the state shape saved in the resource private
+// state against the given resource schema. Diagnostics with the given
+// severity are returned when the saved state shape has changes which affect
+// saved state without a schema version increment, or the version was
+// increased without a StateUpgrader for the saved version.
+//
+// No diagnostics are returned if the schema does not enable state shape
+// detection or the private state has no saved state shape.
+func StateShapeDiagnostics(ctx context.Context, r resource.Resource, s fwschema.Schema, private *privatestate.Data, severity diag.Severity) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if s == nil || !stateShapeDetectionEnabled(s) {
+		return nil
+	}
+
+	saved, ok := savedStateShape(ctx, private)
+
+	if !ok {
+		return nil
+	}
+
+	if !fwschemadiff.StateShapeChanged(saved.Shapes, fwschemadiff.StateShape(ctx, s)) {
+		return nil
+	}
+
+	version := s.GetVersion()
+
+	switch {
+	case saved.Version == version:
+		diags.Append(stateShapeDiagnostic(
+			severity,
+			"Resource Schema Changed Without Version Increment",
+			"The resource schema has changes which affect saved state, such as attribute type or nesting mode changes, "+
+				fmt.Sprintf("however the schema version was not increased from %d. ", version)+
+				"Existing resources may fail to read or upgrade their saved state.\n\n"+
+				"This is always an issue in the Terraform Provider and should be reported to the provider developers. "+
+				fmt.Sprintf("Increase the resource schema Version and implement a StateUpgrader for version %d in the resource UpgradeState method.", version),
+		))
+	case saved.Version < version:
+		if hasStateUpgrader(ctx, r, saved.Version) {
+			return nil
+		}
+
+		diags.Append(stateShapeDiagnostic(
+			severity,
+			"Missing Resource State Upgrader",
+			fmt.Sprintf("The resource schema version was increased from %d to %d with changes which affect saved state, ", saved.Version, version)+
+				fmt.Sprintf("however the resource UpgradeState method does not implement a StateUpgrader for version %d.\n\n", saved.Version)+
+				"This is always an issue in the Terraform Provider and should be reported to the provider developers.",
+		))
+	}
+
+	return diags
+}
+
+// stateShapeDiagnostic returns a diagnostic with the given severity.
+func stateShapeDiagnostic(severity diag.Severity, summary string, detail string) diag.Diagnostic {
+	if severity == diag.SeverityError {
+		return diag.NewErrorDiagnostic(summary, detail)
+	}
+
+	return diag.NewWarningDiagnostic(summary, detail)
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 	"unicode/utf8"
 
@@ -242,6 +243,27 @@ type ProviderData struct {
 	data map[string][]byte
 }
 
+// DeleteKey removes the private state data associated with the given key.
+//
+// If the key is reserved for framework usage, an error diagnostic
+// is returned. If the key is valid, but private state data is not found,
+// no diagnostics are returned.
+func (d *ProviderData) DeleteKey(ctx context.Context, key string) diag.Diagnostics {
+	diags := ValidateProviderDataKey(ctx, key)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	if d == nil || d.data == nil {
+		return nil
+	}
+
+	delete(d.data, key)
+
+	return nil
+}
+
 // Equal returns true if the given ProviderData is exactly equivalent. The
 // internal data is compared byte-for-byte, not accounting for semantic
 // equivalency such as JSON whitespace or property reordering.
@@ -290,6 +312,28 @@ func (d *ProviderData) GetKey(ctx context.Context, key string) ([]byte, diag.Dia
 	return value, nil
 }
 
+// Keys returns the sorted keys which have private state data.
+func (d *ProviderData) Keys(_ context.Context) []string {
+	if d == nil || len(d.data) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(d.data))
+
+	for key, value := range d.data {
+		// Empty values are not saved in private state.
+		if len(value) == 0 {
+			continue
+		}
+
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
 // SetKey sets the private state data at the given key.
 //
 // If the key is reserved for framework usage, an error diagnostic
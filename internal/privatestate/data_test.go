@@ -553,6 +553,88 @@ func TestNewProviderData(t *testing.T) {
 	}
 }
 
+func TestProviderData_DeleteKey(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		providerData  *ProviderData
+		key           string
+		expected      *ProviderData
+		expectedDiags diag.Diagnostics
+	}{
+		"nil": {
+			providerData: nil,
+			key:          "key",
+			expected:     nil,
+		},
+		"key-invalid": {
+			providerData: &ProviderData{
+				data: map[string][]byte{
+					"providerKeyOne": []byte(`{"pKeyOne": "provider value one"}`),
+				},
+			},
+			key: ".key",
+			expected: &ProviderData{
+				data: map[string][]byte{
+					"providerKeyOne": []byte(`{"pKeyOne": "provider value one"}`),
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Restricted Resource Private State Namespace",
+					"Using a period ('.') as a prefix for a key used in private state is not allowed.\n\n"+
+						`The key ".key" is invalid. Please check the key you are supplying does not use a a period ('.') as a prefix.`,
+				),
+			},
+		},
+		"key-not-found": {
+			providerData: &ProviderData{
+				data: map[string][]byte{
+					"providerKeyOne": []byte(`{"pKeyOne": "provider value one"}`),
+				},
+			},
+			key: "key-not-found",
+			expected: &ProviderData{
+				data: map[string][]byte{
+					"providerKeyOne": []byte(`{"pKeyOne": "provider value one"}`),
+				},
+			},
+		},
+		"key-found": {
+			providerData: &ProviderData{
+				data: map[string][]byte{
+					"providerKeyOne": []byte(`{"pKeyOne": "provider value one"}`),
+					"providerKeyTwo": []byte(`{"pKeyTwo": "provider value two"}`),
+				},
+			},
+			key: "providerKeyOne",
+			expected: &ProviderData{
+				data: map[string][]byte{
+					"providerKeyTwo": []byte(`{"pKeyTwo": "provider value two"}`),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actualDiags := testCase.providerData.DeleteKey(context.Background(), testCase.key)
+
+			if diff := cmp.Diff(testCase.providerData, testCase.expected, cmp.AllowUnexported(ProviderData{})); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(actualDiags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestProviderDataEqual(t *testing.T) {
 	t.Parallel()
 
@@ -708,6 +790,51 @@ func TestProviderData_GetKey(t *testing.T) {
 	}
 }
 
+func TestProviderData_Keys(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		providerData *ProviderData
+		expected     []string
+	}{
+		"nil": {
+			providerData: nil,
+			expected:     nil,
+		},
+		"empty": {
+			providerData: &ProviderData{},
+			expected:     nil,
+		},
+		"keys": {
+			providerData: &ProviderData{
+				data: map[string][]byte{
+					"providerKeyTwo":   []byte(`{"pKeyTwo": "provider value two"}`),
+					"providerKeyOne":   []byte(`{"pKeyOne": "provider value one"}`),
+					"providerKeyEmpty": nil,
+				},
+			},
+			expected: []string{
+				"providerKeyOne",
+				"providerKeyTwo",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := testCase.providerData.Keys(context.Background())
+
+			if diff := cmp.Diff(actual, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestProviderData_SetKey(t *testing.T) {
 	t.Parallel()
 
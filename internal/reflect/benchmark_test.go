@@ -0,0 +1,151 @@
+package reflect_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	refl "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type benchmarkModel struct {
+	Items []benchmarkItemModel `tfsdk:"items"`
+}
+
+type benchmarkItemModel struct {
+	ID      types.String          `tfsdk:"id"`
+	Name    string                `tfsdk:"name"`
+	Enabled bool                  `tfsdk:"enabled"`
+	Size    int64                 `tfsdk:"size"`
+	Tags    map[string]string     `tfsdk:"tags"`
+	Nested  benchmarkNestedModel  `tfsdk:"nested"`
+	Extra   *benchmarkNestedModel `tfsdk:"extra"`
+}
+
+type benchmarkNestedModel struct {
+	Key   string       `tfsdk:"key"`
+	Value types.String `tfsdk:"value"`
+}
+
+var benchmarkNestedType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"key":   types.StringType,
+		"value": types.StringType,
+	},
+}
+
+var benchmarkType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"items": types.ListType{
+			ElemType: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"id":      types.StringType,
+					"name":    types.StringType,
+					"enabled": types.BoolType,
+					"size":    types.Int64Type,
+					"tags":    types.MapType{ElemType: types.StringType},
+					"nested":  benchmarkNestedType,
+					"extra":   benchmarkNestedType,
+				},
+			},
+		},
+	},
+}
+
+// benchmarkValue returns a benchmark model with the given number of list
+// elements and its Terraform value.
+func benchmarkValue(b *testing.B, size int) (benchmarkModel, tftypes.Value) {
+	model := benchmarkModel{
+		Items: make([]benchmarkItemModel, size),
+	}
+
+	for i := range model.Items {
+		model.Items[i] = benchmarkItemModel{
+			ID:      types.StringValue(fmt.Sprintf("id-%d", i)),
+			Name:    fmt.Sprintf("name-%d", i),
+			Enabled: i%2 == 0,
+			Size:    int64(i),
+			Tags:    map[string]string{"index": fmt.Sprint(i)},
+			Nested: benchmarkNestedModel{
+				Key:   "key",
+				Value: types.StringValue("value"),
+			},
+		}
+	}
+
+	val, diags := refl.FromValue(context.Background(), benchmarkType, model, path.Empty())
+
+	if diags.HasError() {
+		b.Fatalf("unexpected error: %v", diags)
+	}
+
+	tfVal, err := val.ToTerraformValue(context.Background())
+
+	if err != nil {
+		b.Fatalf("unexpected error: %s", err)
+	}
+
+	return model, tfVal
+}
+
+func benchmarkInto(b *testing.B, size int) {
+	ctx := context.Background()
+	_, val := benchmarkValue(b, size)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		var target benchmarkModel
+
+		diags := refl.Into(ctx, benchmarkType, val, &target, refl.Options{}, path.Empty())
+
+		if diags.HasError() {
+			b.Fatalf("unexpected error: %v", diags)
+		}
+	}
+}
+
+func benchmarkFromValue(b *testing.B, size int) {
+	ctx := context.Background()
+	model, _ := benchmarkValue(b, size)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		_, diags := refl.FromValue(ctx, benchmarkType, model, path.Empty())
+
+		if diags.HasError() {
+			b.Fatalf("unexpected error: %v", diags)
+		}
+	}
+}
+
+func BenchmarkInto_nestedStructList100(b *testing.B) {
+	benchmarkInto(b, 100)
+}
+
+func BenchmarkInto_nestedStructList1000(b *testing.B) {
+	benchmarkInto(b, 1000)
+}
+
+func BenchmarkInto_nestedStructList10000(b *testing.B) {
+	benchmarkInto(b, 10000)
+}
+
+func BenchmarkFromValue_nestedStructList100(b *testing.B) {
+	benchmarkFromValue(b, 100)
+}
+
+func BenchmarkFromValue_nestedStructList1000(b *testing.B) {
+	benchmarkFromValue(b, 1000)
+}
+
+func BenchmarkFromValue_nestedStructList10000(b *testing.B) {
+	benchmarkFromValue(b, 10000)
+}
@@ -0,0 +1,120 @@
+package reflect
+
+import (
+	"math/big"
+	"reflect"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+)
+
+// Reflecting over struct tags and method sets is expensive compared to the
+// conversions themselves, and the same Go types are converted over and over,
+// such as for every element of a list of nested objects. The results only
+// depend on the Go type, so they are computed once per type and cached for
+// the lifetime of the process.
+var (
+	structInfoCache sync.Map // map[reflect.Type]*structInfo
+	typeInfoCache   sync.Map // map[reflect.Type]*typeInfo
+)
+
+var (
+	attrValueType      = reflect.TypeOf((*attr.Value)(nil)).Elem()
+	valueConverterType = reflect.TypeOf((*tftypes.ValueConverter)(nil)).Elem()
+	unknownableType    = reflect.TypeOf((*Unknownable)(nil)).Elem()
+	nullableType       = reflect.TypeOf((*Nullable)(nil)).Elem()
+	bigFloatType       = reflect.TypeOf(big.NewFloat(0))
+	bigIntType         = reflect.TypeOf(big.NewInt(0))
+)
+
+// structInfo is the field metadata of a struct type.
+type structInfo struct {
+	// fields are the struct fields which map to Terraform attributes, in
+	// struct field order.
+	fields []StructField
+
+	// tags maps Terraform attribute names to the index sequence of their
+	// struct field.
+	tags map[string][]int
+
+	// err is set if the struct type can't be mapped to an object, in
+	// which case fields and tags are empty.
+	err *structFieldsError
+}
+
+// getStructInfo returns the field metadata of the struct type `typ`,
+// collecting it on first use.
+func getStructInfo(typ reflect.Type) *structInfo {
+	if info, ok := structInfoCache.Load(typ); ok {
+		return info.(*structInfo)
+	}
+
+	c := &structFieldsCollector{
+		names:    map[string]string{},
+		visiting: map[reflect.Type]bool{},
+	}
+	info := &structInfo{}
+
+	if err := c.collect(typ, nil, ""); err != nil {
+		info.err = err
+	} else {
+		info.fields = c.fields
+		info.tags = make(map[string][]int, len(c.fields))
+		for _, field := range c.fields {
+			info.tags[field.Name] = field.Index
+		}
+	}
+
+	// concurrent callers may have collected the same type; keep whichever
+	// was stored first, they're equivalent
+	actual, _ := structInfoCache.LoadOrStore(typ, info)
+	return actual.(*structInfo)
+}
+
+// typeInfo is the conversion plan for a Go type, recording which of the
+// special cases of BuildValue and FromValue apply to it before falling back
+// to its reflect.Kind.
+type typeInfo struct {
+	// attrValue is true if the type implements attr.Value.
+	attrValue bool
+
+	// valueConverter is true if the type implements
+	// tftypes.ValueConverter.
+	valueConverter bool
+
+	// unknownable is true if the type implements Unknownable.
+	unknownable bool
+
+	// nullable is true if the type implements Nullable.
+	nullable bool
+
+	// text is true if the type is converted using its text
+	// representation, see IsTextType.
+	text bool
+
+	// number is true for *big.Float and *big.Int, which are handled as
+	// numbers rather than pointers.
+	number bool
+}
+
+// getTypeInfo returns the conversion plan for `typ`, computing it on first
+// use.
+func getTypeInfo(typ reflect.Type) *typeInfo {
+	if info, ok := typeInfoCache.Load(typ); ok {
+		return info.(*typeInfo)
+	}
+
+	info := &typeInfo{
+		attrValue:      typ.Implements(attrValueType),
+		valueConverter: typ.Implements(valueConverterType),
+		unknownable:    typ.Implements(unknownableType),
+		nullable:       typ.Implements(nullableType),
+		text:           isTextType(typ),
+		number:         typ == bigFloatType || typ == bigIntType,
+	}
+
+	actual, _ := typeInfoCache.LoadOrStore(typ, info)
+	return actual.(*typeInfo)
+}
@@ -42,47 +42,221 @@ func commaSeparatedString(in []string) string {
 	}
 }
 
-// getStructTags returns a map of Terraform field names to their position in
-// the tags of the struct `in`. `in` must be a struct.
-func getStructTags(_ context.Context, in reflect.Value, path path.Path) (map[string]int, error) {
-	tags := map[string]int{}
+// getStructTags returns a map of Terraform field names to the index sequence
+// of their struct field in the struct `in`, suitable for FieldByIndex. `in`
+// must be a struct. Fields of embedded structs are promoted into the map.
+//
+// The map is cached per struct type and shared between callers, so it must
+// not be modified.
+func getStructTags(_ context.Context, in reflect.Value, path path.Path) (map[string][]int, error) {
 	typ := trueReflectValue(in).Type()
 	if typ.Kind() != reflect.Struct {
 		return nil, fmt.Errorf("%s: can't get struct tags of %s, is not a struct", path, in.Type())
 	}
+	info := getStructInfo(typ)
+	if info.err != nil {
+		return nil, info.err.withPath(path)
+	}
+	return info.tags, nil
+}
+
+// StructField is a struct field with a "tfsdk" tag, which is mapped to the
+// Terraform attribute of the same name.
+type StructField struct {
+	// Name is the Terraform attribute name, which is the first part of the
+	// "tfsdk" tag value.
+	Name string
+
+	// Options are the remaining comma-separated parts of the "tfsdk" tag
+	// value, such as "optional" in `tfsdk:"example,optional"`.
+	Options []string
+
+	// Index is the index sequence of the field in the struct, which has
+	// more than one element for fields promoted from embedded structs.
+	Index []int
+
+	// Field is the underlying struct field. Its Index is the same as the
+	// Index of the StructField.
+	Field reflect.StructField
+}
+
+// StructFields returns the fields of the struct type `typ` which map to
+// Terraform attributes, in struct field order. Pointer types are dereferenced.
+// Unexported fields and fields tagged with `tfsdk:"-"` are skipped. All other
+// fields must have a valid and unique "tfsdk" tag.
+//
+// The fields of untagged embedded structs, or pointers to exported structs,
+// are promoted as if they were fields of `typ`. Attribute names must be unique
+// across all embedding levels, unlike Go field names which can be shadowed.
+func StructFields(typ reflect.Type, path path.Path) ([]StructField, error) {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if typ.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%s: can't get struct tags of %s, is not a struct", path, typ)
+	}
+	return structFields(typ, path)
+}
+
+func structFields(typ reflect.Type, path path.Path) ([]StructField, error) {
+	info := getStructInfo(typ)
+	if info.err != nil {
+		return nil, info.err.withPath(path)
+	}
+	fields := make([]StructField, len(info.fields))
+	copy(fields, info.fields)
+	return fields, nil
+}
+
+// structFieldsError is an error collecting the fields of a struct type. It
+// is independent of the path the struct type was encountered at, so it can
+// be cached with the struct type.
+type structFieldsError struct {
+	// attributeName is the name of the attribute the error applies to,
+	// if any, which is appended to the path of the struct.
+	attributeName string
+
+	message string
+}
+
+// withPath returns the error for the struct type at `p`.
+func (e *structFieldsError) withPath(p path.Path) error {
+	if e.attributeName != "" {
+		p = p.AtName(e.attributeName)
+	}
+	return fmt.Errorf("%s: %s", p, e.message)
+}
+
+// structFieldsCollector accumulates the fields of a struct type and its
+// embedded struct types.
+type structFieldsCollector struct {
+	fields []StructField
+
+	// names maps attribute names to the Go field name, qualified by any
+	// embedded struct field names, which declared them.
+	names map[string]string
+
+	// visiting contains the embedded struct types currently being
+	// collected, to detect cycles through embedded pointers.
+	visiting map[reflect.Type]bool
+}
+
+func (c *structFieldsCollector) collect(typ reflect.Type, index []int, prefix string) *structFieldsError {
+	if c.visiting[typ] {
+		return &structFieldsError{message: fmt.Sprintf("can't embed %s within itself", typ)}
+	}
+	c.visiting[typ] = true
+	defer delete(c.visiting, typ)
+
 	for i := 0; i < typ.NumField(); i++ {
 		field := typ.Field(i)
-		if field.PkgPath != "" {
-			// skip unexported fields
-			continue
-		}
+		fieldIndex := append(append([]int{}, index...), i)
 		tag := field.Tag.Get(`tfsdk`)
 		if tag == "-" {
 			// skip explicitly excluded fields
 			continue
 		}
-		if tag == "" {
-			return nil, fmt.Errorf(`%s: need a struct tag for "tfsdk" on %s`, path, field.Name)
+		if field.Anonymous && tag == "" {
+			embeddedType := field.Type
+			isPtr := embeddedType.Kind() == reflect.Ptr
+			if isPtr {
+				embeddedType = embeddedType.Elem()
+			}
+			if embeddedType.Kind() == reflect.Struct {
+				if field.PkgPath != "" && isPtr {
+					// skip unexported embedded pointers, which
+					// can't be allocated
+					continue
+				}
+				err := c.collect(embeddedType, fieldIndex, prefix+field.Name+".")
+				if err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		if field.PkgPath != "" {
+			// skip unexported fields
+			continue
+		}
+		goName := prefix + field.Name
+		name, options := parseStructTag(tag)
+		if name == "" {
+			return &structFieldsError{message: fmt.Sprintf(`need a struct tag for "tfsdk" on %s`, goName)}
 		}
-		path := path.AtName(tag)
-		if !isValidFieldName(tag) {
-			return nil, fmt.Errorf("%s: invalid field name, must only use lowercase letters, underscores, and numbers, and must start with a letter", path)
+		if !isValidFieldName(name) {
+			return &structFieldsError{
+				attributeName: name,
+				message:       "invalid field name, must only use lowercase letters, underscores, and numbers, and must start with a letter",
+			}
 		}
-		if other, ok := tags[tag]; ok {
-			return nil, fmt.Errorf("%s: can't use field name for both %s and %s", path, typ.Field(other).Name, field.Name)
+		if other, ok := c.names[name]; ok {
+			return &structFieldsError{
+				attributeName: name,
+				message:       fmt.Sprintf("can't use field name for both %s and %s", other, goName),
+			}
 		}
-		tags[tag] = i
+		c.names[name] = goName
+		field.Index = fieldIndex
+		c.fields = append(c.fields, StructField{
+			Name:    name,
+			Options: options,
+			Index:   fieldIndex,
+			Field:   field,
+		})
 	}
-	return tags, nil
+	return nil
+}
+
+// fieldByIndex returns the nested field of the struct `v` at `index`. The
+// second return value is false if an embedded struct pointer along the way
+// is nil.
+func fieldByIndex(v reflect.Value, index []int) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v, true
+}
+
+// settableFieldByIndex returns the nested field of the settable struct `v`
+// at `index`, allocating any nil embedded struct pointers along the way.
+func settableFieldByIndex(v reflect.Value, index []int) reflect.Value {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v
+}
+
+// parseStructTag splits a "tfsdk" tag value into the attribute name and any
+// comma-separated options following it.
+func parseStructTag(tag string) (string, []string) {
+	parts := strings.Split(tag, ",")
+	if len(parts) == 1 {
+		return parts[0], nil
+	}
+	return parts[0], parts[1:]
 }
 
 // isValidFieldName returns true if `name` can be used as a field name in a
 // Terraform resource or data source.
 func isValidFieldName(name string) bool {
-	re := regexp.MustCompile("^[a-z][a-z0-9_]*$")
-	return re.MatchString(name)
+	return validFieldNameRegexp.MatchString(name)
 }
 
+var validFieldNameRegexp = regexp.MustCompile("^[a-z][a-z0-9_]*$")
+
 // canBeNil returns true if `target`'s type can hold a nil value
 func canBeNil(target reflect.Value) bool {
 	switch target.Kind() {
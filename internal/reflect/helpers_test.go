@@ -6,6 +6,8 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+
 	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
@@ -110,7 +112,7 @@ func TestGetStructTags_success(t *testing.T) {
 	if len(res) != 1 {
 		t.Errorf("Unexpected result: %v", res)
 	}
-	if res["exported_and_tagged"] != 0 {
+	if diff := cmp.Diff(res["exported_and_tagged"], []int{0}); diff != "" {
 		t.Errorf("Unexpected result: %v", res)
 	}
 }
@@ -175,6 +177,170 @@ func TestGetStructTags_notAStruct(t *testing.T) {
 	}
 }
 
+func TestGetStructTags_options(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		Plain    string `tfsdk:"plain"`
+		Optional string `tfsdk:"optional_field,optional,sensitive"`
+	}
+
+	res, err := getStructTags(context.Background(), reflect.ValueOf(testStruct{}), path.Empty())
+	if err != nil {
+		t.Errorf("Unexpected error: %s", err)
+	}
+
+	expected := map[string][]int{
+		"plain":          {0},
+		"optional_field": {1},
+	}
+
+	if diff := cmp.Diff(res, expected); diff != "" {
+		t.Errorf("Unexpected result (+wanted, -got): %s", diff)
+	}
+}
+
+func TestStructFields(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		Plain    string `tfsdk:"plain"`
+		Excluded string `tfsdk:"-"`
+		Optional string `tfsdk:"optional_field,optional,sensitive"`
+	}
+
+	typ := reflect.TypeOf(&testStruct{})
+
+	got, err := StructFields(typ, path.Empty())
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	expected := []StructField{
+		{
+			Name:  "plain",
+			Index: []int{0},
+			Field: typ.Elem().Field(0),
+		},
+		{
+			Name:    "optional_field",
+			Options: []string{"optional", "sensitive"},
+			Index:   []int{2},
+			Field:   typ.Elem().Field(2),
+		},
+	}
+
+	if diff := cmp.Diff(got, expected, cmp.Comparer(func(x, y reflect.StructField) bool {
+		return x.Name == y.Name && cmp.Equal(x.Index, y.Index)
+	})); diff != "" {
+		t.Errorf("Unexpected result (+wanted, -got): %s", diff)
+	}
+}
+
+func TestStructFields_invalidTag(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		Empty string `tfsdk:",optional"`
+	}
+
+	_, err := StructFields(reflect.TypeOf(testStruct{}), path.Root("nested"))
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+
+	expected := `nested: need a struct tag for "tfsdk" on Empty`
+	if err.Error() != expected {
+		t.Errorf("Expected error to be %q, got %q", expected, err.Error())
+	}
+}
+
+type EmbeddedExported struct {
+	Shared string `tfsdk:"shared"`
+}
+
+type embeddedUnexported struct {
+	Unexported string `tfsdk:"unexported"`
+}
+
+type embeddedUnexportedPointer struct {
+	Skipped string `tfsdk:"skipped"`
+}
+
+type EmbeddedCycle struct {
+	*EmbeddedCycleInner
+}
+
+type EmbeddedCycleInner struct {
+	*EmbeddedCycle
+}
+
+func TestGetStructTags_embedded(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		embeddedUnexported
+		*EmbeddedExported
+		*embeddedUnexportedPointer
+		Excluded EmbeddedExported `tfsdk:"-"`
+		Tagged   EmbeddedExported `tfsdk:"tagged"`
+		Own      string           `tfsdk:"own"`
+	}
+
+	res, err := getStructTags(context.Background(), reflect.ValueOf(testStruct{}), path.Empty())
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	expected := map[string][]int{
+		"unexported": {0, 0},
+		"shared":     {1, 0},
+		"tagged":     {4},
+		"own":        {5},
+	}
+
+	if diff := cmp.Diff(res, expected); diff != "" {
+		t.Errorf("Unexpected result (+wanted, -got): %s", diff)
+	}
+}
+
+func TestGetStructTags_embeddedDuplicateTag(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		EmbeddedExported
+		Shared string `tfsdk:"shared"`
+	}
+
+	_, err := getStructTags(context.Background(), reflect.ValueOf(testStruct{}), path.Empty())
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+
+	expected := `shared: can't use field name for both EmbeddedExported.Shared and Shared`
+	if err.Error() != expected {
+		t.Errorf("Expected error to be %q, got %q", expected, err.Error())
+	}
+}
+
+func TestGetStructTags_embeddedCycle(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		*EmbeddedCycle
+	}
+
+	_, err := getStructTags(context.Background(), reflect.ValueOf(testStruct{}), path.Empty())
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+
+	expected := `: can't embed reflect.EmbeddedCycle within itself`
+	if err.Error() != expected {
+		t.Errorf("Expected error to be %q, got %q", expected, err.Error())
+	}
+}
+
 func TestIsValidFieldName(t *testing.T) {
 	t.Parallel()
 	tests := map[string]bool{
@@ -256,3 +422,70 @@ func TestCanBeNil_interface(t *testing.T) {
 		t.Errorf("Expected interfaces to be nillable, but canBeNil said they weren't")
 	}
 }
+
+func TestStructFields_cachedErrorPath(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		InvalidTag string `tfsdk:"invalidTag"`
+	}
+
+	for _, p := range []path.Path{path.Root("first"), path.Root("second").AtListIndex(1)} {
+		_, err := StructFields(reflect.TypeOf(testStruct{}), p)
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		expected := p.AtName("invalidTag").String() + ": invalid field name, must only use lowercase letters, underscores, and numbers, and must start with a letter"
+		if err.Error() != expected {
+			t.Errorf("Expected error to be %q, got %q", expected, err.Error())
+		}
+	}
+}
+
+func TestStructFields_copy(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		Name string `tfsdk:"name"`
+	}
+
+	fields, err := StructFields(reflect.TypeOf(testStruct{}), path.Empty())
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	fields[0].Name = "modified"
+
+	fields, err = StructFields(reflect.TypeOf(testStruct{}), path.Empty())
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if fields[0].Name != "name" {
+		t.Errorf("Expected cached field name to be unchanged, got %q", fields[0].Name)
+	}
+}
+
+func BenchmarkGetStructTags(b *testing.B) {
+	type testStruct struct {
+		EmbeddedExported
+		ID      string   `tfsdk:"id"`
+		Name    string   `tfsdk:"name"`
+		Enabled bool     `tfsdk:"enabled"`
+		Size    int64    `tfsdk:"size"`
+		Tags    []string `tfsdk:"tags"`
+	}
+
+	val := reflect.ValueOf(testStruct{})
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		_, err := getStructTags(context.Background(), val, path.Empty())
+		if err != nil {
+			b.Fatalf("Unexpected error: %s", err)
+		}
+	}
+}
@@ -274,7 +274,8 @@ func FromValueCreator(ctx context.Context, typ attr.Type, val tftypes.ValueCreat
 
 // NewAttributeValue creates a new reflect.Value by calling the
 // ValueFromTerraform method on `typ`. It will return an error if the returned
-// `attr.Value` is not the same type as `target`.
+// `attr.Value` is not the same type as `target`, unless `target` is an
+// xattr.ValueWithDerivedType whose derived type can be used instead.
 //
 // It is meant to be called through Into, not directly.
 func NewAttributeValue(ctx context.Context, typ attr.Type, val tftypes.Value, target reflect.Value, opts Options, path path.Path) (reflect.Value, diag.Diagnostics) {
@@ -292,6 +293,16 @@ func NewAttributeValue(ctx context.Context, typ attr.Type, val tftypes.Value, ta
 	if err != nil {
 		return target, append(diags, valueFromTerraformErrorDiag(err, path))
 	}
+	if reflect.TypeOf(res) != target.Type() {
+		// values with a derived type can be built with that type
+		// instead, as long as it's equivalent to the schema type
+		if derivedType, ok := derivedValueType(ctx, typ, target); ok {
+			res, err = derivedType.ValueFromTerraform(ctx, val)
+			if err != nil {
+				return target, append(diags, valueFromTerraformErrorDiag(err, path))
+			}
+		}
+	}
 	if reflect.TypeOf(res) != target.Type() {
 		diags.Append(diag.WithPath(path, DiagNewAttributeValueIntoWrongType{
 			ValType:    reflect.TypeOf(res),
@@ -303,6 +314,22 @@ func NewAttributeValue(ctx context.Context, typ attr.Type, val tftypes.Value, ta
 	return reflect.ValueOf(res), diags
 }
 
+// derivedValueType returns the derived attr.Type of `target` if it is an
+// xattr.ValueWithDerivedType with the same Terraform type as `typ`.
+func derivedValueType(ctx context.Context, typ attr.Type, target reflect.Value) (attr.Type, bool) {
+	value, ok := reflect.Zero(target.Type()).Interface().(xattr.ValueWithDerivedType)
+	if !ok {
+		return nil, false
+	}
+
+	derivedType := value.DerivedType(ctx)
+	if derivedType == nil || !derivedType.TerraformType(ctx).Equal(typ.TerraformType(ctx)) {
+		return nil, false
+	}
+
+	return derivedType, true
+}
+
 // FromAttributeValue creates an attr.Value from an attr.Value. It just returns
 // the attr.Value it is passed, but reserves the right in the future to do some
 // validation on that attr.Value to make sure it matches the type produced by
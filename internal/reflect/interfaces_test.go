@@ -13,6 +13,7 @@ import (
 	refl "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -620,3 +621,67 @@ func TestFromValueCreator(t *testing.T) {
 		})
 	}
 }
+
+func TestNewAttributeValue_derivedType(t *testing.T) {
+	t.Parallel()
+
+	listVal := tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "hello"),
+	})
+
+	testCases := map[string]struct {
+		typ           attr.Type
+		val           tftypes.Value
+		target        reflect.Value
+		expected      attr.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"schema-type": {
+			typ:      types.ListType{ElemType: types.StringType},
+			val:      listVal,
+			target:   reflect.ValueOf(basetypes.ListValueOf[types.String]{}),
+			expected: basetypes.NewListValueOfMust(context.Background(), []types.String{types.StringValue("hello")}),
+		},
+		"derived-type": {
+			typ:      basetypes.ListTypeOf[types.String]{},
+			val:      listVal,
+			target:   reflect.ValueOf(basetypes.ListValueOf[types.String]{}),
+			expected: basetypes.NewListValueOfMust(context.Background(), []types.String{types.StringValue("hello")}),
+		},
+		"incompatible-schema-type": {
+			typ: types.SetType{ElemType: types.StringType},
+			val: tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "hello"),
+			}),
+			target: reflect.ValueOf(basetypes.ListValueOf[types.String]{}),
+			expectedDiags: diag.Diagnostics{
+				diag.WithPath(path.Empty(), refl.DiagNewAttributeValueIntoWrongType{
+					ValType:    reflect.TypeOf(types.SetValueMust(types.StringType, nil)),
+					TargetType: reflect.TypeOf(basetypes.ListValueOf[types.String]{}),
+					SchemaType: types.SetType{ElemType: types.StringType},
+				}),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			res, diags := refl.NewAttributeValue(context.Background(), tc.typ, tc.val, tc.target, refl.Options{}, path.Empty())
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diags.HasError() {
+				return
+			}
+
+			if diff := cmp.Diff(res.Interface(), tc.expected); diff != "" {
+				t.Errorf("unexpected result (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
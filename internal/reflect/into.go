@@ -3,7 +3,6 @@ package reflect
 import (
 	"context"
 	"fmt"
-	"math/big"
 	"reflect"
 
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -21,8 +20,9 @@ import (
 // method. Structs use reflection: each exported struct field must have a
 // "tfsdk" tag with the name of the field in the tftypes.Value, and all fields
 // in the tftypes.Value must have a corresponding property in the struct. Into
-// will be called for each struct field. Slices will have Into called for each
-// element.
+// will be called for each struct field. Slices and arrays will have Into
+// called for each element, and arrays must have exactly as many elements as
+// `val`.
 func Into(ctx context.Context, typ attr.Type, val tftypes.Value, target interface{}, opts Options, path path.Path) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -63,18 +63,19 @@ func BuildValue(ctx context.Context, typ attr.Type, val tftypes.Value, target re
 		)
 		return target, diags
 	}
+	info := getTypeInfo(target.Type())
 	// if this is an attr.Value, build the type from that
-	if target.Type().Implements(reflect.TypeOf((*attr.Value)(nil)).Elem()) {
+	if info.attrValue {
 		return NewAttributeValue(ctx, typ, val, target, opts, path)
 	}
 	// if this tells tftypes how to build an instance of it out of a
 	// tftypes.Value, well, that's what we want, so do that instead of our
 	// default logic.
-	if target.Type().Implements(reflect.TypeOf((*tftypes.ValueConverter)(nil)).Elem()) {
+	if info.valueConverter {
 		return NewValueConverter(ctx, typ, val, target, opts, path)
 	}
 	// if this can explicitly be set to unknown, do that
-	if target.Type().Implements(reflect.TypeOf((*Unknownable)(nil)).Elem()) {
+	if info.unknownable {
 		res, unknownableDiags := NewUnknownable(ctx, typ, val, target, opts, path)
 		diags.Append(unknownableDiags...)
 		if diags.HasError() {
@@ -89,7 +90,7 @@ func BuildValue(ctx context.Context, typ attr.Type, val tftypes.Value, target re
 		}
 	}
 	// if this can explicitly be set to null, do that
-	if target.Type().Implements(reflect.TypeOf((*Nullable)(nil)).Elem()) {
+	if info.nullable {
 		res, nullableDiags := NewNullable(ctx, typ, val, target, opts, path)
 		diags.Append(nullableDiags...)
 		if diags.HasError() {
@@ -145,9 +146,14 @@ func BuildValue(ctx context.Context, typ attr.Type, val tftypes.Value, target re
 
 		return target, diags
 	}
+	// time.Duration and types with a text representation, such as
+	// time.Time, are handled as strings
+	if info.text && val.Type().Is(tftypes.String) {
+		return Text(ctx, typ, val, target, path)
+	}
 	// *big.Float and *big.Int are technically pointers, but we want them
 	// handled as numbers
-	if target.Type() == reflect.TypeOf(big.NewFloat(0)) || target.Type() == reflect.TypeOf(big.NewInt(0)) {
+	if info.number {
 		return Number(ctx, typ, val, target, opts, path)
 	}
 	switch target.Kind() {
@@ -171,7 +177,7 @@ func BuildValue(ctx context.Context, typ attr.Type, val tftypes.Value, target re
 		val, valDiags := Number(ctx, typ, val, target, opts, path)
 		diags.Append(valDiags...)
 		return val, diags
-	case reflect.Slice:
+	case reflect.Slice, reflect.Array:
 		val, valDiags := reflectSlice(ctx, typ, val, target, opts, path)
 		diags.Append(valDiags...)
 		return val, diags
@@ -17,4 +17,14 @@ type Options struct {
 	// perfectly in the types they're being stored in, rather than
 	// returning errors. Numbers will always be rounded towards 0.
 	AllowRoundingNumbers bool
+
+	// IgnoreUnmappedObjectAttributes skips object attributes which have
+	// no corresponding struct field, rather than returning errors. This
+	// allows decoding into structs which only model part of an object.
+	IgnoreUnmappedObjectAttributes bool
+
+	// IgnoreUnmappedStructFields leaves struct fields which have no
+	// corresponding object attribute at their zero value, rather than
+	// returning errors.
+	IgnoreUnmappedStructFields bool
 }
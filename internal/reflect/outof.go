@@ -38,6 +38,11 @@ func FromValue(ctx context.Context, typ attr.Type, val interface{}, path path.Pa
 		return FromBigInt(ctx, typ, bi, path)
 	}
 	value := reflect.ValueOf(val)
+	// time.Duration and types with a text representation, such as
+	// time.Time, are handled as strings
+	if value.IsValid() && getTypeInfo(value.Type()).text && typ != nil && typ.TerraformType(ctx).Is(tftypes.String) {
+		return FromText(ctx, typ, value, path)
+	}
 	kind := value.Kind()
 	switch kind {
 	case reflect.Struct:
@@ -64,7 +69,7 @@ func FromValue(ctx context.Context, typ attr.Type, val interface{}, path path.Pa
 		return FromBool(ctx, typ, value.Bool(), path)
 	case reflect.String:
 		return FromString(ctx, typ, value.String(), path)
-	case reflect.Slice:
+	case reflect.Slice, reflect.Array:
 		return FromSlice(ctx, typ, value, path)
 	case reflect.Map:
 		t, ok := typ.(attr.TypeWithElementType)
@@ -12,13 +12,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
-// build a slice of elements, matching the type of `target`, and fill it with
-// the data in `val`.
+// build a slice or array of elements, matching the type of `target`, and fill
+// it with the data in `val`. Arrays must have exactly as many elements as
+// `val`.
 func reflectSlice(ctx context.Context, typ attr.Type, val tftypes.Value, target reflect.Value, opts Options, path path.Path) (reflect.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	// this only works with slices, so check that out first
-	if target.Kind() != reflect.Slice {
+	// this only works with slices and arrays, so check that out first
+	if target.Kind() != reflect.Slice && target.Kind() != reflect.Array {
 		diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
 			Val:        val,
 			TargetType: target.Type(),
@@ -50,12 +51,27 @@ func reflectSlice(ctx context.Context, typ attr.Type, val tftypes.Value, target
 		return target, diags
 	}
 
+	// arrays can't grow or shrink, so the number of elements must match
+	if target.Kind() == reflect.Array && len(values) != target.Len() {
+		diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
+			Val:        val,
+			TargetType: target.Type(),
+			Err:        fmt.Errorf("cannot reflect %d elements into an array of length %d", len(values), target.Len()),
+		}))
+		return target, diags
+	}
+
 	// we need to know the type the slice is wrapping
 	elemType := target.Type().Elem()
 	elemAttrType := elemTyper.ElementType()
 
-	// we want an empty version of the slice
-	slice := reflect.MakeSlice(target.Type(), 0, len(values))
+	// we want an empty version of the slice, or a zero array to fill
+	var slice reflect.Value
+	if target.Kind() == reflect.Array {
+		slice = reflect.New(target.Type()).Elem()
+	} else {
+		slice = reflect.MakeSlice(target.Type(), 0, len(values))
+	}
 
 	// go over each of the values passed in, create a Go value of the right
 	// type for them, and add it to our new slice
@@ -90,16 +106,20 @@ func reflectSlice(ctx context.Context, typ attr.Type, val tftypes.Value, target
 		}
 
 		// add the new target to our slice
-		slice = reflect.Append(slice, val)
+		if slice.Kind() == reflect.Array {
+			slice.Index(pos).Set(val)
+		} else {
+			slice = reflect.Append(slice, val)
+		}
 	}
 
 	return slice, diags
 }
 
 // FromSlice returns an attr.Value as produced by `typ` using the data in
-// `val`. `val` must be a slice. `typ` must be an attr.TypeWithElementType or
-// attr.TypeWithElementTypes. If the slice is nil, the representation of null
-// for `typ` will be returned. Otherwise, FromSlice will recurse into FromValue
+// `val`. `val` must be a slice or array. `typ` must be an
+// attr.TypeWithElementType or attr.TypeWithElementTypes. If the slice is nil,
+// the representation of null for `typ` will be returned. Otherwise, FromSlice will recurse into FromValue
 // for each element in the slice, using the element type or types defined on
 // `typ` to construct values for them.
 //
@@ -110,7 +130,7 @@ func FromSlice(ctx context.Context, typ attr.Type, val reflect.Value, path path.
 	// TODO: support tuples, which are attr.TypeWithElementTypes
 	tfType := typ.TerraformType(ctx)
 
-	if val.IsNil() {
+	if val.Kind() == reflect.Slice && val.IsNil() {
 		tfVal := tftypes.NewValue(tfType, nil)
 
 		if typeWithValidate, ok := typ.(xattr.TypeWithValidate); ok {
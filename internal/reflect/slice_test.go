@@ -0,0 +1,140 @@
+package reflect_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	refl "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestInto_array(t *testing.T) {
+	t.Parallel()
+
+	listType := types.ListType{ElemType: types.StringType}
+	tfListType := tftypes.List{ElementType: tftypes.String}
+
+	twoElems := tftypes.NewValue(tfListType, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "a"),
+		tftypes.NewValue(tftypes.String, "b"),
+	})
+	threeElems := tftypes.NewValue(tfListType, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "a"),
+		tftypes.NewValue(tftypes.String, "b"),
+		tftypes.NewValue(tftypes.String, "c"),
+	})
+
+	testCases := map[string]struct {
+		typ           attr.Type
+		val           tftypes.Value
+		opts          refl.Options
+		expected      [2]string
+		expectedDiags diag.Diagnostics
+	}{
+		"list": {
+			typ:      listType,
+			val:      twoElems,
+			expected: [2]string{"a", "b"},
+		},
+		"set": {
+			typ: types.SetType{ElemType: types.StringType},
+			val: tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "a"),
+				tftypes.NewValue(tftypes.String, "b"),
+			}),
+			expected: [2]string{"a", "b"},
+		},
+		"wrong-length": {
+			typ: listType,
+			val: threeElems,
+			expectedDiags: diag.Diagnostics{
+				diag.WithPath(path.Empty(), refl.DiagIntoIncompatibleType{
+					Val:        threeElems,
+					TargetType: reflect.TypeOf([2]string{}),
+					Err:        errors.New("cannot reflect 3 elements into an array of length 2"),
+				}),
+			},
+		},
+		"null-as-empty": {
+			typ:  listType,
+			val:  tftypes.NewValue(tfListType, nil),
+			opts: refl.Options{UnhandledNullAsEmpty: true},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got [2]string
+
+			diags := refl.Into(context.Background(), testCase.typ, testCase.val, &got, testCase.opts, path.Empty())
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected result (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFromValue_array(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      attr.Type
+		val      any
+		expected attr.Value
+	}{
+		"list": {
+			typ: types.ListType{ElemType: types.Int64Type},
+			val: [3]int64{1, 2, 3},
+			expected: types.ListValueMust(types.Int64Type, []attr.Value{
+				types.Int64Value(1),
+				types.Int64Value(2),
+				types.Int64Value(3),
+			}),
+		},
+		"empty": {
+			typ:      types.ListType{ElemType: types.Int64Type},
+			val:      [0]int64{},
+			expected: types.ListValueMust(types.Int64Type, []attr.Value{}),
+		},
+		"pointer-nil": {
+			typ:      types.ListType{ElemType: types.Int64Type},
+			val:      (*[3]int64)(nil),
+			expected: types.ListNull(types.Int64Type),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := refl.FromValue(context.Background(), testCase.typ, testCase.val, path.Empty())
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected result (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -25,6 +25,13 @@ import (
 // explicitly defining them as not part of the object. This is to catch typos
 // and other mistakes early.
 //
+// The properties of untagged embedded structs, or pointers to structs, are
+// promoted into `target` as if they were its own properties. Nil embedded
+// struct pointers are allocated.
+//
+// The IgnoreUnmappedObjectAttributes and IgnoreUnmappedStructFields options
+// relax the 1:1 match, so that partial models can be populated.
+//
 // Struct is meant to be called from Into, not directly.
 func Struct(ctx context.Context, typ attr.Type, object tftypes.Value, target reflect.Value, opts Options, path path.Path) (reflect.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
@@ -83,16 +90,20 @@ func Struct(ctx context.Context, typ attr.Type, object tftypes.Value, target ref
 
 	// we require an exact, 1:1 match of these fields to avoid typos
 	// leading to surprises, so let's ensure they have the exact same
-	// fields defined
+	// fields, unless the options allow either side to be partial
 	var objectMissing, targetMissing []string
-	for field := range targetFields {
-		if _, ok := objectFields[field]; !ok {
-			objectMissing = append(objectMissing, field)
+	if !opts.IgnoreUnmappedStructFields {
+		for field := range targetFields {
+			if _, ok := objectFields[field]; !ok {
+				objectMissing = append(objectMissing, field)
+			}
 		}
 	}
-	for field := range objectFields {
-		if _, ok := targetFields[field]; !ok {
-			targetMissing = append(targetMissing, field)
+	if !opts.IgnoreUnmappedObjectAttributes {
+		for field := range objectFields {
+			if _, ok := targetFields[field]; !ok {
+				targetMissing = append(targetMissing, field)
+			}
 		}
 	}
 	if len(objectMissing) > 0 || len(targetMissing) > 0 {
@@ -116,7 +127,13 @@ func Struct(ctx context.Context, typ attr.Type, object tftypes.Value, target ref
 	// now that we know they match perfectly, fill the struct with the
 	// values in the object
 	result := reflect.New(target.Type()).Elem()
-	for field, structFieldPos := range targetFields {
+	for field, structFieldIndex := range targetFields {
+		objectField, ok := objectFields[field]
+		if !ok {
+			// only possible with IgnoreUnmappedStructFields, leave
+			// the field as its zero value
+			continue
+		}
 		attrType, ok := attrTypes[field]
 		if !ok {
 			diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
@@ -126,8 +143,8 @@ func Struct(ctx context.Context, typ attr.Type, object tftypes.Value, target ref
 			}))
 			return target, diags
 		}
-		structField := result.Field(structFieldPos)
-		fieldVal, fieldValDiags := BuildValue(ctx, attrType, objectFields[field], structField, opts, path.AtName(field))
+		structField := settableFieldByIndex(result, structFieldIndex)
+		fieldVal, fieldValDiags := BuildValue(ctx, attrType, objectField, structField, opts, path.AtName(field))
 		diags.Append(fieldValDiags...)
 
 		if diags.HasError() {
@@ -144,6 +161,10 @@ func Struct(ctx context.Context, typ attr.Type, object tftypes.Value, target ref
 // into FromValue for each attribute, using the type of the attribute as
 // reported by `typ`.
 //
+// The properties of untagged embedded structs, or pointers to structs, are
+// promoted as if they were properties of `val`. The properties of nil
+// embedded struct pointers are converted from their zero value.
+//
 // It is meant to be called through FromValue, not directly.
 func FromStruct(ctx context.Context, typ attr.TypeWithAttributeTypes, val reflect.Value, path path.Path) (attr.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
@@ -164,9 +185,15 @@ func FromStruct(ctx context.Context, typ attr.TypeWithAttributeTypes, val reflec
 	}
 
 	attrTypes := typ.AttributeTypes()
-	for name, fieldNo := range targetFields {
+	for name, fieldIndex := range targetFields {
 		path := path.AtName(name)
-		fieldValue := val.Field(fieldNo)
+		fieldValue, ok := fieldByIndex(val, fieldIndex)
+
+		// fields of nil embedded struct pointers are handled as their
+		// zero value
+		if !ok {
+			fieldValue = reflect.Zero(val.Type().FieldByIndex(fieldIndex).Type)
+		}
 
 		attrVal, attrValDiags := FromValue(ctx, attrTypes[name], fieldValue.Interface(), path)
 		diags.Append(attrValDiags...)
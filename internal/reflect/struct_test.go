@@ -157,6 +157,89 @@ func TestNewStruct_objectMissingFieldsAndStructMissingProperties(t *testing.T) {
 	}
 }
 
+func TestNewStruct_ignoreUnmapped(t *testing.T) {
+	t.Parallel()
+
+	objectType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"a": types.StringType,
+			"b": types.StringType,
+		},
+	}
+	val := tftypes.NewValue(objectType.TerraformType(context.Background()), map[string]tftypes.Value{
+		"a": tftypes.NewValue(tftypes.String, "hello"),
+		"b": tftypes.NewValue(tftypes.String, "world"),
+	})
+
+	type model struct {
+		A string `tfsdk:"a"`
+		C string `tfsdk:"c"`
+	}
+
+	testCases := map[string]struct {
+		opts          refl.Options
+		expected      model
+		expectedDiags diag.Diagnostics
+	}{
+		"none": {
+			expectedDiags: diag.Diagnostics{
+				diag.WithPath(path.Empty(), refl.DiagIntoIncompatibleType{
+					Err:        errors.New("mismatch between struct and object: Struct defines fields not found in object: c. Object defines fields not found in struct: b."),
+					Val:        val,
+					TargetType: reflect.TypeOf(model{}),
+				}),
+			},
+		},
+		"object-attributes": {
+			opts: refl.Options{IgnoreUnmappedObjectAttributes: true},
+			expectedDiags: diag.Diagnostics{
+				diag.WithPath(path.Empty(), refl.DiagIntoIncompatibleType{
+					Err:        errors.New("mismatch between struct and object: Struct defines fields not found in object: c."),
+					Val:        val,
+					TargetType: reflect.TypeOf(model{}),
+				}),
+			},
+		},
+		"struct-fields": {
+			opts: refl.Options{IgnoreUnmappedStructFields: true},
+			expectedDiags: diag.Diagnostics{
+				diag.WithPath(path.Empty(), refl.DiagIntoIncompatibleType{
+					Err:        errors.New("mismatch between struct and object: Object defines fields not found in struct: b."),
+					Val:        val,
+					TargetType: reflect.TypeOf(model{}),
+				}),
+			},
+		},
+		"both": {
+			opts: refl.Options{
+				IgnoreUnmappedObjectAttributes: true,
+				IgnoreUnmappedStructFields:     true,
+			},
+			expected: model{A: "hello"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got model
+
+			diags := refl.Into(context.Background(), objectType, val, &got, testCase.opts, path.Empty())
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected result (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestNewStruct_primitives(t *testing.T) {
 	t.Parallel()
 
@@ -850,3 +933,255 @@ func TestFromStruct_complex(t *testing.T) {
 		t.Errorf("Didn't get expected value. Diff (+ is expected, - is result): %s", diff)
 	}
 }
+
+// EmbeddedTimeouts is exported so it can be embedded as a pointer.
+type EmbeddedTimeouts struct {
+	Create types.String `tfsdk:"create"`
+}
+
+type embeddedCommon struct {
+	ID   string            `tfsdk:"id"`
+	Tags map[string]string `tfsdk:"tags"`
+}
+
+func TestNewStruct_embedded(t *testing.T) {
+	t.Parallel()
+
+	type resourceModel struct {
+		embeddedCommon
+		*EmbeddedTimeouts
+		Name string `tfsdk:"name"`
+	}
+
+	var s resourceModel
+
+	result, diags := refl.Struct(context.Background(), types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"create": types.StringType,
+			"id":     types.StringType,
+			"name":   types.StringType,
+			"tags":   types.MapType{ElemType: types.StringType},
+		},
+	}, tftypes.NewValue(tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"create": tftypes.String,
+			"id":     tftypes.String,
+			"name":   tftypes.String,
+			"tags":   tftypes.Map{ElementType: tftypes.String},
+		},
+	}, map[string]tftypes.Value{
+		"create": tftypes.NewValue(tftypes.String, "10m"),
+		"id":     tftypes.NewValue(tftypes.String, "abc123"),
+		"name":   tftypes.NewValue(tftypes.String, "example"),
+		"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"env": tftypes.NewValue(tftypes.String, "test"),
+		}),
+	}), reflect.ValueOf(s), refl.Options{}, path.Empty())
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+	reflect.ValueOf(&s).Elem().Set(result)
+
+	expected := resourceModel{
+		embeddedCommon: embeddedCommon{
+			ID:   "abc123",
+			Tags: map[string]string{"env": "test"},
+		},
+		EmbeddedTimeouts: &EmbeddedTimeouts{
+			Create: types.StringValue("10m"),
+		},
+		Name: "example",
+	}
+
+	if diff := cmp.Diff(s, expected, cmp.AllowUnexported(resourceModel{})); diff != "" {
+		t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestNewStruct_embeddedMissingFields(t *testing.T) {
+	t.Parallel()
+
+	type resourceModel struct {
+		embeddedCommon
+		Name string `tfsdk:"name"`
+	}
+
+	var s resourceModel
+
+	val := tftypes.NewValue(tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":   tftypes.String,
+			"name": tftypes.String,
+		},
+	}, map[string]tftypes.Value{
+		"id":   tftypes.NewValue(tftypes.String, "abc123"),
+		"name": tftypes.NewValue(tftypes.String, "example"),
+	})
+
+	expectedDiags := diag.Diagnostics{
+		diag.WithPath(path.Empty(), refl.DiagIntoIncompatibleType{
+			Val:        val,
+			TargetType: reflect.TypeOf(s),
+			Err:        errors.New("mismatch between struct and object: Struct defines fields not found in object: tags."),
+		}),
+	}
+
+	_, diags := refl.Struct(context.Background(), types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"id":   types.StringType,
+			"name": types.StringType,
+		},
+	}, val, reflect.ValueOf(s), refl.Options{}, path.Empty())
+
+	if diff := cmp.Diff(diags, expectedDiags); diff != "" {
+		t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+	}
+}
+
+func TestFromStruct_embedded(t *testing.T) {
+	t.Parallel()
+
+	type resourceModel struct {
+		embeddedCommon
+		*EmbeddedTimeouts
+		Name string `tfsdk:"name"`
+	}
+
+	objectType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"create": types.StringType,
+			"id":     types.StringType,
+			"name":   types.StringType,
+			"tags":   types.MapType{ElemType: types.StringType},
+		},
+	}
+
+	testCases := map[string]struct {
+		val      resourceModel
+		expected attr.Value
+	}{
+		"embedded-pointer": {
+			val: resourceModel{
+				embeddedCommon: embeddedCommon{
+					ID:   "abc123",
+					Tags: map[string]string{"env": "test"},
+				},
+				EmbeddedTimeouts: &EmbeddedTimeouts{
+					Create: types.StringValue("10m"),
+				},
+				Name: "example",
+			},
+			expected: types.ObjectValueMust(
+				objectType.AttrTypes,
+				map[string]attr.Value{
+					"create": types.StringValue("10m"),
+					"id":     types.StringValue("abc123"),
+					"name":   types.StringValue("example"),
+					"tags": types.MapValueMust(types.StringType, map[string]attr.Value{
+						"env": types.StringValue("test"),
+					}),
+				},
+			),
+		},
+		"embedded-pointer-nil": {
+			val: resourceModel{
+				embeddedCommon: embeddedCommon{
+					ID: "abc123",
+				},
+				Name: "example",
+			},
+			expected: types.ObjectValueMust(
+				objectType.AttrTypes,
+				map[string]attr.Value{
+					"create": types.StringNull(),
+					"id":     types.StringValue("abc123"),
+					"name":   types.StringValue("example"),
+					"tags":   types.MapNull(types.StringType),
+				},
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := refl.FromStruct(context.Background(), objectType, reflect.ValueOf(testCase.val), path.Empty())
+			if diags.HasError() {
+				t.Fatalf("Unexpected error: %v", diags)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewStruct_tagOptions(t *testing.T) {
+	t.Parallel()
+
+	var s struct {
+		A string `tfsdk:"a,required"`
+		B bool   `tfsdk:"b,optional,sensitive"`
+	}
+	result, diags := refl.Struct(context.Background(), types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"a": types.StringType,
+			"b": types.BoolType,
+		},
+	}, tftypes.NewValue(tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"a": tftypes.String,
+			"b": tftypes.Bool,
+		},
+	}, map[string]tftypes.Value{
+		"a": tftypes.NewValue(tftypes.String, "hello"),
+		"b": tftypes.NewValue(tftypes.Bool, true),
+	}), reflect.ValueOf(s), refl.Options{}, path.Empty())
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+	reflect.ValueOf(&s).Elem().Set(result)
+	if s.A != "hello" {
+		t.Errorf("Expected s.A to be %q, was %q", "hello", s.A)
+	}
+	if s.B != true {
+		t.Errorf("Expected s.B to be %v, was %v", true, s.B)
+	}
+}
+
+func TestFromStruct_tagOptions(t *testing.T) {
+	t.Parallel()
+
+	type model struct {
+		A string `tfsdk:"a,required"`
+		B bool   `tfsdk:"b,optional,sensitive"`
+	}
+
+	attrTypes := map[string]attr.Type{
+		"a": types.StringType,
+		"b": types.BoolType,
+	}
+
+	got, diags := refl.FromStruct(context.Background(), types.ObjectType{
+		AttrTypes: attrTypes,
+	}, reflect.ValueOf(model{A: "hello", B: true}), path.Empty())
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	expected := types.ObjectValueMust(
+		attrTypes,
+		map[string]attr.Value{
+			"a": types.StringValue("hello"),
+			"b": types.BoolValue(true),
+		},
+	)
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+	}
+}
@@ -0,0 +1,127 @@
+package reflect
+
+import (
+	"context"
+	"encoding"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	durationType        = reflect.TypeOf(time.Duration(0))
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// IsTextType returns true if values of `typ` are converted to and from
+// Terraform strings using their text representation. This is the case for
+// time.Duration, using its String method and time.ParseDuration, and for
+// non-pointer types whose pointer type implements both
+// encoding.TextMarshaler and encoding.TextUnmarshaler, such as time.Time,
+// which uses RFC 3339.
+//
+// Values are always written in their canonical text representation, so a
+// time.Duration read from "1h" is written as "1h0m0s". Terraform requires
+// configured values to be written unchanged, so these types are only
+// suitable for Computed attributes which are not Optional or Required.
+// Schema derivation from models rejects other uses. Configurable attributes
+// must use a string type and parse the value instead.
+func IsTextType(typ reflect.Type) bool {
+	return getTypeInfo(typ).text
+}
+
+func isTextType(typ reflect.Type) bool {
+	if typ == durationType {
+		return true
+	}
+
+	if typ.Kind() == reflect.Ptr {
+		return false
+	}
+
+	ptr := reflect.PtrTo(typ)
+
+	return ptr.Implements(textMarshalerType) && ptr.Implements(textUnmarshalerType)
+}
+
+// Text builds a value of the type of `target` by parsing the string in `val`,
+// where `target` is a type which IsTextType returns true for. Parse errors,
+// such as a configured value not matching the expected format, are returned
+// as diagnostics for the path.
+//
+// It is meant to be called through Into, not directly.
+func Text(ctx context.Context, typ attr.Type, val tftypes.Value, target reflect.Value, path path.Path) (reflect.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var s string
+
+	err := val.As(&s)
+	if err != nil {
+		diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
+			Val:        val,
+			TargetType: target.Type(),
+			Err:        err,
+		}))
+		return target, diags
+	}
+
+	if target.Type() == durationType {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return target, append(diags, textParseErrorDiag(s, target.Type(), err, path))
+		}
+		return reflect.ValueOf(d), diags
+	}
+
+	result := reflect.New(target.Type())
+
+	err = result.Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+	if err != nil {
+		return target, append(diags, textParseErrorDiag(s, target.Type(), err, path))
+	}
+
+	return result.Elem(), diags
+}
+
+// FromText returns an attr.Value as produced by `typ` from the canonical
+// text representation of `val`, which must be of a type which IsTextType
+// returns true for.
+//
+// It is meant to be called through FromValue, not directly.
+func FromText(ctx context.Context, typ attr.Type, val reflect.Value, path path.Path) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if val.Type() == durationType {
+		return FromString(ctx, typ, time.Duration(val.Int()).String(), path)
+	}
+
+	// MarshalText may be implemented with a pointer receiver.
+	ptr := reflect.New(val.Type())
+	ptr.Elem().Set(val)
+
+	text, err := ptr.Interface().(encoding.TextMarshaler).MarshalText()
+	if err != nil {
+		err = fmt.Errorf("cannot marshal %s as text: %w", val.Type(), err)
+		diags.AddAttributeError(
+			path,
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to convert from value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return nil, diags
+	}
+
+	return FromString(ctx, typ, string(text), path)
+}
+
+func textParseErrorDiag(s string, targetType reflect.Type, err error, path path.Path) diag.DiagnosticWithPath {
+	return diag.NewAttributeErrorDiagnostic(
+		path,
+		"Value Conversion Error",
+		fmt.Sprintf("The string value %q could not be parsed into %s. Ensure the value uses the expected format.\n\nError: %s", s, targetType, err),
+	)
+}
@@ -0,0 +1,308 @@
+package reflect_test
+
+import (
+	"context"
+	"net/netip"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	refl "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestIsTextType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      reflect.Type
+		expected bool
+	}{
+		"duration":          {typ: reflect.TypeOf(time.Duration(0)), expected: true},
+		"time":              {typ: reflect.TypeOf(time.Time{}), expected: true},
+		"netip-addr":        {typ: reflect.TypeOf(netip.Addr{}), expected: true},
+		"time-pointer":      {typ: reflect.TypeOf(&time.Time{}), expected: false},
+		"string":            {typ: reflect.TypeOf(""), expected: false},
+		"int64":             {typ: reflect.TypeOf(int64(0)), expected: false},
+		"unmarshaler-only":  {typ: reflect.TypeOf(textUnmarshalerOnly{}), expected: false},
+		"struct-no-methods": {typ: reflect.TypeOf(struct{}{}), expected: false},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := refl.IsTextType(testCase.typ)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+type textUnmarshalerOnly struct{}
+
+func (t *textUnmarshalerOnly) UnmarshalText([]byte) error {
+	return nil
+}
+
+func TestInto_text(t *testing.T) {
+	t.Parallel()
+
+	type model struct {
+		Addr      netip.Addr      `tfsdk:"addr"`
+		Created   time.Time       `tfsdk:"created"`
+		Deleted   *time.Time      `tfsdk:"deleted"`
+		Timeout   time.Duration   `tfsdk:"timeout"`
+		Intervals []time.Duration `tfsdk:"intervals"`
+	}
+
+	objectType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"addr":      types.StringType,
+			"created":   types.StringType,
+			"deleted":   types.StringType,
+			"timeout":   types.StringType,
+			"intervals": types.ListType{ElemType: types.StringType},
+		},
+	}
+
+	testCases := map[string]struct {
+		val           tftypes.Value
+		expected      model
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			val: tftypes.NewValue(objectType.TerraformType(context.Background()), map[string]tftypes.Value{
+				"addr":    tftypes.NewValue(tftypes.String, "192.0.2.1"),
+				"created": tftypes.NewValue(tftypes.String, "2023-01-02T03:04:05Z"),
+				"deleted": tftypes.NewValue(tftypes.String, nil),
+				"timeout": tftypes.NewValue(tftypes.String, "1h30m"),
+				"intervals": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "10s"),
+				}),
+			}),
+			expected: model{
+				Addr:      netip.MustParseAddr("192.0.2.1"),
+				Created:   time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC),
+				Timeout:   90 * time.Minute,
+				Intervals: []time.Duration{10 * time.Second},
+			},
+		},
+		"invalid-time": {
+			val: tftypes.NewValue(objectType.TerraformType(context.Background()), map[string]tftypes.Value{
+				"addr":      tftypes.NewValue(tftypes.String, "192.0.2.1"),
+				"created":   tftypes.NewValue(tftypes.String, "2023-01-02T03:04:05Z"),
+				"deleted":   tftypes.NewValue(tftypes.String, "yesterday"),
+				"timeout":   tftypes.NewValue(tftypes.String, "1h30m"),
+				"intervals": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("deleted"),
+					"Value Conversion Error",
+					"The string value \"yesterday\" could not be parsed into time.Time. Ensure the value uses the expected format.\n\n"+
+						"Error: parsing time \"yesterday\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \"yesterday\" as \"2006\"",
+				),
+			},
+		},
+		"invalid-duration": {
+			val: tftypes.NewValue(objectType.TerraformType(context.Background()), map[string]tftypes.Value{
+				"addr":    tftypes.NewValue(tftypes.String, "192.0.2.1"),
+				"created": tftypes.NewValue(tftypes.String, "2023-01-02T03:04:05Z"),
+				"deleted": tftypes.NewValue(tftypes.String, nil),
+				"timeout": tftypes.NewValue(tftypes.String, "1h30m"),
+				"intervals": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "10 seconds"),
+				}),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("intervals").AtListIndex(0),
+					"Value Conversion Error",
+					"The string value \"10 seconds\" could not be parsed into time.Duration. Ensure the value uses the expected format.\n\n"+
+						"Error: time: unknown unit \" seconds\" in duration \"10 seconds\"",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got model
+
+			diags := refl.Into(context.Background(), objectType, testCase.val, &got, refl.Options{}, path.Empty())
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diags.HasError() {
+				return
+			}
+
+			if diff := cmp.Diff(got, testCase.expected, cmp.Comparer(func(x, y netip.Addr) bool { return x == y })); diff != "" {
+				t.Errorf("unexpected result (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFromValue_text(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2023, 1, 2, 3, 4, 5, 600, time.FixedZone("test", 3600))
+
+	testCases := map[string]struct {
+		typ      attr.Type
+		val      any
+		expected attr.Value
+	}{
+		"time": {
+			typ:      types.StringType,
+			val:      created,
+			expected: types.StringValue("2023-01-02T03:04:05.0000006+01:00"),
+		},
+		"time-pointer": {
+			typ:      types.StringType,
+			val:      &created,
+			expected: types.StringValue("2023-01-02T03:04:05.0000006+01:00"),
+		},
+		"time-pointer-nil": {
+			typ:      types.StringType,
+			val:      (*time.Time)(nil),
+			expected: types.StringNull(),
+		},
+		"duration": {
+			typ:      types.StringType,
+			val:      90 * time.Minute,
+			expected: types.StringValue("1h30m0s"),
+		},
+		"duration-number": {
+			typ:      types.Int64Type,
+			val:      time.Second,
+			expected: types.Int64Value(int64(time.Second)),
+		},
+		"netip-addr": {
+			typ:      types.StringType,
+			val:      netip.MustParseAddr("2001:db8::1"),
+			expected: types.StringValue("2001:db8::1"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := refl.FromValue(context.Background(), testCase.typ, testCase.val, path.Empty())
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected result (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestText_roundTrip(t *testing.T) {
+	t.Parallel()
+
+	type model struct {
+		Addr    netip.Addr    `tfsdk:"addr"`
+		Created time.Time     `tfsdk:"created"`
+		Timeout time.Duration `tfsdk:"timeout"`
+	}
+
+	objectType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"addr":    types.StringType,
+			"created": types.StringType,
+			"timeout": types.StringType,
+		},
+	}
+
+	// Values are written in their canonical text representation, which is
+	// why text types are limited to computed attributes.
+	testCases := map[string]struct {
+		addr     string
+		created  string
+		timeout  string
+		expected map[string]string
+	}{
+		"canonical": {
+			addr:    "2001:db8::1",
+			created: "2023-01-02T03:04:05+01:00",
+			timeout: "1h30m0s",
+			expected: map[string]string{
+				"addr":    "2001:db8::1",
+				"created": "2023-01-02T03:04:05+01:00",
+				"timeout": "1h30m0s",
+			},
+		},
+		"normalized": {
+			addr:    "2001:DB8:0::1",
+			created: "2023-01-02T03:04:05.000+01:00",
+			timeout: "90m",
+			expected: map[string]string{
+				"addr":    "2001:db8::1",
+				"created": "2023-01-02T03:04:05+01:00",
+				"timeout": "1h30m0s",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			val := tftypes.NewValue(objectType.TerraformType(context.Background()), map[string]tftypes.Value{
+				"addr":    tftypes.NewValue(tftypes.String, testCase.addr),
+				"created": tftypes.NewValue(tftypes.String, testCase.created),
+				"timeout": tftypes.NewValue(tftypes.String, testCase.timeout),
+			})
+
+			var m model
+
+			diags := refl.Into(context.Background(), objectType, val, &m, refl.Options{}, path.Empty())
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			got, diags := refl.FromValue(context.Background(), objectType, m, path.Empty())
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			expected := types.ObjectValueMust(objectType.AttrTypes, map[string]attr.Value{
+				"addr":    types.StringValue(testCase.expected["addr"]),
+				"created": types.StringValue(testCase.expected["created"]),
+				"timeout": types.StringValue(testCase.expected["timeout"]),
+			})
+
+			if diff := cmp.Diff(got, expected); diff != "" {
+				t.Errorf("unexpected result (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/private"
+)
+
+var _ resource.Resource = &ResourceWithPrivateStateMigrations{}
+var _ resource.ResourceWithPrivateStateMigrations = &ResourceWithPrivateStateMigrations{}
+
+// Declarative resource.ResourceWithPrivateStateMigrations for unit testing.
+type ResourceWithPrivateStateMigrations struct {
+	*Resource
+
+	// ResourceWithPrivateStateMigrations interface methods
+	PrivateStateMigrationsMethod func(context.Context) []private.Migrator
+}
+
+// PrivateStateMigrations satisfies the
+// resource.ResourceWithPrivateStateMigrations interface.
+func (p *ResourceWithPrivateStateMigrations) PrivateStateMigrations(ctx context.Context) []private.Migrator {
+	if p.PrivateStateMigrationsMethod == nil {
+		return nil
+	}
+
+	return p.PrivateStateMigrationsMethod(ctx)
+}
@@ -0,0 +1,27 @@
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.Resource = &ResourceWithReadAfterWrite{}
+var _ resource.ResourceWithReadAfterWrite = &ResourceWithReadAfterWrite{}
+
+// Declarative resource.ResourceWithReadAfterWrite for unit testing.
+type ResourceWithReadAfterWrite struct {
+	*Resource
+
+	// ResourceWithReadAfterWrite interface methods
+	ReadAfterWriteMethod func(context.Context) bool
+}
+
+// ReadAfterWrite satisfies the resource.ResourceWithReadAfterWrite interface.
+func (p *ResourceWithReadAfterWrite) ReadAfterWrite(ctx context.Context) bool {
+	if p.ReadAfterWriteMethod == nil {
+		return false
+	}
+
+	return p.ReadAfterWriteMethod(ctx)
+}
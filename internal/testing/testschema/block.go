@@ -3,15 +3,20 @@ package testschema
 import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
-var _ fwschema.Block = Block{}
+var (
+	_ fwschema.Block                   = Block{}
+	_ fwxschema.BlockWithKeyAttributes = Block{}
+)
 
 type Block struct {
 	DeprecationMessage  string
 	Description         string
+	KeyAttributes       []string
 	MarkdownDescription string
 	NestedObject        fwschema.NestedBlockObject
 	NestingMode         fwschema.BlockNestingMode
@@ -43,6 +48,11 @@ func (b Block) GetDescription() string {
 	return b.Description
 }
 
+// GetKeyAttributes satisfies the fwxschema.BlockWithKeyAttributes interface.
+func (b Block) GetKeyAttributes() []string {
+	return b.KeyAttributes
+}
+
 // GetMarkdownDescription satisfies the fwschema.Block interface.
 func (b Block) GetMarkdownDescription() string {
 	return b.MarkdownDescription
@@ -3,16 +3,21 @@ package testschema
 import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
-var _ fwschema.NestedAttribute = NestedAttribute{}
+var (
+	_ fwschema.NestedAttribute             = NestedAttribute{}
+	_ fwxschema.AttributeWithKeyAttributes = NestedAttribute{}
+)
 
 type NestedAttribute struct {
 	Computed            bool
 	DeprecationMessage  string
 	Description         string
+	KeyAttributes       []string
 	MarkdownDescription string
 	NestedObject        fwschema.NestedAttributeObject
 	NestingMode         fwschema.NestingMode
@@ -48,6 +53,11 @@ func (a NestedAttribute) GetDescription() string {
 	return a.Description
 }
 
+// GetKeyAttributes satisfies the fwxschema.AttributeWithKeyAttributes interface.
+func (a NestedAttribute) GetKeyAttributes() []string {
+	return a.KeyAttributes
+}
+
 // GetMarkdownDescription satisfies the fwschema.Attribute interface.
 func (a NestedAttribute) GetMarkdownDescription() string {
 	return a.MarkdownDescription
@@ -0,0 +1,7 @@
+// Package tfjson contains the Go types for the Terraform CLI machine-readable
+// provider schema JSON format, such as the output of the
+// `terraform providers schema -json` command.
+//
+// These types intentionally mirror the github.com/hashicorp/terraform-json
+// Go module so the framework does not require that dependency.
+package tfjson
@@ -0,0 +1,188 @@
+package tfjson
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ProviderSchemasFormatVersion is the version of the provider schemas JSON
+// format implemented by this package.
+const ProviderSchemasFormatVersion = "1.0"
+
+// ProviderSchemas is the top-level object of the provider schemas JSON format.
+type ProviderSchemas struct {
+	// FormatVersion is the version of the JSON format.
+	FormatVersion string `json:"format_version"`
+
+	// Schemas is a mapping of provider source addresses, such as
+	// registry.terraform.io/hashicorp/example, to provider schemas.
+	Schemas map[string]*ProviderSchema `json:"provider_schemas,omitempty"`
+}
+
+// ProviderSchema returns the source address and schemas of the provider with
+// the given address. The address can be omitted when there is only a single
+// provider.
+func (s ProviderSchemas) ProviderSchema(address string) (string, *ProviderSchema, error) {
+	if address == "" {
+		if len(s.Schemas) != 1 {
+			addresses := make([]string, 0, len(s.Schemas))
+
+			for addr := range s.Schemas {
+				addresses = append(addresses, addr)
+			}
+
+			sort.Strings(addresses)
+
+			return "", nil, fmt.Errorf("provider address must be given when provider schemas do not contain exactly one provider, found: %s", strings.Join(addresses, ", "))
+		}
+
+		for addr := range s.Schemas {
+			address = addr
+		}
+	}
+
+	providerSchema, ok := s.Schemas[address]
+
+	if !ok || providerSchema == nil {
+		return "", nil, fmt.Errorf("provider %q not found in provider schemas", address)
+	}
+
+	return address, providerSchema, nil
+}
+
+// ProviderSchema contains all schemas of a single provider.
+type ProviderSchema struct {
+	// ConfigSchema is the provider configuration schema.
+	ConfigSchema *Schema `json:"provider,omitempty"`
+
+	// ResourceSchemas is a mapping of resource type names to schemas.
+	ResourceSchemas map[string]*Schema `json:"resource_schemas,omitempty"`
+
+	// DataSourceSchemas is a mapping of data source type names to schemas.
+	DataSourceSchemas map[string]*Schema `json:"data_source_schemas,omitempty"`
+}
+
+// Schema is a versioned schema of a provider, resource, or data source.
+type Schema struct {
+	// Version is the schema version.
+	Version int64 `json:"version"`
+
+	// Block is the root block of the schema.
+	Block *SchemaBlock `json:"block,omitempty"`
+}
+
+// SchemaBlock represents the root block of a schema or the underlying block of
+// a nested block.
+type SchemaBlock struct {
+	// Attributes is a mapping of attribute names to attributes.
+	Attributes map[string]*SchemaAttribute `json:"attributes,omitempty"`
+
+	// NestedBlocks is a mapping of block type names to nested blocks.
+	NestedBlocks map[string]*SchemaBlockType `json:"block_types,omitempty"`
+
+	// Description is the block description.
+	Description string `json:"description,omitempty"`
+
+	// DescriptionKind is the format of the Description field.
+	DescriptionKind SchemaDescriptionKind `json:"description_kind,omitempty"`
+
+	// Deprecated is true if the block is deprecated.
+	Deprecated bool `json:"deprecated,omitempty"`
+}
+
+// SchemaBlockType represents a nested block.
+type SchemaBlockType struct {
+	// NestingMode is the nesting mode of the block.
+	NestingMode SchemaNestingMode `json:"nesting_mode,omitempty"`
+
+	// Block is the underlying block.
+	Block *SchemaBlock `json:"block,omitempty"`
+
+	// MinItems is the minimum number of block instances.
+	MinItems uint64 `json:"min_items,omitempty"`
+
+	// MaxItems is the maximum number of block instances.
+	MaxItems uint64 `json:"max_items,omitempty"`
+}
+
+// SchemaAttribute represents an attribute. Exactly one of AttributeType or
+// AttributeNestedType is set.
+type SchemaAttribute struct {
+	// AttributeType is the Terraform type of the attribute, if the attribute
+	// is not a nested attribute.
+	AttributeType *Type `json:"type,omitempty"`
+
+	// AttributeNestedType is the nested attribute object definition, if the
+	// attribute is a nested attribute.
+	AttributeNestedType *SchemaNestedAttributeType `json:"nested_type,omitempty"`
+
+	// Description is the attribute description.
+	Description string `json:"description,omitempty"`
+
+	// DescriptionKind is the format of the Description field.
+	DescriptionKind SchemaDescriptionKind `json:"description_kind,omitempty"`
+
+	// Deprecated is true if the attribute is deprecated.
+	Deprecated bool `json:"deprecated,omitempty"`
+
+	// Required is true if the attribute must be configured.
+	Required bool `json:"required,omitempty"`
+
+	// Optional is true if the attribute can be configured.
+	Optional bool `json:"optional,omitempty"`
+
+	// Computed is true if the provider can set the attribute value.
+	Computed bool `json:"computed,omitempty"`
+
+	// Sensitive is true if the attribute value is sensitive.
+	Sensitive bool `json:"sensitive,omitempty"`
+}
+
+// SchemaNestedAttributeType represents the object underneath a nested
+// attribute.
+type SchemaNestedAttributeType struct {
+	// Attributes is a mapping of attribute names to attributes.
+	Attributes map[string]*SchemaAttribute `json:"attributes,omitempty"`
+
+	// NestingMode is the nesting mode of the nested attribute.
+	NestingMode SchemaNestingMode `json:"nesting_mode,omitempty"`
+
+	// MinItems is the minimum number of nested objects.
+	MinItems uint64 `json:"min_items,omitempty"`
+
+	// MaxItems is the maximum number of nested objects.
+	MaxItems uint64 `json:"max_items,omitempty"`
+}
+
+// SchemaDescriptionKind is the format of a description.
+type SchemaDescriptionKind string
+
+const (
+	// SchemaDescriptionKindPlain represents a plaintext description.
+	SchemaDescriptionKindPlain SchemaDescriptionKind = "plain"
+
+	// SchemaDescriptionKindMarkdown represents a Markdown description.
+	SchemaDescriptionKindMarkdown SchemaDescriptionKind = "markdown"
+)
+
+// SchemaNestingMode is the nesting mode of a nested attribute or block.
+type SchemaNestingMode string
+
+const (
+	// SchemaNestingModeSingle represents a single object.
+	SchemaNestingModeSingle SchemaNestingMode = "single"
+
+	// SchemaNestingModeGroup represents a single object which is never null.
+	// The framework does not support this nesting mode.
+	SchemaNestingModeGroup SchemaNestingMode = "group"
+
+	// SchemaNestingModeList represents a list of objects.
+	SchemaNestingModeList SchemaNestingMode = "list"
+
+	// SchemaNestingModeSet represents a set of objects.
+	SchemaNestingModeSet SchemaNestingMode = "set"
+
+	// SchemaNestingModeMap represents a map of objects.
+	SchemaNestingModeMap SchemaNestingMode = "map"
+)
@@ -0,0 +1,103 @@
+package tfjson_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/tfjson"
+)
+
+func TestProviderSchemasProviderSchema(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schemas         tfjson.ProviderSchemas
+		address         string
+		expectedAddress string
+		expectedSchema  *tfjson.ProviderSchema
+		expectedError   string
+	}{
+		"address": {
+			schemas: tfjson.ProviderSchemas{
+				Schemas: map[string]*tfjson.ProviderSchema{
+					"registry.terraform.io/examplecorp/examplecloud": {},
+					"registry.terraform.io/examplecorp/other":        nil,
+				},
+			},
+			address:         "registry.terraform.io/examplecorp/examplecloud",
+			expectedAddress: "registry.terraform.io/examplecorp/examplecloud",
+			expectedSchema:  &tfjson.ProviderSchema{},
+		},
+		"address-missing": {
+			schemas: tfjson.ProviderSchemas{
+				Schemas: map[string]*tfjson.ProviderSchema{
+					"registry.terraform.io/examplecorp/examplecloud": {},
+				},
+			},
+			address:       "registry.terraform.io/examplecorp/other",
+			expectedError: `provider "registry.terraform.io/examplecorp/other" not found in provider schemas`,
+		},
+		"address-nil-schema": {
+			schemas: tfjson.ProviderSchemas{
+				Schemas: map[string]*tfjson.ProviderSchema{
+					"registry.terraform.io/examplecorp/other": nil,
+				},
+			},
+			address:       "registry.terraform.io/examplecorp/other",
+			expectedError: `provider "registry.terraform.io/examplecorp/other" not found in provider schemas`,
+		},
+		"no-address-single-provider": {
+			schemas: tfjson.ProviderSchemas{
+				Schemas: map[string]*tfjson.ProviderSchema{
+					"registry.terraform.io/examplecorp/examplecloud": {},
+				},
+			},
+			expectedAddress: "registry.terraform.io/examplecorp/examplecloud",
+			expectedSchema:  &tfjson.ProviderSchema{},
+		},
+		"no-address-multiple-providers": {
+			schemas: tfjson.ProviderSchemas{
+				Schemas: map[string]*tfjson.ProviderSchema{
+					"registry.terraform.io/examplecorp/examplecloud": {},
+					"registry.terraform.io/examplecorp/other":        {},
+				},
+			},
+			expectedError: "provider address must be given when provider schemas do not contain exactly one provider, found: registry.terraform.io/examplecorp/examplecloud, registry.terraform.io/examplecorp/other",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotAddress, gotSchema, err := testCase.schemas.ProviderSchema(testCase.address)
+
+			if err != nil {
+				if testCase.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if diff := cmp.Diff(err.Error(), testCase.expectedError); diff != "" {
+					t.Fatalf("unexpected error difference: %s", diff)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("expected error: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(gotAddress, testCase.expectedAddress); diff != "" {
+				t.Errorf("unexpected address difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(gotSchema, testCase.expectedSchema); diff != "" {
+				t.Errorf("unexpected schema difference: %s", diff)
+			}
+		})
+	}
+}
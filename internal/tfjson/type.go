@@ -0,0 +1,46 @@
+package tfjson
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Type wraps a tftypes.Type to implement the JSON type representation used by
+// Terraform, such as "string" or ["list","string"].
+type Type struct {
+	tftypes.Type
+}
+
+// MarshalJSON returns the Terraform JSON representation of the type.
+func (t Type) MarshalJSON() ([]byte, error) {
+	if t.Type == nil {
+		return nil, errors.New("cannot marshal missing type")
+	}
+
+	return json.Marshal(t.Type)
+}
+
+// UnmarshalJSON sets the type from its Terraform JSON representation.
+func (t *Type) UnmarshalJSON(b []byte) error {
+	//nolint:staticcheck // Terraform JSON type parsing is intentionally used.
+	typ, err := tftypes.ParseJSONType(b)
+
+	if err != nil {
+		return err
+	}
+
+	t.Type = typ
+
+	return nil
+}
+
+// Equal returns true if the types are equal.
+func (t Type) Equal(o Type) bool {
+	if t.Type == nil || o.Type == nil {
+		return t.Type == nil && o.Type == nil
+	}
+
+	return t.Type.Equal(o.Type)
+}
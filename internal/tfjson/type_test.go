@@ -0,0 +1,70 @@
+package tfjson_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/tfjson"
+)
+
+func TestTypeJSON(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      tftypes.Type
+		expected string
+	}{
+		"bool": {
+			typ:      tftypes.Bool,
+			expected: `"bool"`,
+		},
+		"list": {
+			typ:      tftypes.List{ElementType: tftypes.String},
+			expected: `["list","string"]`,
+		},
+		"map": {
+			typ:      tftypes.Map{ElementType: tftypes.Number},
+			expected: `["map","number"]`,
+		},
+		"object": {
+			typ: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"a": tftypes.String,
+					"b": tftypes.Set{ElementType: tftypes.Bool},
+				},
+			},
+			expected: `["object",{"a":"string","b":["set","bool"]}]`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := json.Marshal(tfjson.Type{Type: testCase.typ})
+
+			if err != nil {
+				t.Fatalf("unexpected marshal error: %s", err)
+			}
+
+			if diff := cmp.Diff(string(got), testCase.expected); diff != "" {
+				t.Errorf("unexpected marshal difference: %s", diff)
+			}
+
+			var typ tfjson.Type
+
+			if err := json.Unmarshal(got, &typ); err != nil {
+				t.Fatalf("unexpected unmarshal error: %s", err)
+			}
+
+			if !typ.Type.Equal(testCase.typ) {
+				t.Errorf("expected %s, got %s", testCase.typ, typ.Type)
+			}
+		})
+	}
+}
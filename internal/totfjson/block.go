@@ -0,0 +1,70 @@
+package totfjson
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tfjson"
+)
+
+// Block returns the *tfjson.SchemaBlockType equivalent of a Block. Errors
+// will be tftypes.AttributePathErrors based on `path`.
+//
+// MinItems and MaxItems are intentionally not set, matching the protocol
+// conversion, as the framework does not support them. Refer to the
+// fwschema.Block documentation for more details.
+func Block(ctx context.Context, path *tftypes.AttributePath, b fwschema.Block) (*tfjson.SchemaBlockType, error) {
+	schemaBlockType := &tfjson.SchemaBlockType{
+		Block: &tfjson.SchemaBlock{
+			Deprecated: b.GetDeprecationMessage() != "",
+		},
+	}
+
+	schemaBlockType.Block.Description, schemaBlockType.Block.DescriptionKind = description(b.GetDescription(), b.GetMarkdownDescription())
+
+	nm := b.GetNestingMode()
+	switch nm {
+	case fwschema.BlockNestingModeList:
+		schemaBlockType.NestingMode = tfjson.SchemaNestingModeList
+	case fwschema.BlockNestingModeSet:
+		schemaBlockType.NestingMode = tfjson.SchemaNestingModeSet
+	case fwschema.BlockNestingModeSingle:
+		schemaBlockType.NestingMode = tfjson.SchemaNestingModeSingle
+	default:
+		return nil, path.NewErrorf("unrecognized nesting mode %v", nm)
+	}
+
+	nestedBlockObject := b.GetNestedObject()
+
+	for attrName, attr := range nestedBlockObject.GetAttributes() {
+		attrJSON, err := SchemaAttribute(ctx, path.WithAttributeName(attrName), attr)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if schemaBlockType.Block.Attributes == nil {
+			schemaBlockType.Block.Attributes = make(map[string]*tfjson.SchemaAttribute)
+		}
+
+		schemaBlockType.Block.Attributes[attrName] = attrJSON
+	}
+
+	for blockName, block := range nestedBlockObject.GetBlocks() {
+		blockJSON, err := Block(ctx, path.WithAttributeName(blockName), block)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if schemaBlockType.Block.NestedBlocks == nil {
+			schemaBlockType.Block.NestedBlocks = make(map[string]*tfjson.SchemaBlockType)
+		}
+
+		schemaBlockType.Block.NestedBlocks[blockName] = blockJSON
+	}
+
+	return schemaBlockType, nil
+}
@@ -0,0 +1,107 @@
+package totfjson_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tfjson"
+	"github.com/hashicorp/terraform-plugin-framework/internal/totfjson"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBlock(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		block       fwschema.Block
+		expected    *tfjson.SchemaBlockType
+		expectedErr string
+	}{
+		"set-nested-blocks": {
+			block: testschema.Block{
+				NestedObject: testschema.NestedBlockObject{
+					Blocks: map[string]fwschema.Block{
+						"single": testschema.Block{
+							NestedObject: testschema.NestedBlockObject{
+								Attributes: map[string]fwschema.Attribute{
+									"string": testschema.Attribute{
+										Type:     types.StringType,
+										Optional: true,
+									},
+								},
+							},
+							NestingMode: fwschema.BlockNestingModeSingle,
+						},
+					},
+				},
+				NestingMode:        fwschema.BlockNestingModeSet,
+				DeprecationMessage: "Do not use.",
+				Description:        "Example description.",
+			},
+			expected: &tfjson.SchemaBlockType{
+				Block: &tfjson.SchemaBlock{
+					NestedBlocks: map[string]*tfjson.SchemaBlockType{
+						"single": {
+							Block: &tfjson.SchemaBlock{
+								Attributes: map[string]*tfjson.SchemaAttribute{
+									"string": {
+										AttributeType:   &tfjson.Type{Type: tftypes.String},
+										DescriptionKind: tfjson.SchemaDescriptionKindPlain,
+										Optional:        true,
+									},
+								},
+								DescriptionKind: tfjson.SchemaDescriptionKindPlain,
+							},
+							NestingMode: tfjson.SchemaNestingModeSingle,
+						},
+					},
+					Deprecated:      true,
+					Description:     "Example description.",
+					DescriptionKind: tfjson.SchemaDescriptionKindPlain,
+				},
+				NestingMode: tfjson.SchemaNestingModeSet,
+			},
+		},
+		"invalid-nesting-mode": {
+			block: testschema.Block{
+				NestedObject: testschema.NestedBlockObject{},
+			},
+			expectedErr: `AttributeName("test"): unrecognized nesting mode 0`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := totfjson.Block(context.Background(), tftypes.NewAttributePath().WithAttributeName("test"), testCase.block)
+
+			if err != nil {
+				if testCase.expectedErr == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if err.Error() != testCase.expectedErr {
+					t.Fatalf("expected error %q, got %q", testCase.expectedErr, err)
+				}
+
+				return
+			}
+
+			if testCase.expectedErr != "" {
+				t.Fatalf("expected error %q, got none", testCase.expectedErr)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,3 @@
+// Package totfjson contains functions to convert from framework types to the
+// Terraform CLI machine-readable JSON types in the internal/tfjson package.
+package totfjson
@@ -0,0 +1,53 @@
+package totfjson
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tfjson"
+)
+
+// GetProviderSchemaResponse returns the *tfjson.ProviderSchema equivalent of a
+// *fwserver.GetProviderSchemaResponse. Diagnostics are not converted.
+func GetProviderSchemaResponse(ctx context.Context, fw *fwserver.GetProviderSchemaResponse) (*tfjson.ProviderSchema, error) {
+	if fw == nil {
+		return nil, nil
+	}
+
+	result := &tfjson.ProviderSchema{}
+
+	var err error
+
+	result.ConfigSchema, err = Schema(ctx, fw.Provider)
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert provider schema: %w", err)
+	}
+
+	if len(fw.DataSourceSchemas) > 0 {
+		result.DataSourceSchemas = make(map[string]*tfjson.Schema, len(fw.DataSourceSchemas))
+	}
+
+	for dataSourceType, dataSourceSchema := range fw.DataSourceSchemas {
+		result.DataSourceSchemas[dataSourceType], err = Schema(ctx, dataSourceSchema)
+
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert data source %q schema: %w", dataSourceType, err)
+		}
+	}
+
+	if len(fw.ResourceSchemas) > 0 {
+		result.ResourceSchemas = make(map[string]*tfjson.Schema, len(fw.ResourceSchemas))
+	}
+
+	for resourceType, resourceSchema := range fw.ResourceSchemas {
+		result.ResourceSchemas[resourceType], err = Schema(ctx, resourceSchema)
+
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert resource %q schema: %w", resourceType, err)
+		}
+	}
+
+	return result, nil
+}
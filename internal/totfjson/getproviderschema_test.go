@@ -0,0 +1,120 @@
+package totfjson_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tfjson"
+	"github.com/hashicorp/terraform-plugin-framework/internal/totfjson"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestGetProviderSchemaResponse(t *testing.T) {
+	t.Parallel()
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"test": testschema.Attribute{
+				Type:     types.StringType,
+				Required: true,
+			},
+		},
+	}
+	testSchemaJSON := &tfjson.Schema{
+		Block: &tfjson.SchemaBlock{
+			Attributes: map[string]*tfjson.SchemaAttribute{
+				"test": {
+					AttributeType:   &tfjson.Type{Type: tftypes.String},
+					DescriptionKind: tfjson.SchemaDescriptionKindPlain,
+					Required:        true,
+				},
+			},
+			DescriptionKind: tfjson.SchemaDescriptionKindPlain,
+		},
+	}
+
+	testCases := map[string]struct {
+		input       *fwserver.GetProviderSchemaResponse
+		expected    *tfjson.ProviderSchema
+		expectedErr string
+	}{
+		"nil": {
+			input:    nil,
+			expected: nil,
+		},
+		"empty": {
+			input:    &fwserver.GetProviderSchemaResponse{},
+			expected: &tfjson.ProviderSchema{},
+		},
+		"schemas": {
+			input: &fwserver.GetProviderSchemaResponse{
+				Provider: testSchema,
+				DataSourceSchemas: map[string]fwschema.Schema{
+					"test_data_source": testSchema,
+				},
+				ResourceSchemas: map[string]fwschema.Schema{
+					"test_resource": testSchema,
+				},
+			},
+			expected: &tfjson.ProviderSchema{
+				ConfigSchema: testSchemaJSON,
+				DataSourceSchemas: map[string]*tfjson.Schema{
+					"test_data_source": testSchemaJSON,
+				},
+				ResourceSchemas: map[string]*tfjson.Schema{
+					"test_resource": testSchemaJSON,
+				},
+			},
+		},
+		"resource-schema-error": {
+			input: &fwserver.GetProviderSchemaResponse{
+				ResourceSchemas: map[string]fwschema.Schema{
+					"test_resource": testschema.Schema{
+						Attributes: map[string]fwschema.Attribute{
+							"test": testschema.Attribute{
+								Type: types.StringType,
+							},
+						},
+					},
+				},
+			},
+			expectedErr: `unable to convert resource "test_resource" schema: AttributeName("test"): must have Required, Optional, or Computed set`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := totfjson.GetProviderSchemaResponse(context.Background(), testCase.input)
+
+			if err != nil {
+				if testCase.expectedErr == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if err.Error() != testCase.expectedErr {
+					t.Fatalf("expected error %q, got %q", testCase.expectedErr, err)
+				}
+
+				return
+			}
+
+			if testCase.expectedErr != "" {
+				t.Fatalf("expected error %q, got none", testCase.expectedErr)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
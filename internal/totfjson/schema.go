@@ -0,0 +1,66 @@
+package totfjson
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tfjson"
+)
+
+// Schema returns the *tfjson.Schema equivalent of a Schema.
+func Schema(ctx context.Context, s fwschema.Schema) (*tfjson.Schema, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	result := &tfjson.Schema{
+		Version: s.GetVersion(),
+		Block: &tfjson.SchemaBlock{
+			Deprecated: s.GetDeprecationMessage() != "",
+		},
+	}
+
+	result.Block.Description, result.Block.DescriptionKind = description(s.GetDescription(), s.GetMarkdownDescription())
+
+	for name, attr := range s.GetAttributes() {
+		a, err := SchemaAttribute(ctx, tftypes.NewAttributePath().WithAttributeName(name), attr)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if result.Block.Attributes == nil {
+			result.Block.Attributes = make(map[string]*tfjson.SchemaAttribute)
+		}
+
+		result.Block.Attributes[name] = a
+	}
+
+	for name, block := range s.GetBlocks() {
+		b, err := Block(ctx, tftypes.NewAttributePath().WithAttributeName(name), block)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if result.Block.NestedBlocks == nil {
+			result.Block.NestedBlocks = make(map[string]*tfjson.SchemaBlockType)
+		}
+
+		result.Block.NestedBlocks[name] = b
+	}
+
+	return result, nil
+}
+
+// description returns the description and description kind, preferring the
+// Markdown description similar to the protocol conversion.
+func description(plain string, markdown string) (string, tfjson.SchemaDescriptionKind) {
+	if markdown != "" {
+		return markdown, tfjson.SchemaDescriptionKindMarkdown
+	}
+
+	return plain, tfjson.SchemaDescriptionKindPlain
+}
@@ -0,0 +1,70 @@
+package totfjson
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tfjson"
+)
+
+// SchemaAttribute returns the *tfjson.SchemaAttribute equivalent of an
+// Attribute. Errors will be tftypes.AttributePathErrors based on `path`.
+func SchemaAttribute(ctx context.Context, path *tftypes.AttributePath, a fwschema.Attribute) (*tfjson.SchemaAttribute, error) {
+	if !a.IsRequired() && !a.IsOptional() && !a.IsComputed() {
+		return nil, path.NewErrorf("must have Required, Optional, or Computed set")
+	}
+
+	schemaAttribute := &tfjson.SchemaAttribute{
+		Required:   a.IsRequired(),
+		Optional:   a.IsOptional(),
+		Computed:   a.IsComputed(),
+		Sensitive:  a.IsSensitive(),
+		Deprecated: a.GetDeprecationMessage() != "",
+	}
+
+	schemaAttribute.Description, schemaAttribute.DescriptionKind = description(a.GetDescription(), a.GetMarkdownDescription())
+
+	nestedAttribute, ok := a.(fwschema.NestedAttribute)
+
+	if !ok {
+		schemaAttribute.AttributeType = &tfjson.Type{
+			Type: a.GetType().TerraformType(ctx),
+		}
+
+		return schemaAttribute, nil
+	}
+
+	nestedType := &tfjson.SchemaNestedAttributeType{
+		Attributes: make(map[string]*tfjson.SchemaAttribute),
+	}
+
+	nm := nestedAttribute.GetNestingMode()
+	switch nm {
+	case fwschema.NestingModeSingle:
+		nestedType.NestingMode = tfjson.SchemaNestingModeSingle
+	case fwschema.NestingModeList:
+		nestedType.NestingMode = tfjson.SchemaNestingModeList
+	case fwschema.NestingModeSet:
+		nestedType.NestingMode = tfjson.SchemaNestingModeSet
+	case fwschema.NestingModeMap:
+		nestedType.NestingMode = tfjson.SchemaNestingModeMap
+	default:
+		return nil, path.NewErrorf("unrecognized nesting mode %v", nm)
+	}
+
+	for nestedName, nestedA := range nestedAttribute.GetNestedObject().GetAttributes() {
+		nestedSchemaAttribute, err := SchemaAttribute(ctx, path.WithAttributeName(nestedName), nestedA)
+
+		if err != nil {
+			return nil, err
+		}
+
+		nestedType.Attributes[nestedName] = nestedSchemaAttribute
+	}
+
+	schemaAttribute.AttributeNestedType = nestedType
+
+	return schemaAttribute, nil
+}
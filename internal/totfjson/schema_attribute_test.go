@@ -0,0 +1,167 @@
+package totfjson_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tfjson"
+	"github.com/hashicorp/terraform-plugin-framework/internal/totfjson"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSchemaAttribute(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attr        fwschema.Attribute
+		expected    *tfjson.SchemaAttribute
+		expectedErr string
+	}{
+		"computed-sensitive": {
+			attr: testschema.Attribute{
+				Type:      types.StringType,
+				Computed:  true,
+				Sensitive: true,
+			},
+			expected: &tfjson.SchemaAttribute{
+				AttributeType:   &tfjson.Type{Type: tftypes.String},
+				Computed:        true,
+				DescriptionKind: tfjson.SchemaDescriptionKindPlain,
+				Sensitive:       true,
+			},
+		},
+		"deprecated-description": {
+			attr: testschema.Attribute{
+				Type:               types.ListType{ElemType: types.NumberType},
+				Optional:           true,
+				DeprecationMessage: "Do not use.",
+				Description:        "Example description.",
+			},
+			expected: &tfjson.SchemaAttribute{
+				AttributeType:   &tfjson.Type{Type: tftypes.List{ElementType: tftypes.Number}},
+				Deprecated:      true,
+				Description:     "Example description.",
+				DescriptionKind: tfjson.SchemaDescriptionKindPlain,
+				Optional:        true,
+			},
+		},
+		"markdown-description": {
+			attr: testschema.Attribute{
+				Type:                types.ObjectType{AttrTypes: map[string]attr.Type{"a": types.BoolType}},
+				Optional:            true,
+				MarkdownDescription: "*Example* description.",
+			},
+			expected: &tfjson.SchemaAttribute{
+				AttributeType:   &tfjson.Type{Type: tftypes.Object{AttributeTypes: map[string]tftypes.Type{"a": tftypes.Bool}}},
+				Description:     "*Example* description.",
+				DescriptionKind: tfjson.SchemaDescriptionKindMarkdown,
+				Optional:        true,
+			},
+		},
+		"nested-attribute-map": {
+			attr: testschema.NestedAttribute{
+				NestedObject: testschema.NestedAttributeObject{
+					Attributes: map[string]fwschema.Attribute{
+						"nested": testschema.Attribute{
+							Type:     types.StringType,
+							Required: true,
+						},
+					},
+				},
+				NestingMode: fwschema.NestingModeMap,
+				Optional:    true,
+			},
+			expected: &tfjson.SchemaAttribute{
+				AttributeNestedType: &tfjson.SchemaNestedAttributeType{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"nested": {
+							AttributeType:   &tfjson.Type{Type: tftypes.String},
+							DescriptionKind: tfjson.SchemaDescriptionKindPlain,
+							Required:        true,
+						},
+					},
+					NestingMode: tfjson.SchemaNestingModeMap,
+				},
+				DescriptionKind: tfjson.SchemaDescriptionKindPlain,
+				Optional:        true,
+			},
+		},
+		"nested-attribute-single": {
+			attr: testschema.NestedAttribute{
+				NestedObject: testschema.NestedAttributeObject{
+					Attributes: map[string]fwschema.Attribute{
+						"nested": testschema.Attribute{
+							Type:     types.Int64Type,
+							Computed: true,
+						},
+					},
+				},
+				NestingMode: fwschema.NestingModeSingle,
+				Computed:    true,
+			},
+			expected: &tfjson.SchemaAttribute{
+				AttributeNestedType: &tfjson.SchemaNestedAttributeType{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"nested": {
+							AttributeType:   &tfjson.Type{Type: tftypes.Number},
+							Computed:        true,
+							DescriptionKind: tfjson.SchemaDescriptionKindPlain,
+						},
+					},
+					NestingMode: tfjson.SchemaNestingModeSingle,
+				},
+				Computed:        true,
+				DescriptionKind: tfjson.SchemaDescriptionKindPlain,
+			},
+		},
+		"nested-attribute-invalid-nesting-mode": {
+			attr: testschema.NestedAttribute{
+				NestedObject: testschema.NestedAttributeObject{},
+				Optional:     true,
+			},
+			expectedErr: `AttributeName("test"): unrecognized nesting mode 0`,
+		},
+		"missing-required-optional-computed": {
+			attr: testschema.Attribute{
+				Type: types.StringType,
+			},
+			expectedErr: `AttributeName("test"): must have Required, Optional, or Computed set`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := totfjson.SchemaAttribute(context.Background(), tftypes.NewAttributePath().WithAttributeName("test"), testCase.attr)
+
+			if err != nil {
+				if testCase.expectedErr == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if err.Error() != testCase.expectedErr {
+					t.Fatalf("expected error %q, got %q", testCase.expectedErr, err)
+				}
+
+				return
+			}
+
+			if testCase.expectedErr != "" {
+				t.Fatalf("expected error %q, got none", testCase.expectedErr)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
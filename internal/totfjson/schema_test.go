@@ -0,0 +1,144 @@
+package totfjson_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tfjson"
+	"github.com/hashicorp/terraform-plugin-framework/internal/totfjson"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSchema(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input       fwschema.Schema
+		expected    *tfjson.Schema
+		expectedErr string
+	}{
+		"nil": {
+			input:    nil,
+			expected: nil,
+		},
+		"empty": {
+			input: testschema.Schema{},
+			expected: &tfjson.Schema{
+				Block: &tfjson.SchemaBlock{
+					DescriptionKind: tfjson.SchemaDescriptionKindPlain,
+				},
+			},
+		},
+		"attributes-blocks": {
+			input: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"string": testschema.Attribute{
+						Type:     types.StringType,
+						Required: true,
+					},
+				},
+				Blocks: map[string]fwschema.Block{
+					"list": testschema.Block{
+						NestedObject: testschema.NestedBlockObject{
+							Attributes: map[string]fwschema.Attribute{
+								"bool": testschema.Attribute{
+									Type:     types.BoolType,
+									Optional: true,
+								},
+							},
+						},
+						NestingMode: fwschema.BlockNestingModeList,
+					},
+				},
+				Version: 3,
+			},
+			expected: &tfjson.Schema{
+				Version: 3,
+				Block: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"string": {
+							AttributeType:   &tfjson.Type{Type: tftypes.String},
+							DescriptionKind: tfjson.SchemaDescriptionKindPlain,
+							Required:        true,
+						},
+					},
+					NestedBlocks: map[string]*tfjson.SchemaBlockType{
+						"list": {
+							Block: &tfjson.SchemaBlock{
+								Attributes: map[string]*tfjson.SchemaAttribute{
+									"bool": {
+										AttributeType:   &tfjson.Type{Type: tftypes.Bool},
+										DescriptionKind: tfjson.SchemaDescriptionKindPlain,
+										Optional:        true,
+									},
+								},
+								DescriptionKind: tfjson.SchemaDescriptionKindPlain,
+							},
+							NestingMode: tfjson.SchemaNestingModeList,
+						},
+					},
+					DescriptionKind: tfjson.SchemaDescriptionKindPlain,
+				},
+			},
+		},
+		"description-deprecation": {
+			input: testschema.Schema{
+				DeprecationMessage:  "Use other instead.",
+				Description:         "plain",
+				MarkdownDescription: "*markdown*",
+			},
+			expected: &tfjson.Schema{
+				Block: &tfjson.SchemaBlock{
+					Deprecated:      true,
+					Description:     "*markdown*",
+					DescriptionKind: tfjson.SchemaDescriptionKindMarkdown,
+				},
+			},
+		},
+		"attribute-error": {
+			input: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"string": testschema.Attribute{
+						Type: types.StringType,
+					},
+				},
+			},
+			expectedErr: `AttributeName("string"): must have Required, Optional, or Computed set`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := totfjson.Schema(context.Background(), testCase.input)
+
+			if err != nil {
+				if testCase.expectedErr == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if err.Error() != testCase.expectedErr {
+					t.Fatalf("expected error %q, got %q", testCase.expectedErr, err)
+				}
+
+				return
+			}
+
+			if testCase.expectedErr != "" {
+				t.Fatalf("expected error %q, got none", testCase.expectedErr)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
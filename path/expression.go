@@ -20,8 +20,10 @@ import (
 // construct a full expression. The available traversal steps after Expression
 // creation are:
 //
+//   - AtAnyDepth(): Step zero or more times into anything
 //   - AtAnyListIndex(): Step into a list at any index
 //   - AtAnyMapKey(): Step into a map at any key
+//   - AtAnyName(): Step into an attribute or block with any name
 //   - AtAnySetValue(): Step into a set at any attr.Value element
 //   - AtListIndex(): Step into a list at a specific index
 //   - AtMapKey(): Step into a map at a specific key
@@ -34,6 +36,11 @@ import (
 //
 //	path.MatchRoot("some_attribute").AtAnyListIndex()
 //
+// Or to express every attribute named "tags", at the root or nested at any
+// depth:
+//
+//	path.MatchRootAnyDepth().AtName("tags")
+//
 // An Expression is generally preferable over a Path in schema-defined
 // functionality that is intended to accept paths as parameters, such as
 // attribute validators and attribute plan modifiers, since it allows consumers
@@ -54,6 +61,17 @@ type Expression struct {
 	steps ExpressionSteps
 }
 
+// AtAnyDepth returns a copied expression with a new recursive descent step,
+// which matches zero or more steps of any kind, at the end. The returned path
+// is safe to modify without affecting the original.
+func (e Expression) AtAnyDepth() Expression {
+	copiedPath := e.Copy()
+
+	copiedPath.steps.Append(ExpressionStepAnyDepth{})
+
+	return copiedPath
+}
+
 // AtAnyListIndex returns a copied expression with a new list index step at the
 // end. The returned path is safe to modify without affecting the original.
 func (e Expression) AtAnyListIndex() Expression {
@@ -74,6 +92,17 @@ func (e Expression) AtAnyMapKey() Expression {
 	return copiedPath
 }
 
+// AtAnyName returns a copied expression with a new attribute or block name
+// step, which matches any name, at the end. The returned path is safe to
+// modify without affecting the original.
+func (e Expression) AtAnyName() Expression {
+	copiedPath := e.Copy()
+
+	copiedPath.steps.Append(ExpressionStepAttributeNameAny{})
+
+	return copiedPath
+}
+
 // AtAnySetValue returns a copied expression with a new set value step at the
 // end. The returned path is safe to modify without affecting the original.
 func (e Expression) AtAnySetValue() Expression {
@@ -251,7 +280,15 @@ func (e Expression) Steps() ExpressionSteps {
 // String returns the human-readable representation of the path.
 // It is intended for logging and error messages and is not protected by
 // compatibility guarantees.
+//
+// Relative expressions starting with an attribute name step are prefixed
+// with a period, such as `.child`, to distinguish them from root
+// expressions.
 func (e Expression) String() string {
+	if !e.root && len(e.steps) > 0 && isAttributeNameStep(e.steps[0]) {
+		return "." + e.steps.String()
+	}
+
 	return e.steps.String()
 }
 
@@ -275,3 +312,37 @@ func MatchRoot(rootAttributeName string) Expression {
 		},
 	}
 }
+
+// MatchRootAnyDepth creates an attribute path expression starting with
+// ExpressionStepAnyDepth, which matches from the root of the data at any
+// depth.
+func MatchRootAnyDepth() Expression {
+	return Expression{
+		root: true,
+		steps: ExpressionSteps{
+			ExpressionStepAnyDepth{},
+		},
+	}
+}
+
+// MatchRootAnyName creates an attribute path expression starting with
+// ExpressionStepAttributeNameAny, which matches any root attribute.
+func MatchRootAnyName() Expression {
+	return Expression{
+		root: true,
+		steps: ExpressionSteps{
+			ExpressionStepAttributeNameAny{},
+		},
+	}
+}
+
+// isAttributeNameStep returns true if the step matches attribute names, which
+// are the steps starting root expressions.
+func isAttributeNameStep(step ExpressionStep) bool {
+	switch step.(type) {
+	case ExpressionStepAttributeNameAny, ExpressionStepAttributeNameExact, ExpressionStepAnyDepth:
+		return true
+	default:
+		return false
+	}
+}
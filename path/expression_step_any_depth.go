@@ -0,0 +1,37 @@
+package path
+
+// Ensure ExpressionStepAnyDepth satisfies the ExpressionStep interface.
+var _ ExpressionStep = ExpressionStepAnyDepth{}
+
+// ExpressionStepAnyDepth is an attribute path expression for recursive
+// descent, matching zero or more steps of any kind. For example, an
+// expression of an ExpressionStepAnyDepth followed by an
+// ExpressionStepAttributeNameExact of "tags" matches every "tags" attribute,
+// at the root or nested at any depth.
+//
+// ExpressionSteps handles matching multiple steps, so the Matches method of
+// this step matches any single PathStep.
+type ExpressionStepAnyDepth struct{}
+
+// Equal returns true if the given ExpressionStep is a
+// ExpressionStepAnyDepth.
+func (s ExpressionStepAnyDepth) Equal(o ExpressionStep) bool {
+	_, ok := o.(ExpressionStepAnyDepth)
+
+	return ok
+}
+
+// Matches returns true for any PathStep.
+func (s ExpressionStepAnyDepth) Matches(_ PathStep) bool {
+	return true
+}
+
+// String returns the human-readable representation of the recursive descent
+// expression. It is intended for logging and error messages and is not
+// protected by compatibility guarantees.
+func (s ExpressionStepAnyDepth) String() string {
+	return "**"
+}
+
+// unexported satisfies the Step interface.
+func (s ExpressionStepAnyDepth) unexported() {}
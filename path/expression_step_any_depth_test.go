@@ -0,0 +1,137 @@
+package path_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestExpressionStepAnyDepthEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		step     path.ExpressionStepAnyDepth
+		other    path.ExpressionStep
+		expected bool
+	}{
+		"ExpressionStepAttributeNameExact": {
+			step:     path.ExpressionStepAnyDepth{},
+			other:    path.ExpressionStepAttributeNameExact("test"),
+			expected: false,
+		},
+		"ExpressionStepAnyDepth": {
+			step:     path.ExpressionStepAnyDepth{},
+			other:    path.ExpressionStepAnyDepth{},
+			expected: true,
+		},
+		"ExpressionStepAttributeNameAny": {
+			step:     path.ExpressionStepAnyDepth{},
+			other:    path.ExpressionStepAttributeNameAny{},
+			expected: false,
+		},
+		"ExpressionStepElementKeyIntExact": {
+			step:     path.ExpressionStepAnyDepth{},
+			other:    path.ExpressionStepElementKeyIntExact(0),
+			expected: false,
+		},
+		"ExpressionStepElementKeyStringExact": {
+			step:     path.ExpressionStepAnyDepth{},
+			other:    path.ExpressionStepElementKeyStringExact("test"),
+			expected: false,
+		},
+		"ExpressionStepElementKeyValueExact": {
+			step:     path.ExpressionStepAnyDepth{},
+			other:    path.ExpressionStepElementKeyValueExact{Value: types.StringValue("test")},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.step.Equal(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestExpressionStepAnyDepthMatches(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		step     path.ExpressionStepAnyDepth
+		pathStep path.PathStep
+		expected bool
+	}{
+		"StepAttributeName": {
+			step:     path.ExpressionStepAnyDepth{},
+			pathStep: path.PathStepAttributeName("test"),
+			expected: true,
+		},
+		"StepElementKeyInt": {
+			step:     path.ExpressionStepAnyDepth{},
+			pathStep: path.PathStepElementKeyInt(0),
+			expected: true,
+		},
+		"StepElementKeyString": {
+			step:     path.ExpressionStepAnyDepth{},
+			pathStep: path.PathStepElementKeyString("test"),
+			expected: true,
+		},
+		"StepElementKeyValue": {
+			step:     path.ExpressionStepAnyDepth{},
+			pathStep: path.PathStepElementKeyValue{Value: types.StringValue("test")},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.step.Matches(testCase.pathStep)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestExpressionStepAnyDepthString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		step     path.ExpressionStepAnyDepth
+		expected string
+	}{
+		"basic": {
+			step:     path.ExpressionStepAnyDepth{},
+			expected: "**",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.step.String()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package path
+
+// Ensure ExpressionStepAttributeNameAny satisfies the ExpressionStep
+// interface.
+var _ ExpressionStep = ExpressionStepAttributeNameAny{}
+
+// ExpressionStepAttributeNameAny is an attribute path expression for matching
+// any attribute name within an object.
+type ExpressionStepAttributeNameAny struct{}
+
+// Equal returns true if the given ExpressionStep is a
+// ExpressionStepAttributeNameAny.
+func (s ExpressionStepAttributeNameAny) Equal(o ExpressionStep) bool {
+	_, ok := o.(ExpressionStepAttributeNameAny)
+
+	return ok
+}
+
+// Matches returns true if the given PathStep is fulfilled by the
+// ExpressionStepAttributeNameAny condition.
+func (s ExpressionStepAttributeNameAny) Matches(pathStep PathStep) bool {
+	_, ok := pathStep.(PathStepAttributeName)
+
+	return ok
+}
+
+// String returns the human-readable representation of the attribute name
+// expression. It is intended for logging and error messages and is not
+// protected by compatibility guarantees.
+func (s ExpressionStepAttributeNameAny) String() string {
+	return "*"
+}
+
+// unexported satisfies the Step interface.
+func (s ExpressionStepAttributeNameAny) unexported() {}
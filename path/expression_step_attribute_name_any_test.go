@@ -0,0 +1,137 @@
+package path_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestExpressionStepAttributeNameAnyEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		step     path.ExpressionStepAttributeNameAny
+		other    path.ExpressionStep
+		expected bool
+	}{
+		"ExpressionStepAttributeNameExact": {
+			step:     path.ExpressionStepAttributeNameAny{},
+			other:    path.ExpressionStepAttributeNameExact("test"),
+			expected: false,
+		},
+		"ExpressionStepAttributeNameAny": {
+			step:     path.ExpressionStepAttributeNameAny{},
+			other:    path.ExpressionStepAttributeNameAny{},
+			expected: true,
+		},
+		"ExpressionStepElementKeyIntAny": {
+			step:     path.ExpressionStepAttributeNameAny{},
+			other:    path.ExpressionStepElementKeyIntAny{},
+			expected: false,
+		},
+		"ExpressionStepElementKeyIntExact": {
+			step:     path.ExpressionStepAttributeNameAny{},
+			other:    path.ExpressionStepElementKeyIntExact(0),
+			expected: false,
+		},
+		"ExpressionStepElementKeyStringExact": {
+			step:     path.ExpressionStepAttributeNameAny{},
+			other:    path.ExpressionStepElementKeyStringExact("test"),
+			expected: false,
+		},
+		"ExpressionStepElementKeyValueExact": {
+			step:     path.ExpressionStepAttributeNameAny{},
+			other:    path.ExpressionStepElementKeyValueExact{Value: types.StringValue("test")},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.step.Equal(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestExpressionStepAttributeNameAnyMatches(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		step     path.ExpressionStepAttributeNameAny
+		pathStep path.PathStep
+		expected bool
+	}{
+		"StepAttributeName": {
+			step:     path.ExpressionStepAttributeNameAny{},
+			pathStep: path.PathStepAttributeName("test"),
+			expected: true,
+		},
+		"StepElementKeyInt": {
+			step:     path.ExpressionStepAttributeNameAny{},
+			pathStep: path.PathStepElementKeyInt(0),
+			expected: false,
+		},
+		"StepElementKeyString": {
+			step:     path.ExpressionStepAttributeNameAny{},
+			pathStep: path.PathStepElementKeyString("test"),
+			expected: false,
+		},
+		"StepElementKeyValue": {
+			step:     path.ExpressionStepAttributeNameAny{},
+			pathStep: path.PathStepElementKeyValue{Value: types.StringValue("test")},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.step.Matches(testCase.pathStep)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestExpressionStepAttributeNameAnyString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		step     path.ExpressionStepAttributeNameAny
+		expected string
+	}{
+		"basic": {
+			step:     path.ExpressionStepAttributeNameAny{},
+			expected: "*",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.step.String()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -61,6 +61,7 @@ func (s ExpressionSteps) LastStep() (ExpressionStep, ExpressionSteps) {
 }
 
 // Matches returns true if the given PathSteps match each ExpressionStep.
+// Each ExpressionStepAnyDepth matches zero or more PathSteps.
 //
 // Any ExpressionStepParent will automatically be resolved.
 func (s ExpressionSteps) Matches(pathSteps PathSteps) bool {
@@ -71,23 +72,15 @@ func (s ExpressionSteps) Matches(pathSteps PathSteps) bool {
 		return false
 	}
 
-	if len(resolvedExpressionSteps) != len(pathSteps) {
-		return false
-	}
-
-	for stepIndex, expressionStep := range resolvedExpressionSteps {
-		if !expressionStep.Matches(pathSteps[stepIndex]) {
-			return false
-		}
-	}
-
-	return true
+	return matchExpressionSteps(resolvedExpressionSteps, pathSteps, false)
 }
 
 // MatchesParent returns true if the given PathSteps match each ExpressionStep
 // until there are no more PathSteps. This is helpful for determining if the
 // PathSteps would potentially match the full ExpressionSteps during
-// depth-first traversal.
+// depth-first traversal. Each ExpressionStepAnyDepth matches zero or more
+// PathSteps, so PathSteps matching ExpressionSteps ending in an
+// ExpressionStepAnyDepth are also a parent of deeper matching PathSteps.
 //
 // Any ExpressionStepParent will automatically be resolved.
 func (s ExpressionSteps) MatchesParent(pathSteps PathSteps) bool {
@@ -100,19 +93,46 @@ func (s ExpressionSteps) MatchesParent(pathSteps PathSteps) bool {
 		return false
 	}
 
-	// Path steps deeper than or equal to the expression steps should not match
-	// as a potential parent.
-	if len(pathSteps) >= len(resolvedExpressionSteps) {
+	return matchExpressionSteps(resolvedExpressionSteps, pathSteps, true)
+}
+
+// matchExpressionSteps returns true if the resolved expression steps match
+// the path steps. If parent is true, the path steps must instead match the
+// beginning of the expression steps, with expression steps remaining which
+// could match deeper path steps.
+func matchExpressionSteps(expressionSteps ExpressionSteps, pathSteps PathSteps, parent bool) bool {
+	if len(pathSteps) == 0 {
+		if parent {
+			return len(expressionSteps) > 0
+		}
+
+		// Only remaining recursive descent steps can match no path steps.
+		for _, expressionStep := range expressionSteps {
+			if _, ok := expressionStep.(ExpressionStepAnyDepth); !ok {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	// Path steps deeper than the expression steps should not match.
+	if len(expressionSteps) == 0 {
 		return false
 	}
 
-	for stepIndex, pathStep := range pathSteps {
-		if !resolvedExpressionSteps[stepIndex].Matches(pathStep) {
-			return false
-		}
+	if _, ok := expressionSteps[0].(ExpressionStepAnyDepth); ok {
+		// Either match no path steps with this expression step or consume
+		// the next path step and try again.
+		return matchExpressionSteps(expressionSteps[1:], pathSteps, parent) ||
+			matchExpressionSteps(expressionSteps, pathSteps[1:], parent)
 	}
 
-	return true
+	if !expressionSteps[0].Matches(pathSteps[0]) {
+		return false
+	}
+
+	return matchExpressionSteps(expressionSteps[1:], pathSteps[1:], parent)
 }
 
 // NextStep returns the first ExpressionStep and the remaining ExpressionSteps.
@@ -174,7 +194,7 @@ func (s ExpressionSteps) String() string {
 	for stepIndex, step := range s {
 		if stepIndex != 0 {
 			switch step.(type) {
-			case ExpressionStepAttributeNameExact, ExpressionStepParent:
+			case ExpressionStepAttributeNameAny, ExpressionStepAttributeNameExact, ExpressionStepAnyDepth, ExpressionStepParent:
 				result.WriteString(".")
 			}
 		}
@@ -646,6 +646,91 @@ func TestExpressionStepsMatches(t *testing.T) {
 			},
 			expected: false,
 		},
+		"AttributeNameAny-AttributeName": {
+			steps: path.ExpressionSteps{
+				path.ExpressionStepAttributeNameAny{},
+			},
+			pathSteps: path.PathSteps{
+				path.PathStepAttributeName("test"),
+			},
+			expected: true,
+		},
+		"AttributeNameAny-ElementKeyInt": {
+			steps: path.ExpressionSteps{
+				path.ExpressionStepAttributeNameAny{},
+			},
+			pathSteps: path.PathSteps{
+				path.PathStepElementKeyInt(0),
+			},
+			expected: false,
+		},
+		"AnyDepth-empty": {
+			steps: path.ExpressionSteps{
+				path.ExpressionStepAnyDepth{},
+			},
+			pathSteps: path.PathSteps{},
+			expected:  true,
+		},
+		"AnyDepth-AttributeName-shallow": {
+			steps: path.ExpressionSteps{
+				path.ExpressionStepAnyDepth{},
+				path.ExpressionStepAttributeNameExact("tags"),
+			},
+			pathSteps: path.PathSteps{
+				path.PathStepAttributeName("tags"),
+			},
+			expected: true,
+		},
+		"AnyDepth-AttributeName-deep": {
+			steps: path.ExpressionSteps{
+				path.ExpressionStepAnyDepth{},
+				path.ExpressionStepAttributeNameExact("tags"),
+			},
+			pathSteps: path.PathSteps{
+				path.PathStepAttributeName("test1"),
+				path.PathStepElementKeyInt(0),
+				path.PathStepAttributeName("test2"),
+				path.PathStepAttributeName("tags"),
+			},
+			expected: true,
+		},
+		"AnyDepth-AttributeName-different": {
+			steps: path.ExpressionSteps{
+				path.ExpressionStepAnyDepth{},
+				path.ExpressionStepAttributeNameExact("tags"),
+			},
+			pathSteps: path.PathSteps{
+				path.PathStepAttributeName("tags"),
+				path.PathStepElementKeyString("test"),
+			},
+			expected: false,
+		},
+		"AttributeName-AnyDepth-AttributeName-AnyDepth": {
+			steps: path.ExpressionSteps{
+				path.ExpressionStepAttributeNameExact("test1"),
+				path.ExpressionStepAnyDepth{},
+				path.ExpressionStepAttributeNameExact("test2"),
+				path.ExpressionStepAnyDepth{},
+			},
+			pathSteps: path.PathSteps{
+				path.PathStepAttributeName("test1"),
+				path.PathStepAttributeName("test2"),
+				path.PathStepAttributeName("test2"),
+				path.PathStepElementKeyInt(0),
+			},
+			expected: true,
+		},
+		"AttributeName-AnyDepth-different-root": {
+			steps: path.ExpressionSteps{
+				path.ExpressionStepAttributeNameExact("test1"),
+				path.ExpressionStepAnyDepth{},
+			},
+			pathSteps: path.PathSteps{
+				path.PathStepAttributeName("test2"),
+				path.PathStepAttributeName("test1"),
+			},
+			expected: false,
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -1062,6 +1147,66 @@ func TestExpressionStepsMatchesParent(t *testing.T) {
 			},
 			expected: false,
 		},
+		"AttributeNameAny-AttributeName": {
+			steps: path.ExpressionSteps{
+				path.ExpressionStepAttributeNameAny{},
+				path.ExpressionStepAttributeNameExact("test2"),
+			},
+			pathSteps: path.PathSteps{
+				path.PathStepAttributeName("test1"),
+			},
+			expected: true,
+		},
+		"AnyDepth-AttributeName-empty": {
+			steps: path.ExpressionSteps{
+				path.ExpressionStepAnyDepth{},
+				path.ExpressionStepAttributeNameExact("tags"),
+			},
+			pathSteps: path.PathSteps{},
+			expected:  true,
+		},
+		"AnyDepth-AttributeName-deep": {
+			steps: path.ExpressionSteps{
+				path.ExpressionStepAnyDepth{},
+				path.ExpressionStepAttributeNameExact("tags"),
+			},
+			pathSteps: path.PathSteps{
+				path.PathStepAttributeName("test"),
+				path.PathStepElementKeyInt(0),
+			},
+			expected: true,
+		},
+		"AnyDepth-AttributeName-match": {
+			steps: path.ExpressionSteps{
+				path.ExpressionStepAnyDepth{},
+				path.ExpressionStepAttributeNameExact("tags"),
+			},
+			pathSteps: path.PathSteps{
+				path.PathStepAttributeName("tags"),
+			},
+			// tags could be nested in tags
+			expected: true,
+		},
+		"AttributeName-AnyDepth-match": {
+			steps: path.ExpressionSteps{
+				path.ExpressionStepAttributeNameExact("test"),
+				path.ExpressionStepAnyDepth{},
+			},
+			pathSteps: path.PathSteps{
+				path.PathStepAttributeName("test"),
+			},
+			expected: true,
+		},
+		"AttributeName-AnyDepth-different": {
+			steps: path.ExpressionSteps{
+				path.ExpressionStepAttributeNameExact("test"),
+				path.ExpressionStepAnyDepth{},
+			},
+			pathSteps: path.PathSteps{
+				path.PathStepAttributeName("not-test"),
+			},
+			expected: false,
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -1388,6 +1533,22 @@ func TestExpressionStepsString(t *testing.T) {
 			},
 			expected: `[Value("test")]`,
 		},
+		"AnyDepth-AttributeName": {
+			steps: path.ExpressionSteps{
+				path.ExpressionStepAnyDepth{},
+				path.ExpressionStepAttributeNameExact("test"),
+			},
+			expected: `**.test`,
+		},
+		"AttributeName-AttributeNameAny-AnyDepth-ElementKeyIntAny": {
+			steps: path.ExpressionSteps{
+				path.ExpressionStepAttributeNameExact("test"),
+				path.ExpressionStepAttributeNameAny{},
+				path.ExpressionStepAnyDepth{},
+				path.ExpressionStepElementKeyIntAny{},
+			},
+			expected: `test.*.**[*]`,
+		},
 	}
 
 	for name, testCase := range testCases {
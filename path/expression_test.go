@@ -9,6 +9,38 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+func TestExpressionAtAnyDepth(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		expression path.Expression
+		expected   path.Expression
+	}{
+		"shallow": {
+			expression: path.MatchRoot("test"),
+			expected:   path.MatchRoot("test").AtAnyDepth(),
+		},
+		"deep": {
+			expression: path.MatchRoot("test1").AtListIndex(0).AtName("test2"),
+			expected:   path.MatchRoot("test1").AtListIndex(0).AtName("test2").AtAnyDepth(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.expression.AtAnyDepth()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestExpressionAtAnyListIndex(t *testing.T) {
 	t.Parallel()
 
@@ -73,6 +105,38 @@ func TestExpressionAtAnyMapKey(t *testing.T) {
 	}
 }
 
+func TestExpressionAtAnyName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		expression path.Expression
+		expected   path.Expression
+	}{
+		"shallow": {
+			expression: path.MatchRoot("test"),
+			expected:   path.MatchRoot("test").AtAnyName(),
+		},
+		"deep": {
+			expression: path.MatchRoot("test1").AtListIndex(0).AtName("test2"),
+			expected:   path.MatchRoot("test1").AtListIndex(0).AtName("test2").AtAnyName(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.expression.AtAnyName()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestExpressionAtAnySetValue(t *testing.T) {
 	t.Parallel()
 
@@ -944,6 +1008,18 @@ func TestExpressionString(t *testing.T) {
 			expression: path.MatchRoot("test"),
 			expected:   `test`,
 		},
+		"relative-AttributeNameExact": {
+			expression: path.MatchRelative().AtName("test"),
+			expected:   `.test`,
+		},
+		"relative-AnyDepth-AttributeNameExact": {
+			expression: path.MatchRelative().AtAnyDepth().AtName("test"),
+			expected:   `.**.test`,
+		},
+		"relative-Parent-AttributeNameExact": {
+			expression: path.MatchRelative().AtParent().AtName("test"),
+			expected:   `<.test`,
+		},
 		"AttributeNameExact-AttributeNameExact": {
 			expression: path.MatchRoot("test1").AtName("test2"),
 			expected:   `test1.test2`,
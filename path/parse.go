@@ -0,0 +1,316 @@
+package path
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseError is returned by ParsePath and ParseExpression when the input is
+// not a valid path or path expression.
+type ParseError struct {
+	// Input is the string which was being parsed.
+	Input string
+
+	// Offset is the byte offset in Input where the error was detected.
+	Offset int
+
+	// Message describes the error.
+	Message string
+
+	// expression is true if Input was being parsed as an expression.
+	expression bool
+}
+
+// Error returns a human-readable description of the error, including the
+// input and offset.
+func (e *ParseError) Error() string {
+	kind := "path"
+
+	if e.expression {
+		kind = "path expression"
+	}
+
+	return fmt.Sprintf("invalid %s %q at offset %d: %s", kind, e.Input, e.Offset, e.Message)
+}
+
+// ParsePath parses a Path from the syntax returned by Path.String(), such as
+// `parent[0].child["key"]`. Attribute names may only contain letters, digits,
+// underscores, and hyphens. Set value steps are not supported, as their
+// values cannot be typed from the string alone. An empty string returns
+// Empty().
+//
+// For any Path consisting of supported steps, ParsePath(p.String()) returns
+// a Path equal to p. Parse errors are returned as *ParseError.
+func ParsePath(s string) (Path, error) {
+	p := &parser{input: s}
+	path := Empty()
+
+	for stepIndex := 0; p.pos < len(s); stepIndex++ {
+		start := p.pos
+
+		step, err := p.step(stepIndex)
+
+		if err != nil {
+			return Empty(), err
+		}
+
+		switch step := step.(type) {
+		case ExpressionStepAttributeNameExact:
+			path = path.AtName(string(step))
+		case ExpressionStepElementKeyIntExact:
+			path = path.AtListIndex(int(step))
+		case ExpressionStepElementKeyStringExact:
+			path = path.AtMapKey(string(step))
+		default:
+			return Empty(), p.errorf(start, "%s steps are only allowed in path expressions", step)
+		}
+	}
+
+	return path, nil
+}
+
+// ParseExpression parses an Expression from the syntax returned by
+// Expression.String(), such as `parent[*].child`, `parent["*"]`, `<.sibling`,
+// `parent.*`, or `**.tags`, where `*` is any attribute name and `**` is any
+// depth. Attribute names may only contain letters, digits, underscores,
+// and hyphens. Set value steps are only supported as `[Value(*)]`, as exact
+// values cannot be typed from the string alone. An empty string returns
+// MatchRelative().
+//
+// Expressions starting with an attribute name step, including `*` and `**`,
+// are root expressions, as created by MatchRoot(), MatchRootAnyName(), and
+// MatchRootAnyDepth(). Other expressions, such as those starting with a
+// parent step, are relative expressions, as created by MatchRelative().
+// Relative expressions starting with an attribute name step are prefixed
+// with a period, such as `.child` or `.**.tags`.
+//
+// For any expression consisting of supported steps,
+// ParseExpression(e.String()) returns an Expression equal to e. A map key of
+// "*" is always parsed as any map key, as both are represented as `["*"]`.
+// Parse errors are returned as *ParseError.
+func ParseExpression(s string) (Expression, error) {
+	p := &parser{input: s, expression: true}
+	steps := ExpressionSteps{}
+
+	var relative bool
+
+	if strings.HasPrefix(s, ".") {
+		relative = true
+		p.pos++
+	}
+
+	for stepIndex := 0; p.pos < len(s); stepIndex++ {
+		step, err := p.step(stepIndex)
+
+		if err != nil {
+			return MatchRelative(), err
+		}
+
+		steps.Append(step)
+	}
+
+	if relative && (len(steps) == 0 || !isAttributeNameStep(steps[0])) {
+		return MatchRelative(), p.errorf(0, "'.' prefix is only allowed before an attribute name")
+	}
+
+	if len(steps) == 0 {
+		return MatchRelative(), nil
+	}
+
+	return Expression{
+		root:  !relative && isAttributeNameStep(steps[0]),
+		steps: steps,
+	}, nil
+}
+
+// parser holds the state of parsing a path or path expression string.
+type parser struct {
+	// input is the string being parsed.
+	input string
+
+	// pos is the byte offset of the next character to parse.
+	pos int
+
+	// expression is true if input is being parsed as an expression, which
+	// allows any element steps and parent steps.
+	expression bool
+}
+
+// errorf returns a *ParseError at the given offset.
+func (p *parser) errorf(offset int, format string, args ...any) error {
+	return &ParseError{
+		Input:      p.input,
+		Offset:     offset,
+		Message:    fmt.Sprintf(format, args...),
+		expression: p.expression,
+	}
+}
+
+// step parses the next step, which is the stepIndex step of the input. Steps
+// after the first are prefixed with a period, unless they are element steps.
+func (p *parser) step(stepIndex int) (ExpressionStep, error) {
+	if p.input[p.pos] == '[' {
+		return p.elementStep()
+	}
+
+	if stepIndex > 0 {
+		if p.input[p.pos] != '.' {
+			return nil, p.errorf(p.pos, "expected '.' or '[', got %q", p.input[p.pos])
+		}
+
+		p.pos++
+	}
+
+	if p.pos < len(p.input) && p.input[p.pos] == '<' {
+		if !p.expression {
+			return nil, p.errorf(p.pos, "parent steps are only allowed in path expressions")
+		}
+
+		p.pos++
+
+		return ExpressionStepParent{}, nil
+	}
+
+	if p.pos < len(p.input) && p.input[p.pos] == '*' {
+		if !p.expression {
+			return nil, p.errorf(p.pos, "attribute name wildcards are only allowed in path expressions")
+		}
+
+		if strings.HasPrefix(p.input[p.pos:], "**") {
+			p.pos += 2
+
+			return ExpressionStepAnyDepth{}, nil
+		}
+
+		p.pos++
+
+		return ExpressionStepAttributeNameAny{}, nil
+	}
+
+	start := p.pos
+
+	for p.pos < len(p.input) && isNameCharacter(p.input[p.pos]) {
+		p.pos++
+	}
+
+	if p.pos == start {
+		if p.pos == len(p.input) {
+			return nil, p.errorf(p.pos, "expected attribute name, got end of input")
+		}
+
+		return nil, p.errorf(p.pos, "expected attribute name, got %q", p.input[p.pos])
+	}
+
+	return ExpressionStepAttributeNameExact(p.input[start:p.pos]), nil
+}
+
+// elementStep parses a bracketed element step.
+func (p *parser) elementStep() (ExpressionStep, error) {
+	start := p.pos
+
+	// skip the opening bracket
+	p.pos++
+
+	var step ExpressionStep
+
+	switch {
+	case p.pos == len(p.input):
+		return nil, p.errorf(p.pos, "expected element key, got end of input")
+	case p.input[p.pos] == '*':
+		if !p.expression {
+			return nil, p.errorf(start, "[*] steps are only allowed in path expressions")
+		}
+
+		p.pos++
+		step = ExpressionStepElementKeyIntAny{}
+	case p.input[p.pos] == '"':
+		key, err := p.quotedString()
+
+		if err != nil {
+			return nil, err
+		}
+
+		if key == "*" && p.expression {
+			step = ExpressionStepElementKeyStringAny{}
+		} else {
+			step = ExpressionStepElementKeyStringExact(key)
+		}
+	case p.input[p.pos] == '-' || isDigit(p.input[p.pos]):
+		digitsStart := p.pos
+		p.pos++
+
+		for p.pos < len(p.input) && isDigit(p.input[p.pos]) {
+			p.pos++
+		}
+
+		index, err := strconv.ParseInt(p.input[digitsStart:p.pos], 10, 64)
+
+		if err != nil {
+			return nil, p.errorf(digitsStart, "invalid list index %q", p.input[digitsStart:p.pos])
+		}
+
+		step = ExpressionStepElementKeyIntExact(index)
+	case strings.HasPrefix(p.input[p.pos:], "Value("):
+		if !p.expression || !strings.HasPrefix(p.input[p.pos:], "Value(*)") {
+			return nil, p.errorf(start, "set value steps cannot be parsed, only [Value(*)] is supported in path expressions")
+		}
+
+		p.pos += len("Value(*)")
+		step = ExpressionStepElementKeyValueAny{}
+	default:
+		return nil, p.errorf(p.pos, "expected list index, quoted map key, or wildcard, got %q", p.input[p.pos])
+	}
+
+	if p.pos == len(p.input) {
+		return nil, p.errorf(p.pos, "expected ']', got end of input")
+	}
+
+	if p.input[p.pos] != ']' {
+		return nil, p.errorf(p.pos, "expected ']', got %q", p.input[p.pos])
+	}
+
+	p.pos++
+
+	return step, nil
+}
+
+// quotedString parses a double quoted string, as formatted by the %q verb.
+func (p *parser) quotedString() (string, error) {
+	start := p.pos
+
+	// skip the opening quote
+	p.pos++
+
+	for p.pos < len(p.input) {
+		switch p.input[p.pos] {
+		case '\\':
+			p.pos += 2
+			continue
+		case '"':
+			p.pos++
+
+			value, err := strconv.Unquote(p.input[start:p.pos])
+
+			if err != nil {
+				return "", p.errorf(start, "invalid quoted map key %s", p.input[start:p.pos])
+			}
+
+			return value, nil
+		}
+
+		p.pos++
+	}
+
+	return "", p.errorf(start, "unterminated quoted map key")
+}
+
+// isDigit returns true for ASCII digits.
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// isNameCharacter returns true for characters allowed in attribute names.
+func isNameCharacter(c byte) bool {
+	return isDigit(c) || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c == '_' || c == '-'
+}
@@ -0,0 +1,379 @@
+package path_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestParsePath(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         string
+		expected      path.Path
+		expectedError string
+	}{
+		"empty": {
+			input:    "",
+			expected: path.Empty(),
+		},
+		"name": {
+			input:    "test",
+			expected: path.Root("test"),
+		},
+		"name-characters": {
+			input:    "Test_2-b",
+			expected: path.Root("Test_2-b"),
+		},
+		"names": {
+			input:    "test1.test2",
+			expected: path.Root("test1").AtName("test2"),
+		},
+		"list-index": {
+			input:    "test[1]",
+			expected: path.Root("test").AtListIndex(1),
+		},
+		"list-index-negative": {
+			input:    "test[-1]",
+			expected: path.Root("test").AtListIndex(-1),
+		},
+		"map-key": {
+			input:    `test["key"]`,
+			expected: path.Root("test").AtMapKey("key"),
+		},
+		"map-key-escaped": {
+			input:    `test["a\"b].c\\"]`,
+			expected: path.Root("test").AtMapKey(`a"b].c\`),
+		},
+		"map-key-asterisk": {
+			input:    `test["*"]`,
+			expected: path.Root("test").AtMapKey("*"),
+		},
+		"element-first": {
+			input:    `[0]["key"].test`,
+			expected: path.Empty().AtListIndex(0).AtMapKey("key").AtName("test"),
+		},
+		"deep": {
+			input:    `test1[0].test2["key"][2].test3`,
+			expected: path.Root("test1").AtListIndex(0).AtName("test2").AtMapKey("key").AtListIndex(2).AtName("test3"),
+		},
+		"error-leading-period": {
+			input:         ".test",
+			expectedError: `invalid path ".test" at offset 0: expected attribute name, got '.'`,
+		},
+		"error-trailing-period": {
+			input:         "test.",
+			expectedError: `invalid path "test." at offset 5: expected attribute name, got end of input`,
+		},
+		"error-missing-period": {
+			input:         "test[0]test",
+			expectedError: `invalid path "test[0]test" at offset 7: expected '.' or '[', got 't'`,
+		},
+		"error-name-character": {
+			input:         "test.a b",
+			expectedError: `invalid path "test.a b" at offset 6: expected '.' or '[', got ' '`,
+		},
+		"error-any-int": {
+			input:         "test[*]",
+			expectedError: `invalid path "test[*]" at offset 4: [*] steps are only allowed in path expressions`,
+		},
+		"error-parent": {
+			input:         "test.<",
+			expectedError: `invalid path "test.<" at offset 5: parent steps are only allowed in path expressions`,
+		},
+		"error-set-value": {
+			input:         `test[Value("a")]`,
+			expectedError: `invalid path "test[Value(\"a\")]" at offset 4: set value steps cannot be parsed, only [Value(*)] is supported in path expressions`,
+		},
+		"error-unterminated-bracket": {
+			input:         "test[0",
+			expectedError: `invalid path "test[0" at offset 6: expected ']', got end of input`,
+		},
+		"error-unterminated-quote": {
+			input:         `test["key]`,
+			expectedError: `invalid path "test[\"key]" at offset 5: unterminated quoted map key`,
+		},
+		"error-invalid-quote": {
+			input:         `test["\z"]`,
+			expectedError: `invalid path "test[\"\\z\"]" at offset 5: invalid quoted map key "\z"`,
+		},
+		"error-invalid-index": {
+			input:         "test[-]",
+			expectedError: `invalid path "test[-]" at offset 5: invalid list index "-"`,
+		},
+		"error-index-trailing": {
+			input:         "test[1a]",
+			expectedError: `invalid path "test[1a]" at offset 6: expected ']', got 'a'`,
+		},
+		"error-empty-brackets": {
+			input:         "test[]",
+			expectedError: `invalid path "test[]" at offset 5: expected list index, quoted map key, or wildcard, got ']'`,
+		},
+		"error-any-name": {
+			input:         "test.*",
+			expectedError: `invalid path "test.*" at offset 5: attribute name wildcards are only allowed in path expressions`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := path.ParsePath(testCase.input)
+
+			if err != nil {
+				if testCase.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if err.Error() != testCase.expectedError {
+					t.Fatalf("expected error %q, got %q", testCase.expectedError, err.Error())
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("expected error %q, got none", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if got.String() != testCase.input {
+				t.Errorf("expected round trip string %q, got %q", testCase.input, got.String())
+			}
+		})
+	}
+}
+
+func TestParsePath_parseError(t *testing.T) {
+	t.Parallel()
+
+	_, err := path.ParsePath("test[")
+
+	var parseErr *path.ParseError
+
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("expected *path.ParseError, got %T", err)
+	}
+
+	expected := &path.ParseError{
+		Input:   "test[",
+		Offset:  5,
+		Message: "expected element key, got end of input",
+	}
+
+	if diff := cmp.Diff(parseErr, expected, cmp.AllowUnexported(path.ParseError{})); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestParseExpression(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         string
+		expected      path.Expression
+		expectedError string
+	}{
+		"empty": {
+			input:    "",
+			expected: path.MatchRelative(),
+		},
+		"name": {
+			input:    "test",
+			expected: path.MatchRoot("test"),
+		},
+		"names": {
+			input:    "test1.test2",
+			expected: path.MatchRoot("test1").AtName("test2"),
+		},
+		"list-index": {
+			input:    "test[1]",
+			expected: path.MatchRoot("test").AtListIndex(1),
+		},
+		"any-list-index": {
+			input:    "test[*]",
+			expected: path.MatchRoot("test").AtAnyListIndex(),
+		},
+		"map-key": {
+			input:    `test["key"]`,
+			expected: path.MatchRoot("test").AtMapKey("key"),
+		},
+		"any-map-key": {
+			input:    `test["*"]`,
+			expected: path.MatchRoot("test").AtAnyMapKey(),
+		},
+		"any-set-value": {
+			input:    "test[Value(*)]",
+			expected: path.MatchRoot("test").AtAnySetValue(),
+		},
+		"parent": {
+			input:    "<.test",
+			expected: path.MatchRelative().AtParent().AtName("test"),
+		},
+		"parents": {
+			input:    "<.<.test[*]",
+			expected: path.MatchRelative().AtParent().AtParent().AtName("test").AtAnyListIndex(),
+		},
+		"parent-middle": {
+			input:    "test1.test2.<.test3",
+			expected: path.MatchRoot("test1").AtName("test2").AtParent().AtName("test3"),
+		},
+		"element-first": {
+			input:    "[*].test",
+			expected: path.MatchRelative().AtAnyListIndex().AtName("test"),
+		},
+		"deep": {
+			input:    `test1[*].test2["*"][Value(*)].test3`,
+			expected: path.MatchRoot("test1").AtAnyListIndex().AtName("test2").AtAnyMapKey().AtAnySetValue().AtName("test3"),
+		},
+		"parent-element": {
+			input:    "<[0]",
+			expected: path.MatchRelative().AtParent().AtListIndex(0),
+		},
+		"error-parent-trailing": {
+			input:         "test<",
+			expectedError: `invalid path expression "test<" at offset 4: expected '.' or '[', got '<'`,
+		},
+		"error-set-value": {
+			input:         `test[Value("a")]`,
+			expectedError: `invalid path expression "test[Value(\"a\")]" at offset 4: set value steps cannot be parsed, only [Value(*)] is supported in path expressions`,
+		},
+		"error-any-unterminated": {
+			input:         "test[*",
+			expectedError: `invalid path expression "test[*" at offset 6: expected ']', got end of input`,
+		},
+		"any-name": {
+			input:    "test.*",
+			expected: path.MatchRoot("test").AtAnyName(),
+		},
+		"any-name-root": {
+			input:    "*.test",
+			expected: path.MatchRootAnyName().AtName("test"),
+		},
+		"any-depth": {
+			input:    "test.**[*]",
+			expected: path.MatchRoot("test").AtAnyDepth().AtAnyListIndex(),
+		},
+		"any-depth-root": {
+			input:    "**.tags",
+			expected: path.MatchRootAnyDepth().AtName("tags"),
+		},
+		"relative-name": {
+			input:    ".test",
+			expected: path.MatchRelative().AtName("test"),
+		},
+		"relative-names": {
+			input:    ".test1.test2[*]",
+			expected: path.MatchRelative().AtName("test1").AtName("test2").AtAnyListIndex(),
+		},
+		"relative-any-name": {
+			input:    ".*.test",
+			expected: path.MatchRelative().AtAnyName().AtName("test"),
+		},
+		"relative-any-depth": {
+			input:    ".**.tags",
+			expected: path.MatchRelative().AtAnyDepth().AtName("tags"),
+		},
+		"error-relative-empty": {
+			input:         ".",
+			expectedError: `invalid path expression "." at offset 0: '.' prefix is only allowed before an attribute name`,
+		},
+		"error-relative-parent": {
+			input:         ".<.test",
+			expectedError: `invalid path expression ".<.test" at offset 0: '.' prefix is only allowed before an attribute name`,
+		},
+		"error-relative-element": {
+			input:         ".[0]",
+			expectedError: `invalid path expression ".[0]" at offset 0: '.' prefix is only allowed before an attribute name`,
+		},
+		"error-any-name-trailing": {
+			input:         "test.***",
+			expectedError: `invalid path expression "test.***" at offset 7: expected '.' or '[', got '*'`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := path.ParseExpression(testCase.input)
+
+			if err != nil {
+				if testCase.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if err.Error() != testCase.expectedError {
+					t.Fatalf("expected error %q, got %q", testCase.expectedError, err.Error())
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("expected error %q, got none", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if got.String() != testCase.input {
+				t.Errorf("expected round trip string %q, got %q", testCase.input, got.String())
+			}
+		})
+	}
+}
+
+func TestParseExpression_roundTrip(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]path.Expression{
+		"root":       path.MatchRoot("test"),
+		"list":       path.MatchRoot("test").AtListIndex(0).AtName("nested"),
+		"map":        path.MatchRoot("test").AtMapKey("a.b[c]\n").AtAnyMapKey(),
+		"any":        path.MatchRoot("test").AtAnyListIndex().AtAnySetValue().AtAnyMapKey(),
+		"relative":   path.MatchRelative().AtParent().AtParent().AtName("test"),
+		"from-path":  path.Root("test").AtListIndex(2).AtMapKey("key").Expression(),
+		"merged":     path.MatchRoot("test").AtName("nested").Merge(path.MatchRelative().AtParent().AtName("other")),
+		"resolved":   path.MatchRoot("test").AtName("nested").AtParent().AtName("other").Resolve(),
+		"empty-root": path.MatchRelative().AtAnyListIndex(),
+		"wildcards":  path.MatchRootAnyDepth().AtName("test").AtAnyName().AtAnyDepth(),
+
+		"relative-name":       path.MatchRelative().AtName("test"),
+		"relative-nested":     path.MatchRelative().AtName("test").AtListIndex(0).AtName("nested"),
+		"relative-any-name":   path.MatchRelative().AtAnyName(),
+		"relative-any-depth":  path.MatchRelative().AtAnyDepth().AtName("tags"),
+		"relative-map-key":    path.MatchRelative().AtMapKey("key").AtName("test"),
+		"relative-parent-mid": path.MatchRelative().AtName("test").AtParent().AtName("other"),
+	}
+
+	for name, expression := range testCases {
+		name, expression := name, expression
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := path.ParseExpression(expression.String())
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, expression); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package schema_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFromModel(t *testing.T) {
+	t.Parallel()
+
+	type nestedModel struct {
+		Value types.Int64 `tfsdk:"value,required"`
+	}
+
+	testCases := map[string]struct {
+		model         any
+		opts          []schema.ModelOption
+		expected      schema.Schema
+		expectedDiags diag.Diagnostics
+	}{
+		"attributes": {
+			model: &struct {
+				ID     types.String  `tfsdk:"id,optional"`
+				Name   string        `tfsdk:"name,required"`
+				Token  string        `tfsdk:"token,optional,sensitive"`
+				Tags   []string      `tfsdk:"tags,optional,set"`
+				Items  []nestedModel `tfsdk:"items,optional"`
+				Filter types.List    `tfsdk:"filter"`
+			}{},
+			opts: []schema.ModelOption{
+				schema.ModelDescription(path.Root("name"), "Name of the region."),
+				schema.ModelAttribute(path.Root("filter"), schema.ListAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+				}),
+			},
+			expected: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":    schema.StringAttribute{Optional: true},
+					"name":  schema.StringAttribute{Required: true, Description: "Name of the region."},
+					"token": schema.StringAttribute{Optional: true, Sensitive: true},
+					"tags":  schema.SetAttribute{ElementType: types.StringType, Optional: true},
+					"items": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"value": schema.Int64Attribute{Required: true},
+							},
+						},
+						Optional: true,
+					},
+					"filter": schema.ListAttribute{ElementType: types.StringType, Optional: true},
+				},
+			},
+		},
+		"computed": {
+			model: struct {
+				Name string `tfsdk:"name,computed"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Model",
+					"Unable to derive the schema from the model type. This is always an error in the provider. "+
+						"Please report the following to the provider developer:\n\n"+
+						"name: provider schema attributes cannot be computed",
+				),
+			},
+		},
+		"invalid": {
+			model: struct {
+				Name string `tfsdk:"name,required,set"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Model",
+					"Unable to derive the schema from the model type. This is always an error in the provider. "+
+						"Please report the following to the provider developer:\n\n"+
+						"name: set struct tag option requires a slice, got string",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := schema.FromModel(context.Background(), testCase.model, testCase.opts...)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSchemaValidateModel(t *testing.T) {
+	t.Parallel()
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":   schema.StringAttribute{Optional: true},
+			"tags": schema.SetAttribute{ElementType: types.StringType, Optional: true},
+		},
+	}
+
+	testCases := map[string]struct {
+		model    any
+		expected diag.Diagnostics
+	}{
+		"valid": {
+			model: &struct {
+				ID   string    `tfsdk:"id"`
+				Tags types.Set `tfsdk:"tags"`
+			}{},
+		},
+		"invalid": {
+			model: struct {
+				ID   bool   `tfsdk:"id"`
+				Name string `tfsdk:"name"`
+			}{},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Schema Model Mismatch",
+					"The schema and its Go model type do not agree. This is always an error in the provider. "+
+						"Please report the following to the provider developer:\n\n"+
+						"name: model field Name has no schema attribute",
+				),
+				diag.NewErrorDiagnostic(
+					"Schema Model Mismatch",
+					"The schema and its Go model type do not agree. This is always an error in the provider. "+
+						"Please report the following to the provider developer:\n\n"+
+						"id: model type bool is incompatible with schema type tftypes.String",
+				),
+				diag.NewErrorDiagnostic(
+					"Schema Model Mismatch",
+					"The schema and its Go model type do not agree. This is always an error in the provider. "+
+						"Please report the following to the provider developer:\n\n"+
+						"tags: schema attribute has no model field",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := s.ValidateModel(context.Background(), testCase.model)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
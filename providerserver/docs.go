@@ -0,0 +1,93 @@
+package providerserver
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/docgen"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwdiag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+// ProviderDocsOptions configures the ProviderDocs documentation generation.
+type ProviderDocsOptions struct {
+	// Templates optionally contains text/template page templates which
+	// override the default templates, using the file names:
+	//
+	//   - index.md.tmpl: provider page
+	//   - resources/<name>.md.tmpl: page of a single resource
+	//   - resources.md.tmpl: pages of all other resources
+	//   - data-sources/<name>.md.tmpl: page of a single data source
+	//   - data-sources.md.tmpl: pages of all other data sources
+	//
+	// Where <name> is the type name without the provider type name prefix.
+	// Templates can use the fields Kind, Name, ShortName, ProviderName,
+	// Description, DeprecationMessage, SchemaMarkdown, ExampleFile, and
+	// ImportFile, and the functions codefile, prefixlines, tffile, and
+	// trimspace. For example:
+	//
+	//	{{ .SchemaMarkdown }}
+	//	{{ tffile "resources/examplecloud_thing/advanced.tf" }}
+	Templates fs.FS
+
+	// Examples optionally contains example files, which are included in the
+	// default templates when they exist, using the file names:
+	//
+	//   - provider/provider.tf: provider example
+	//   - resources/<type name>/resource.tf: resource example
+	//   - resources/<type name>/import.sh: resource import example
+	//   - data-sources/<type name>/data-source.tf: data source example
+	Examples fs.FS
+}
+
+// ProviderDocs returns Terraform Registry-style Markdown documentation pages
+// generated from the provider, resource, and data source schemas of the given
+// Provider, keyed by file path relative to the documentation directory:
+// index.md, resources/<name>.md, and data-sources/<name>.md.
+//
+// Each page includes the schema description, deprecation notice, example
+// usage, and the argument reference of all attributes and blocks, grouped by
+// whether they are required, optional, or read-only, with nested attributes
+// and blocks documented in separate sections. Attributes and blocks include
+// the descriptions of their validators and plan modifiers.
+//
+// This is intended to be called by a program run with go generate, such as:
+//
+//	files, err := providerserver.ProviderDocs(ctx, provider.New(), providerserver.ProviderDocsOptions{
+//		Examples:  os.DirFS("examples"),
+//		Templates: os.DirFS("templates"),
+//	})
+//
+// Warning diagnostics are ignored while error diagnostics are returned as an
+// error.
+func ProviderDocs(ctx context.Context, p provider.Provider, opts ProviderDocsOptions) (map[string][]byte, error) {
+	server := fwserver.Server{
+		Provider: p,
+	}
+
+	resp := &fwserver.GetProviderSchemaResponse{}
+
+	server.GetProviderSchema(ctx, &fwserver.GetProviderSchemaRequest{}, resp)
+
+	if resp.Diagnostics.HasError() {
+		return nil, fmt.Errorf("unable to get provider schemas: %w", fwdiag.Error(resp.Diagnostics))
+	}
+
+	metadataResp := &provider.MetadataResponse{}
+
+	p.Metadata(ctx, provider.MetadataRequest{}, metadataResp)
+
+	schemas := docgen.Schemas{
+		ProviderName: metadataResp.TypeName,
+		Provider:     resp.Provider,
+		Resources:    resp.ResourceSchemas,
+		DataSources:  resp.DataSourceSchemas,
+	}
+
+	return docgen.Generate(ctx, schemas, docgen.Options{
+		Templates: opts.Templates,
+		Examples:  opts.Examples,
+	})
+}
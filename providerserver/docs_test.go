@@ -0,0 +1,109 @@
+package providerserver
+
+import (
+	"context"
+	"testing"
+	"testing/fstest"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func TestProviderDocs(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		provider      provider.Provider
+		opts          ProviderDocsOptions
+		expected      map[string]string
+		expectedError string
+	}{
+		"resource": {
+			provider: &testprovider.Provider{
+				MetadataMethod: func(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+					resp.TypeName = "examplecloud"
+				},
+				ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+					return []func() resource.Resource{
+						func() resource.Resource {
+							return &testprovider.Resource{
+								MetadataMethod: func(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+									resp.TypeName = req.ProviderTypeName + "_thing"
+								},
+								SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+									resp.Schema = schema.Schema{
+										Attributes: map[string]schema.Attribute{
+											"id": schema.StringAttribute{
+												Computed:    true,
+												Description: "Identifier.",
+											},
+										},
+									}
+								},
+							}
+						},
+					}
+				},
+			},
+			opts: ProviderDocsOptions{
+				Templates: fstest.MapFS{
+					"index.md.tmpl":     {Data: []byte("# {{ .ProviderName }}\n")},
+					"resources.md.tmpl": {Data: []byte("# {{ .Name }}\n\n{{ .SchemaMarkdown }}")},
+				},
+			},
+			expected: map[string]string{
+				"index.md":           "# examplecloud\n",
+				"resources/thing.md": "# examplecloud_thing\n\n## Schema\n\n### Read-Only\n\n- `id` (String) Identifier.\n",
+			},
+		},
+		"error-diagnostics": {
+			provider: &testprovider.Provider{
+				SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+					resp.Diagnostics.Append(diag.NewErrorDiagnostic("Test Summary", "Test detail."))
+				},
+			},
+			expectedError: "unable to get provider schemas: Test Summary: Test detail.",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ProviderDocs(context.Background(), testCase.provider, testCase.opts)
+
+			if err != nil {
+				if testCase.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if diff := cmp.Diff(err.Error(), testCase.expectedError); diff != "" {
+					t.Fatalf("unexpected error difference: %s", diff)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("expected error: %s", testCase.expectedError)
+			}
+
+			gotStrings := make(map[string]string, len(got))
+
+			for fileName, content := range got {
+				gotStrings[fileName] = string(content)
+			}
+
+			if diff := cmp.Diff(gotStrings, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
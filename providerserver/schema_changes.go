@@ -0,0 +1,86 @@
+package providerserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadiff"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// ValidateSchemaChanges compares the schemas of the given Provider against
+// prior schemas in the `terraform providers schema -json` format, such as the
+// ProviderSchemasJSON output saved from a previous release. The address
+// selects the provider in the prior schemas and can be omitted when there is
+// only a single provider.
+//
+// Warning diagnostics are returned for removed resources and data sources and
+// for changes which can break existing configurations, such as removed
+// attributes, type changes, nesting mode changes, or attributes becoming
+// required. Error diagnostics are returned for resources with changes which
+// affect saved state when the resource schema Version was not increased or
+// the resource UpgradeState method does not implement a StateUpgrader for
+// the prior version.
+//
+// This is intended for provider unit testing, such as:
+//
+//	diags := providerserver.ValidateSchemaChanges(ctx, New(), priorJSON, "")
+//
+//	if diags.HasError() {
+//		t.Fatalf("unexpected schema changes: %v", diags)
+//	}
+func ValidateSchemaChanges(ctx context.Context, p provider.Provider, priorJSON []byte, address string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	prior, err := fwschemadiff.ParseProviderSchemas(priorJSON, address)
+
+	if err != nil {
+		diags.AddError(
+			"Invalid Prior Provider Schemas",
+			"The prior provider schemas could not be read: "+err.Error(),
+		)
+
+		return diags
+	}
+
+	server := fwserver.Server{
+		Provider: p,
+	}
+
+	resp := &fwserver.GetProviderSchemaResponse{}
+
+	server.GetProviderSchema(ctx, &fwserver.GetProviderSchemaRequest{}, resp)
+
+	diags.Append(resp.Diagnostics...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	current := fwschemadiff.ProviderSchemas{
+		Provider:               resp.Provider,
+		ResourceSchemas:        resp.ResourceSchemas,
+		DataSourceSchemas:      resp.DataSourceSchemas,
+		ResourceStateUpgraders: make(map[string]map[int64]resource.StateUpgrader, len(resp.ResourceSchemas)),
+	}
+
+	for typeName := range resp.ResourceSchemas {
+		r, resourceDiags := server.Resource(ctx, typeName)
+
+		diags.Append(resourceDiags...)
+
+		if diags.HasError() {
+			return diags
+		}
+
+		if resourceWithUpgradeState, ok := r.(resource.ResourceWithUpgradeState); ok {
+			current.ResourceStateUpgraders[typeName] = resourceWithUpgradeState.UpgradeState(ctx)
+		}
+	}
+
+	diags.Append(fwschemadiff.Providers(ctx, prior, current).Diagnostics()...)
+
+	return diags
+}
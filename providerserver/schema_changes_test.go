@@ -0,0 +1,192 @@
+package providerserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func TestValidateSchemaChanges(t *testing.T) {
+	t.Parallel()
+
+	priorJSON := `{"format_version":"1.0","provider_schemas":{"registry.terraform.io/examplecorp/examplecloud":{` +
+		`"provider":{"version":0,"block":{"description_kind":"plain"}},` +
+		`"resource_schemas":{"examplecloud_thing":{"version":0,"block":{"attributes":{` +
+		`"id":{"type":"string","description_kind":"plain","computed":true},` +
+		`"name":{"type":"string","description_kind":"plain","optional":true}` +
+		`},"description_kind":"plain"}}}}}}`
+
+	testProvider := func(s schema.Schema, upgraders map[int64]resource.StateUpgrader) provider.Provider {
+		return &testprovider.Provider{
+			MetadataMethod: func(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+				resp.TypeName = "examplecloud"
+			},
+			ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+				return []func() resource.Resource{
+					func() resource.Resource {
+						r := &testprovider.Resource{
+							MetadataMethod: func(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+								resp.TypeName = req.ProviderTypeName + "_thing"
+							},
+							SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+								resp.Schema = s
+							},
+						}
+
+						if upgraders == nil {
+							return r
+						}
+
+						return &testprovider.ResourceWithUpgradeState{
+							Resource: r,
+							UpgradeStateMethod: func(_ context.Context) map[int64]resource.StateUpgrader {
+								return upgraders
+							},
+						}
+					},
+				}
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		provider  provider.Provider
+		priorJSON string
+		expected  diag.Diagnostics
+	}{
+		"invalid-prior-json": {
+			provider:  testProvider(schema.Schema{}, nil),
+			priorJSON: `{`,
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Prior Provider Schemas",
+					"The prior provider schemas could not be read: unable to parse provider schemas: unexpected end of JSON input",
+				),
+			},
+		},
+		"no-changes": {
+			provider: testProvider(schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed: true,
+					},
+					"name": schema.StringAttribute{
+						Description: "Descriptions are not compared.",
+						Optional:    true,
+					},
+				},
+			}, nil),
+			priorJSON: priorJSON,
+			expected:  nil,
+		},
+		"attribute-removed": {
+			provider: testProvider(schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed: true,
+					},
+				},
+			}, nil),
+			priorJSON: priorJSON,
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"Breaking Resource Schema Changes",
+					"The examplecloud_thing resource schema has changes which can break existing configurations or state:\n\n"+
+						"- name: attribute removed",
+				),
+			},
+		},
+		"missing-version-increment": {
+			provider: testProvider(schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed: true,
+					},
+					"name": schema.Int64Attribute{
+						Optional: true,
+					},
+				},
+			}, nil),
+			priorJSON: priorJSON,
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"Breaking Resource Schema Changes",
+					"The examplecloud_thing resource schema has changes which can break existing configurations or state:\n\n"+
+						"- name: type changed (tftypes.String to tftypes.Number)",
+				),
+				diag.NewErrorDiagnostic(
+					"Missing Resource Schema Version Increment",
+					"The examplecloud_thing resource schema has changes which affect saved state, however the schema version was not increased from 0. "+
+						"Increase the resource schema Version and implement a StateUpgrader for version 0 in the resource UpgradeState method.\n\n"+
+						"Changes:\n\n"+
+						"- name: type changed (tftypes.String to tftypes.Number)",
+				),
+			},
+		},
+		"missing-state-upgrader": {
+			provider: testProvider(schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed: true,
+					},
+				},
+				Version: 1,
+			}, nil),
+			priorJSON: priorJSON,
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"Breaking Resource Schema Changes",
+					"The examplecloud_thing resource schema has changes which can break existing configurations or state:\n\n"+
+						"- name: attribute removed",
+				),
+				diag.NewErrorDiagnostic(
+					"Missing Resource State Upgrader",
+					"The examplecloud_thing resource schema version was increased from 0 to 1, "+
+						"however the resource UpgradeState method does not implement a StateUpgrader for version 0. "+
+						"Terraform will return an error when upgrading saved state from version 0.",
+				),
+			},
+		},
+		"state-upgrader": {
+			provider: testProvider(schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed: true,
+					},
+				},
+				Version: 1,
+			}, map[int64]resource.StateUpgrader{
+				0: {},
+			}),
+			priorJSON: priorJSON,
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"Breaking Resource Schema Changes",
+					"The examplecloud_thing resource schema has changes which can break existing configurations or state:\n\n"+
+						"- name: attribute removed",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := ValidateSchemaChanges(context.Background(), testCase.provider, []byte(testCase.priorJSON), "")
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package providerserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwdiag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/tfjson"
+	"github.com/hashicorp/terraform-plugin-framework/internal/totfjson"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+// ProviderSchemasJSON returns the provider, resource, and data source schemas
+// of the given Provider in the same JSON format as the
+// `terraform providers schema -json` command output, without requiring
+// Terraform. The address is the provider source address used as the schemas
+// key, such as registry.terraform.io/examplecorp/examplecloud.
+//
+// This is intended for testing, such as comparing schemas against golden
+// files. Warning diagnostics are ignored while error diagnostics are returned
+// as an error.
+//
+// Block min_items and max_items are never included. The framework does not
+// support block size constraints in schemas, which are implemented with
+// validators instead, so they are also absent from the Terraform output.
+func ProviderSchemasJSON(ctx context.Context, p provider.Provider, address string) ([]byte, error) {
+	server := fwserver.Server{
+		Provider: p,
+	}
+
+	resp := &fwserver.GetProviderSchemaResponse{}
+
+	server.GetProviderSchema(ctx, &fwserver.GetProviderSchemaRequest{}, resp)
+
+	if resp.Diagnostics.HasError() {
+		return nil, fmt.Errorf("unable to get provider schemas: %w", fwdiag.Error(resp.Diagnostics))
+	}
+
+	providerSchema, err := totfjson.GetProviderSchemaResponse(ctx, resp)
+
+	if err != nil {
+		return nil, err
+	}
+
+	schemas := tfjson.ProviderSchemas{
+		FormatVersion: tfjson.ProviderSchemasFormatVersion,
+		Schemas: map[string]*tfjson.ProviderSchema{
+			address: providerSchema,
+		},
+	}
+
+	return json.Marshal(schemas)
+}
@@ -0,0 +1,158 @@
+package providerserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestProviderSchemasJSON(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		provider      provider.Provider
+		expected      string
+		expectedError string
+	}{
+		"empty": {
+			provider: &testprovider.Provider{},
+			expected: `{"format_version":"1.0","provider_schemas":{"registry.terraform.io/examplecorp/examplecloud":{"provider":{"version":0,"block":{"description_kind":"plain"}}}}}`,
+		},
+		"schemas": {
+			provider: &testprovider.Provider{
+				MetadataMethod: func(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+					resp.TypeName = "examplecloud"
+				},
+				SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+					resp.Schema = providerschema.Schema{
+						Attributes: map[string]providerschema.Attribute{
+							"token": providerschema.StringAttribute{
+								Optional:  true,
+								Sensitive: true,
+							},
+						},
+						MarkdownDescription: "The *examplecloud* provider.",
+					}
+				},
+				DataSourcesMethod: func(_ context.Context) []func() datasource.DataSource {
+					return []func() datasource.DataSource{
+						func() datasource.DataSource {
+							return &testprovider.DataSource{
+								MetadataMethod: func(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+									resp.TypeName = req.ProviderTypeName + "_thing"
+								},
+								SchemaMethod: func(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+									resp.Schema = datasourceschema.Schema{
+										Attributes: map[string]datasourceschema.Attribute{
+											"items": datasourceschema.ListNestedAttribute{
+												NestedObject: datasourceschema.NestedAttributeObject{
+													Attributes: map[string]datasourceschema.Attribute{
+														"name": datasourceschema.StringAttribute{
+															Computed:    true,
+															Description: "Item name.",
+														},
+													},
+												},
+												Computed: true,
+											},
+										},
+									}
+								},
+							}
+						},
+					}
+				},
+				ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+					return []func() resource.Resource{
+						func() resource.Resource {
+							return &testprovider.Resource{
+								MetadataMethod: func(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+									resp.TypeName = req.ProviderTypeName + "_thing"
+								},
+								SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+									resp.Schema = resourceschema.Schema{
+										Attributes: map[string]resourceschema.Attribute{
+											"id": resourceschema.StringAttribute{
+												Computed: true,
+											},
+											"tags": resourceschema.MapAttribute{
+												ElementType:        types.StringType,
+												Optional:           true,
+												DeprecationMessage: "Use labels instead.",
+											},
+										},
+										Blocks: map[string]resourceschema.Block{
+											"rule": resourceschema.SetNestedBlock{
+												NestedObject: resourceschema.NestedBlockObject{
+													Attributes: map[string]resourceschema.Attribute{
+														"port": resourceschema.Int64Attribute{
+															Required: true,
+														},
+													},
+												},
+											},
+										},
+										Version: 2,
+									}
+								},
+							}
+						},
+					}
+				},
+			},
+			expected: `{"format_version":"1.0","provider_schemas":{"registry.terraform.io/examplecorp/examplecloud":{` +
+				`"provider":{"version":0,"block":{"attributes":{"token":{"type":"string","description_kind":"plain","optional":true,"sensitive":true}},"description":"The *examplecloud* provider.","description_kind":"markdown"}},` +
+				`"resource_schemas":{"examplecloud_thing":{"version":2,"block":{"attributes":{"id":{"type":"string","description_kind":"plain","computed":true},"tags":{"type":["map","string"],"description_kind":"plain","deprecated":true,"optional":true}},"block_types":{"rule":{"nesting_mode":"set","block":{"attributes":{"port":{"type":"number","description_kind":"plain","required":true}},"description_kind":"plain"}}},"description_kind":"plain"}}},` +
+				`"data_source_schemas":{"examplecloud_thing":{"version":0,"block":{"attributes":{"items":{"nested_type":{"attributes":{"name":{"type":"string","description":"Item name.","description_kind":"plain","computed":true}},"nesting_mode":"list"},"description_kind":"plain","computed":true}},"description_kind":"plain"}}}` +
+				`}}}`,
+		},
+		"error-diagnostics": {
+			provider: &testprovider.Provider{
+				SchemaMethod: func(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+					resp.Diagnostics.AddError("Example Summary", "Example detail.")
+				},
+			},
+			expectedError: "unable to get provider schemas: Example Summary: Example detail.",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ProviderSchemasJSON(context.Background(), testCase.provider, "registry.terraform.io/examplecorp/examplecloud")
+
+			if err != nil {
+				if testCase.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if err.Error() != testCase.expectedError {
+					t.Fatalf("expected error %q, got %q", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("expected error %q, got none", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(string(got), testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package providerserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+// ValidateResourceStateShape compares the state shape saved in resource
+// private state against the current schema of the given resource type, which
+// must enable the resource/schema.Schema type DetectStateShapeChanges field.
+// The private state is the JSON data saved in the Terraform state, such as
+// the base64 decoded "private" value of a resource instance in
+// `terraform state pull` output from a previous release.
+//
+// Error diagnostics are returned when the schema has changes which affect
+// saved state without a schema Version increase, or the Version was increased
+// without a StateUpgrader for the saved version. During Terraform operations,
+// the framework returns these as warning diagnostics instead.
+//
+// This is intended for provider unit testing, such as:
+//
+//	diags := providerserver.ValidateResourceStateShape(ctx, New(), "examplecloud_thing", private)
+//
+//	if diags.HasError() {
+//		t.Fatalf("unexpected state shape changes: %v", diags)
+//	}
+func ValidateResourceStateShape(ctx context.Context, p provider.Provider, typeName string, private []byte) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	server := fwserver.Server{
+		Provider: p,
+	}
+
+	// The provider metadata is required for resource type names.
+	schemaResp := &fwserver.GetProviderSchemaResponse{}
+
+	server.GetProviderSchema(ctx, &fwserver.GetProviderSchemaRequest{}, schemaResp)
+
+	diags.Append(schemaResp.Diagnostics...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	r, resourceDiags := server.Resource(ctx, typeName)
+
+	diags.Append(resourceDiags...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	resourceSchema, schemaDiags := server.ResourceSchema(ctx, typeName)
+
+	diags.Append(schemaDiags...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	privateData, privateDiags := privatestate.NewData(ctx, private)
+
+	diags.Append(privateDiags...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(fwserver.StateShapeDiagnostics(ctx, r, resourceSchema, privateData, diag.SeverityError)...)
+
+	return diags
+}
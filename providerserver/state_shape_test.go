@@ -0,0 +1,213 @@
+package providerserver
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadiff"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func TestValidateResourceStateShape(t *testing.T) {
+	t.Parallel()
+
+	priorSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+		DetectStateShapeChanges: true,
+	}
+
+	stateShape, err := json.Marshal(map[string]any{
+		"version": 0,
+		"shapes":  fwschemadiff.StateShape(context.Background(), priorSchema),
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	private := privatestate.MustMarshalToJson(map[string][]byte{
+		".state_shape": stateShape,
+		"providerKey":  []byte(`{"key": "value"}`),
+	})
+
+	testProvider := func(s schema.Schema, upgraders map[int64]resource.StateUpgrader) provider.Provider {
+		return &testprovider.Provider{
+			MetadataMethod: func(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+				resp.TypeName = "examplecloud"
+			},
+			ResourcesMethod: func(_ context.Context) []func() resource.Resource {
+				return []func() resource.Resource{
+					func() resource.Resource {
+						r := &testprovider.Resource{
+							MetadataMethod: func(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+								resp.TypeName = req.ProviderTypeName + "_thing"
+							},
+							SchemaMethod: func(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+								resp.Schema = s
+							},
+						}
+
+						if upgraders == nil {
+							return r
+						}
+
+						return &testprovider.ResourceWithUpgradeState{
+							Resource: r,
+							UpgradeStateMethod: func(_ context.Context) map[int64]resource.StateUpgrader {
+								return upgraders
+							},
+						}
+					},
+				}
+			},
+		}
+	}
+
+	schemaV0TypeChanged := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"name": schema.Int64Attribute{
+				Optional: true,
+			},
+		},
+		DetectStateShapeChanges: true,
+	}
+
+	schemaV1TypeChanged := schema.Schema{
+		Attributes:              schemaV0TypeChanged.Attributes,
+		DetectStateShapeChanges: true,
+		Version:                 1,
+	}
+
+	testCases := map[string]struct {
+		provider provider.Provider
+		private  []byte
+		expected diag.Diagnostics
+	}{
+		"no-private": {
+			provider: testProvider(schemaV0TypeChanged, nil),
+			private:  nil,
+			expected: nil,
+		},
+		"no-changes": {
+			provider: testProvider(priorSchema, nil),
+			private:  private,
+			expected: nil,
+		},
+		"attribute-added": {
+			provider: testProvider(schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed: true,
+					},
+					"name": schema.StringAttribute{
+						Optional: true,
+					},
+					"description": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+				DetectStateShapeChanges: true,
+			}, nil),
+			private:  private,
+			expected: nil,
+		},
+		"detection-disabled": {
+			provider: testProvider(schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed: true,
+					},
+				},
+			}, nil),
+			private:  private,
+			expected: nil,
+		},
+		"attribute-removed": {
+			provider: testProvider(schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed: true,
+					},
+				},
+				DetectStateShapeChanges: true,
+			}, nil),
+			private:  private,
+			expected: nil,
+		},
+		"missing-version-increment": {
+			provider: testProvider(schemaV0TypeChanged, nil),
+			private:  private,
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Resource Schema Changed Without Version Increment",
+					"The resource schema has changes which affect saved state, such as attribute type or nesting mode changes, "+
+						"however the schema version was not increased from 0. "+
+						"Existing resources may fail to read or upgrade their saved state.\n\n"+
+						"This is always an issue in the Terraform Provider and should be reported to the provider developers. "+
+						"Increase the resource schema Version and implement a StateUpgrader for version 0 in the resource UpgradeState method.",
+				),
+			},
+		},
+		"missing-state-upgrader": {
+			provider: testProvider(schemaV1TypeChanged, map[int64]resource.StateUpgrader{}),
+			private:  private,
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Missing Resource State Upgrader",
+					"The resource schema version was increased from 0 to 1 with changes which affect saved state, "+
+						"however the resource UpgradeState method does not implement a StateUpgrader for version 0.\n\n"+
+						"This is always an issue in the Terraform Provider and should be reported to the provider developers.",
+				),
+			},
+		},
+		"state-upgrader": {
+			provider: testProvider(schemaV1TypeChanged, map[int64]resource.StateUpgrader{
+				0: {},
+			}),
+			private:  private,
+			expected: nil,
+		},
+		"resource-not-found": {
+			provider: &testprovider.Provider{},
+			private:  private,
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Resource Type Not Found",
+					"No resource type named \"examplecloud_thing\" was found in the provider.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := ValidateResourceStateShape(context.Background(), testCase.provider, "examplecloud_thing", testCase.private)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,225 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// ImportStateIDSegmentType is the value type of an ImportStateIDSegment,
+// which determines how the segment string is converted before it is set in
+// the resource state.
+type ImportStateIDSegmentType uint8
+
+const (
+	// ImportStateIDSegmentTypeString sets the segment as a string value. This
+	// is the default type.
+	ImportStateIDSegmentTypeString ImportStateIDSegmentType = 0
+
+	// ImportStateIDSegmentTypeInt64 converts the segment to an int64 value,
+	// such as for Int64Attribute.
+	ImportStateIDSegmentTypeInt64 ImportStateIDSegmentType = 1
+
+	// ImportStateIDSegmentTypeBool converts the segment to a bool value, such
+	// as for BoolAttribute. Values accepted by strconv.ParseBool are valid,
+	// such as "true" and "false".
+	ImportStateIDSegmentTypeBool ImportStateIDSegmentType = 2
+)
+
+// String returns a human readable description of the type for diagnostics.
+func (t ImportStateIDSegmentType) String() string {
+	switch t {
+	case ImportStateIDSegmentTypeString:
+		return "string"
+	case ImportStateIDSegmentTypeInt64:
+		return "integer"
+	case ImportStateIDSegmentTypeBool:
+		return "boolean"
+	default:
+		return "unknown"
+	}
+}
+
+// ImportStateIDSegment is a segment of a composite import identifier, such as
+// the region of an identifier in the project/region/name format, which is set
+// in the resource state.
+type ImportStateIDSegment struct {
+	// Path is the state attribute path which is set to the segment value.
+	Path path.Path
+
+	// Name is the name of the segment in the expected import identifier
+	// format of error diagnostics. If empty, the last attribute name of Path
+	// is used.
+	Name string
+
+	// Type is the value type of the segment. If unset, the segment is set
+	// as a string value.
+	Type ImportStateIDSegmentType
+}
+
+// name returns the segment name for diagnostics.
+func (s ImportStateIDSegment) name() string {
+	if s.Name != "" {
+		return s.Name
+	}
+
+	lastStep, _ := s.Path.Steps().LastStep()
+
+	if attributeName, ok := lastStep.(path.PathStepAttributeName); ok {
+		return string(attributeName)
+	}
+
+	return s.Path.String()
+}
+
+// ImportStateCompositeID is a helper function to set state attribute values
+// from an import identifier which contains multiple segments joined by the
+// given separator, such as project/region/name. Each segment of the
+// identifier is set to the path of the segment at the same position.
+//
+// An error diagnostic with the expected format is returned if the identifier
+// does not contain exactly one non-empty value per segment, or a value cannot
+// be converted to the segment Type.
+func ImportStateCompositeID(ctx context.Context, separator string, segments []ImportStateIDSegment, req ImportStateRequest, resp *ImportStateResponse) {
+	if separator == "" || !validImportStateIDSegments(segments) {
+		resp.Diagnostics.AddError(
+			"Resource Import Composite ID Invalid Arguments",
+			"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Resource ImportState method call to ImportStateCompositeID must set a non-empty separator and at least one segment with a valid attribute path.",
+		)
+
+		return
+	}
+
+	names := make([]string, 0, len(segments))
+
+	for _, segment := range segments {
+		names = append(names, segment.name())
+	}
+
+	format := strings.Join(names, separator)
+	values := strings.Split(req.ID, separator)
+
+	if len(values) != len(segments) {
+		addInvalidImportStateIDError(resp, format, req.ID)
+
+		return
+	}
+
+	for _, value := range values {
+		if value == "" {
+			addInvalidImportStateIDError(resp, format, req.ID)
+
+			return
+		}
+	}
+
+	setImportStateIDSegments(ctx, format, segments, values, req, resp)
+}
+
+// ImportStateCompositeIDPattern is a helper function to set state attribute
+// values from an import identifier which matches the given regular
+// expression. The pattern must match the entire identifier, even if it is not
+// anchored with ^ and $, and contain one capturing group per segment. Each
+// captured value is set to the path of the segment at the same position.
+//
+// An error diagnostic with the expected pattern is returned if the identifier
+// does not match the pattern, or a value cannot be converted to the segment
+// Type.
+func ImportStateCompositeIDPattern(ctx context.Context, pattern *regexp.Regexp, segments []ImportStateIDSegment, req ImportStateRequest, resp *ImportStateResponse) {
+	if pattern == nil || pattern.NumSubexp() != len(segments) || !validImportStateIDSegments(segments) {
+		resp.Diagnostics.AddError(
+			"Resource Import Composite ID Invalid Arguments",
+			"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Resource ImportState method call to ImportStateCompositeIDPattern must set a pattern with one capturing group per segment and at least one segment with a valid attribute path.",
+		)
+
+		return
+	}
+
+	format := pattern.String()
+
+	// Anchor the pattern so the entire identifier must match. Comparing the
+	// leftmost-first match with the identifier would reject identifiers
+	// which only match a later alternative, such as "ab" with "(a|ab)".
+	anchoredPattern, err := regexp.Compile(`\A(?:` + format + `)\z`)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Resource Import Composite ID Invalid Arguments",
+			"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Resource ImportState method call to ImportStateCompositeIDPattern must set a pattern which can be anchored: "+err.Error(),
+		)
+
+		return
+	}
+
+	matches := anchoredPattern.FindStringSubmatch(req.ID)
+
+	if matches == nil {
+		addInvalidImportStateIDError(resp, format, req.ID)
+
+		return
+	}
+
+	setImportStateIDSegments(ctx, format, segments, matches[1:], req, resp)
+}
+
+// addInvalidImportStateIDError adds the practitioner facing error diagnostic
+// for an import identifier which does not match the expected format.
+func addInvalidImportStateIDError(resp *ImportStateResponse, format string, id string) {
+	resp.Diagnostics.AddError(
+		"Invalid Import Identifier",
+		fmt.Sprintf("The import identifier must have the format: %s\n\n", format)+
+			fmt.Sprintf("Got: %q", id),
+	)
+}
+
+// setImportStateIDSegments converts and sets each segment value in the
+// response state.
+func setImportStateIDSegments(ctx context.Context, format string, segments []ImportStateIDSegment, values []string, req ImportStateRequest, resp *ImportStateResponse) {
+	for index, segment := range segments {
+		var value any = values[index]
+		var err error
+
+		switch segment.Type {
+		case ImportStateIDSegmentTypeInt64:
+			value, err = strconv.ParseInt(values[index], 10, 64)
+		case ImportStateIDSegmentTypeBool:
+			value, err = strconv.ParseBool(values[index])
+		}
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid Import Identifier",
+				fmt.Sprintf("The %s segment of the import identifier must be a valid %s, got: %q\n\n", segment.name(), segment.Type, values[index])+
+					fmt.Sprintf("The import identifier must have the format: %s\n\n", format)+
+					fmt.Sprintf("Got: %q", req.ID),
+			)
+
+			continue
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, segment.Path, value)...)
+	}
+}
+
+// validImportStateIDSegments returns true if there is at least one segment
+// and all segments have a non-empty path.
+func validImportStateIDSegments(segments []ImportStateIDSegment) bool {
+	if len(segments) == 0 {
+		return false
+	}
+
+	for _, segment := range segments {
+		if segment.Path.Equal(path.Empty()) {
+			return false
+		}
+	}
+
+	return true
+}
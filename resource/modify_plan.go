@@ -34,7 +34,8 @@ type ModifyPlanRequest struct {
 	// ModifyPlanResponse.Private to prevent accidental private state data loss.
 	//
 	// Use the GetKey method to read data. Use the SetKey method on
-	// ModifyPlanResponse.Private to update or remove a value.
+	// ModifyPlanResponse.Private to update or remove a value. The resource/private
+	// package Key type provides typed access to values.
 	Private *privatestate.ProviderData
 }
 
@@ -0,0 +1,31 @@
+package private
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+)
+
+// Data must be implemented by the private state data of resource requests
+// and responses.
+var _ Data = &privatestate.ProviderData{}
+
+// Data is the provider-defined private state data of a resource, such as the
+// Private field of the resource.ReadRequest and resource.ReadResponse types.
+// Keys are strings which must not begin with a period ('.'), which is
+// reserved for framework usage. Values are JSON encoded bytes.
+type Data interface {
+	// DeleteKey removes the value associated with the given key.
+	DeleteKey(ctx context.Context, key string) diag.Diagnostics
+
+	// GetKey returns the value associated with the given key, or nil if the
+	// key is not found.
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+
+	// Keys returns the sorted keys which have values.
+	Keys(ctx context.Context) []string
+
+	// SetKey sets the value associated with the given key.
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
@@ -0,0 +1,24 @@
+// Package private contains typed accessors for resource private state data,
+// which is provider-defined data that is saved with the resource in the
+// Terraform state, but is not shown to practitioners.
+//
+// The main starting point for implementations in this package is the Key
+// type, which encodes and decodes a Go type as the JSON value of a private
+// state key. Declaring each Key once, such as a package variable, prevents
+// mistyped key names and value types across the resource operations:
+//
+//	var settingsKey = private.Key[settings]{
+//		Name: "settings",
+//	}
+//
+//	func (r *thingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+//		value, diags := settingsKey.Get(ctx, req.Private)
+//		// ...
+//	}
+//
+// Keys can be grouped with a Namespace, which enables listing and removing
+// all keys of the namespace. Keys can also define a Version with Migrations
+// for upgrading values saved by prior versions of the provider. Resources can
+// implement resource.ResourceWithPrivateStateMigrations to have the framework
+// migrate and save the values of keys during ReadResource.
+package private
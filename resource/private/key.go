@@ -0,0 +1,257 @@
+package private
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// Migration upgrades the JSON encoded value of a private state key from a
+// prior version to the current Key Version.
+//
+// Terraform does not send private state data with UpgradeResourceState
+// requests, so migrations cannot run when the resource state is upgraded.
+// Instead, migrations run each time a value with a prior version is read with
+// Key.Get. Values are only saved with the current version when Key.Set or
+// Key.Migrate is called, such as by the framework during ReadResource for the
+// keys returned by a resource implementing
+// resource.ResourceWithPrivateStateMigrations. Otherwise, values keep their
+// prior version until the provider saves them.
+//
+// The context.Context parameter contains framework-defined loggers and
+// supports request cancellation.
+type Migration func(ctx context.Context, value []byte) ([]byte, diag.Diagnostics)
+
+// Key is a private state key with a value of type T, which is encoded and
+// decoded with the encoding/json package.
+type Key[T any] struct {
+	// Name is the private state key. It must not begin with a period ('.'),
+	// which is reserved for framework usage. Use Namespace.KeyName to create
+	// a key within a namespace.
+	//
+	// The naming of keys only matters in context of a single resource,
+	// however care should be taken that any historical keys are not reused
+	// without accounting for older resource instances that may still have
+	// older data at the key.
+	Name string
+
+	// Version is the current version of the value type. If greater than
+	// zero, values are saved with their version, so values saved with a prior
+	// version are upgraded by Migrations when read. Values saved without a
+	// version, such as before Version was set, are version zero.
+	//
+	// Versions are conventionally only incremented by one when the value
+	// type changes in a manner that is incompatible with prior values.
+	Version int64
+
+	// Migrations is a mapping of prior versions to the Migration which
+	// upgrades values of that version directly to the current Version. Read
+	// errors are returned for values with a prior version and no Migration.
+	//
+	// Migrations are run when values are read, rather than when the
+	// resource state is upgraded. Refer to the Migration type for details on
+	// saving migrated values.
+	Migrations map[int64]Migration
+}
+
+// Migrator is implemented by each Key, regardless of its value type, so keys
+// can be migrated together, such as by the framework during ReadResource.
+type Migrator interface {
+	// Migrate upgrades a value saved with a prior version and saves it with
+	// the current version.
+	Migrate(ctx context.Context, data Data) diag.Diagnostics
+}
+
+var _ Migrator = Key[any]{}
+
+// versionedValue is the JSON encoding of values when the Key Version is
+// greater than zero.
+type versionedValue struct {
+	Version int64           `json:"version"`
+	Value   json.RawMessage `json:"value"`
+}
+
+// Delete removes the value of the key from the private state data.
+func (k Key[T]) Delete(ctx context.Context, data Data) diag.Diagnostics {
+	if data == nil {
+		return nil
+	}
+
+	return data.DeleteKey(ctx, k.Name)
+}
+
+// Get returns the value of the key from the private state data, or nil if
+// the key is not found. Values saved with a prior Version are upgraded with
+// the Migrations before they are decoded.
+func (k Key[T]) Get(ctx context.Context, data Data) (*T, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if data == nil {
+		return nil, nil
+	}
+
+	value, getDiags := data.GetKey(ctx, k.Name)
+
+	diags.Append(getDiags...)
+
+	if diags.HasError() || len(value) == 0 {
+		return nil, diags
+	}
+
+	version, value := k.decodeVersion(value)
+
+	if version > k.Version {
+		diags.AddError(
+			"Error Decoding Private State",
+			fmt.Sprintf("The private state value for key %q has version %d, which is newer than the current version %d. ", k.Name, version, k.Version)+
+				"This can occur if a newer version of the provider saved the value.",
+		)
+
+		return nil, diags
+	}
+
+	if version < k.Version {
+		migration, ok := k.Migrations[version]
+
+		if !ok || migration == nil {
+			diags.AddError(
+				"Error Decoding Private State",
+				fmt.Sprintf("The private state value for key %q has version %d, however no Migration is defined for the version. ", k.Name, version)+
+					"This is always an issue with the provider and should be reported to the provider developers.",
+			)
+
+			return nil, diags
+		}
+
+		migratedValue, migrationDiags := migration(ctx, value)
+
+		diags.Append(migrationDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		value = migratedValue
+	}
+
+	var result T
+
+	if err := json.Unmarshal(value, &result); err != nil {
+		diags.AddError(
+			"Error Decoding Private State",
+			fmt.Sprintf("An error was encountered when decoding the private state value for key %q: %s\n\n", k.Name, err)+
+				"This is always an issue with the provider and should be reported to the provider developers.",
+		)
+
+		return nil, diags
+	}
+
+	return &result, diags
+}
+
+// Migrate upgrades the value of the key in the private state data with the
+// Migrations, if it was saved with a prior Version, and saves it with the
+// current Version. Missing values and values saved with the current Version
+// are unchanged.
+func (k Key[T]) Migrate(ctx context.Context, data Data) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data == nil || k.Version <= 0 {
+		return nil
+	}
+
+	value, getDiags := data.GetKey(ctx, k.Name)
+
+	diags.Append(getDiags...)
+
+	if diags.HasError() || len(value) == 0 {
+		return diags
+	}
+
+	if version, _ := k.decodeVersion(value); version == k.Version {
+		return diags
+	}
+
+	result, getDiags := k.Get(ctx, data)
+
+	diags.Append(getDiags...)
+
+	if diags.HasError() || result == nil {
+		return diags
+	}
+
+	diags.Append(k.Set(ctx, data, *result)...)
+
+	return diags
+}
+
+// Set saves the value of the key in the private state data, along with the
+// current Version if greater than zero.
+func (k Key[T]) Set(ctx context.Context, data Data, value T) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	encoded, err := json.Marshal(value)
+
+	if err == nil && k.Version > 0 {
+		encoded, err = json.Marshal(versionedValue{
+			Version: k.Version,
+			Value:   encoded,
+		})
+	}
+
+	if err != nil {
+		diags.AddError(
+			"Error Encoding Private State",
+			fmt.Sprintf("An error was encountered when encoding the private state value for key %q: %s\n\n", k.Name, err)+
+				"This is always an issue with the provider and should be reported to the provider developers.",
+		)
+
+		return diags
+	}
+
+	if data == nil {
+		diags.AddError(
+			"Uninitialized Private State",
+			fmt.Sprintf("The private state value for key %q could not be saved as the private state data is missing. ", k.Name)+
+				"This is always an issue with the provider and should be reported to the provider developers.",
+		)
+
+		return diags
+	}
+
+	return data.SetKey(ctx, k.Name, encoded)
+}
+
+// decodeVersion returns the version and value of a saved value. Values are
+// only decoded as versioned when the Key Version is greater than zero and
+// the value is a JSON object with only the version and value properties.
+// Otherwise, the value is returned as version zero.
+func (k Key[T]) decodeVersion(value []byte) (int64, []byte) {
+	if k.Version <= 0 {
+		return 0, value
+	}
+
+	var properties map[string]json.RawMessage
+
+	if err := json.Unmarshal(value, &properties); err != nil || len(properties) != 2 {
+		return 0, value
+	}
+
+	if _, ok := properties["version"]; !ok {
+		return 0, value
+	}
+
+	if _, ok := properties["value"]; !ok {
+		return 0, value
+	}
+
+	var versioned versionedValue
+
+	if err := json.Unmarshal(value, &versioned); err != nil {
+		return 0, value
+	}
+
+	return versioned.Version, versioned.Value
+}
@@ -0,0 +1,372 @@
+package private_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/resource/private"
+)
+
+type testSettings struct {
+	Name  string `json:"name"`
+	Count int64  `json:"count"`
+}
+
+func TestKeyDelete(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		key           private.Key[testSettings]
+		data          private.Data
+		expectedKeys  []string
+		expectedDiags diag.Diagnostics
+	}{
+		"nil": {
+			key:  private.Key[testSettings]{Name: "settings"},
+			data: nil,
+		},
+		"key-found": {
+			key: private.Key[testSettings]{Name: "settings"},
+			data: privatestate.MustProviderData(context.Background(), privatestate.MustMarshalToJson(map[string][]byte{
+				"other":    []byte(`{}`),
+				"settings": []byte(`{"name":"test","count":1}`),
+			})),
+			expectedKeys: []string{"other"},
+		},
+		"key-invalid": {
+			key: private.Key[testSettings]{Name: ".settings"},
+			data: privatestate.MustProviderData(context.Background(), privatestate.MustMarshalToJson(map[string][]byte{
+				"other": []byte(`{}`),
+			})),
+			expectedKeys: []string{"other"},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Restricted Resource Private State Namespace",
+					"Using a period ('.') as a prefix for a key used in private state is not allowed.\n\n"+
+						`The key ".settings" is invalid. Please check the key you are supplying does not use a a period ('.') as a prefix.`,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotDiags := testCase.key.Delete(context.Background(), testCase.data)
+
+			if diff := cmp.Diff(gotDiags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if testCase.data == nil {
+				return
+			}
+
+			if diff := cmp.Diff(testCase.data.Keys(context.Background()), testCase.expectedKeys); diff != "" {
+				t.Errorf("unexpected keys difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestKeyGet(t *testing.T) {
+	t.Parallel()
+
+	migrateV0 := func(_ context.Context, value []byte) ([]byte, diag.Diagnostics) {
+		// Version 0 used a "title" property instead of "name".
+		return []byte(`{"name":"migrated","count":0}`), nil
+	}
+
+	testCases := map[string]struct {
+		key           private.Key[testSettings]
+		data          map[string][]byte
+		expected      *testSettings
+		expectedDiags diag.Diagnostics
+	}{
+		"key-not-found": {
+			key:      private.Key[testSettings]{Name: "settings"},
+			data:     map[string][]byte{"other": []byte(`{}`)},
+			expected: nil,
+		},
+		"key-found": {
+			key: private.Key[testSettings]{Name: "settings"},
+			data: map[string][]byte{
+				"settings": []byte(`{"name":"test","count":1}`),
+			},
+			expected: &testSettings{Name: "test", Count: 1},
+		},
+		"value-type-mismatch": {
+			key: private.Key[testSettings]{Name: "settings"},
+			data: map[string][]byte{
+				"settings": []byte(`{"name":1}`),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Error Decoding Private State",
+					`An error was encountered when decoding the private state value for key "settings": `+
+						"json: cannot unmarshal number into Go struct field testSettings.name of type string\n\n"+
+						"This is always an issue with the provider and should be reported to the provider developers.",
+				),
+			},
+		},
+		"version-current": {
+			key: private.Key[testSettings]{Name: "settings", Version: 1},
+			data: map[string][]byte{
+				"settings": []byte(`{"version":1,"value":{"name":"test","count":1}}`),
+			},
+			expected: &testSettings{Name: "test", Count: 1},
+		},
+		"version-unversioned-migration": {
+			key: private.Key[testSettings]{
+				Name:    "settings",
+				Version: 1,
+				Migrations: map[int64]private.Migration{
+					0: migrateV0,
+				},
+			},
+			data: map[string][]byte{
+				"settings": []byte(`{"title":"test"}`),
+			},
+			expected: &testSettings{Name: "migrated"},
+		},
+		"version-prior-migration": {
+			key: private.Key[testSettings]{
+				Name:    "settings",
+				Version: 2,
+				Migrations: map[int64]private.Migration{
+					1: func(_ context.Context, value []byte) ([]byte, diag.Diagnostics) {
+						return []byte(`{"name":"migrated","count":1}`), nil
+					},
+				},
+			},
+			data: map[string][]byte{
+				"settings": []byte(`{"version":1,"value":{"title":"test"}}`),
+			},
+			expected: &testSettings{Name: "migrated", Count: 1},
+		},
+		"version-prior-migration-error": {
+			key: private.Key[testSettings]{
+				Name:    "settings",
+				Version: 1,
+				Migrations: map[int64]private.Migration{
+					0: func(_ context.Context, value []byte) ([]byte, diag.Diagnostics) {
+						return nil, diag.Diagnostics{
+							diag.NewErrorDiagnostic("test summary", "test detail"),
+						}
+					},
+				},
+			},
+			data: map[string][]byte{
+				"settings": []byte(`{"title":"test"}`),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("test summary", "test detail"),
+			},
+		},
+		"version-prior-migration-missing": {
+			key: private.Key[testSettings]{Name: "settings", Version: 2},
+			data: map[string][]byte{
+				"settings": []byte(`{"version":1,"value":{"name":"test"}}`),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Error Decoding Private State",
+					`The private state value for key "settings" has version 1, however no Migration is defined for the version. `+
+						"This is always an issue with the provider and should be reported to the provider developers.",
+				),
+			},
+		},
+		"version-newer": {
+			key: private.Key[testSettings]{Name: "settings", Version: 1},
+			data: map[string][]byte{
+				"settings": []byte(`{"version":2,"value":{"name":"test"}}`),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Error Decoding Private State",
+					`The private state value for key "settings" has version 2, which is newer than the current version 1. `+
+						"This can occur if a newer version of the provider saved the value.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data := privatestate.MustProviderData(context.Background(), privatestate.MustMarshalToJson(testCase.data))
+
+			got, gotDiags := testCase.key.Get(context.Background(), data)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(gotDiags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestKeyMigrate(t *testing.T) {
+	t.Parallel()
+
+	migrations := map[int64]private.Migration{
+		0: func(_ context.Context, value []byte) ([]byte, diag.Diagnostics) {
+			return []byte(`{"name":"migrated","count":0}`), nil
+		},
+	}
+
+	testCases := map[string]struct {
+		key           private.Key[testSettings]
+		data          map[string][]byte
+		expected      []byte
+		expectedDiags diag.Diagnostics
+	}{
+		"key-not-found": {
+			key:      private.Key[testSettings]{Name: "settings", Version: 1, Migrations: migrations},
+			data:     map[string][]byte{"other": []byte(`{}`)},
+			expected: nil,
+		},
+		"unversioned": {
+			key: private.Key[testSettings]{Name: "settings"},
+			data: map[string][]byte{
+				"settings": []byte(`{"name":"test","count":1}`),
+			},
+			expected: []byte(`{"name":"test","count":1}`),
+		},
+		"version-current": {
+			key: private.Key[testSettings]{Name: "settings", Version: 1, Migrations: migrations},
+			data: map[string][]byte{
+				"settings": []byte(`{"version":1,"value":{"name":"test","count":1}}`),
+			},
+			expected: []byte(`{"version":1,"value":{"name":"test","count":1}}`),
+		},
+		"version-prior": {
+			key: private.Key[testSettings]{Name: "settings", Version: 1, Migrations: migrations},
+			data: map[string][]byte{
+				"settings": []byte(`{"title":"test"}`),
+			},
+			expected: []byte(`{"version":1,"value":{"name":"migrated","count":0}}`),
+		},
+		"version-prior-migration-missing": {
+			key: private.Key[testSettings]{Name: "settings", Version: 1},
+			data: map[string][]byte{
+				"settings": []byte(`{"title":"test"}`),
+			},
+			expected: []byte(`{"title":"test"}`),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Error Decoding Private State",
+					`The private state value for key "settings" has version 0, however no Migration is defined for the version. `+
+						"This is always an issue with the provider and should be reported to the provider developers.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data := privatestate.MustProviderData(context.Background(), privatestate.MustMarshalToJson(testCase.data))
+
+			gotDiags := testCase.key.Migrate(context.Background(), data)
+
+			if diff := cmp.Diff(gotDiags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			got, _ := data.GetKey(context.Background(), testCase.key.Name)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestKeySet(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		key           private.Key[testSettings]
+		data          private.Data
+		value         testSettings
+		expected      []byte
+		expectedDiags diag.Diagnostics
+	}{
+		"nil": {
+			key:   private.Key[testSettings]{Name: "settings"},
+			data:  nil,
+			value: testSettings{Name: "test"},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Uninitialized Private State",
+					`The private state value for key "settings" could not be saved as the private state data is missing. `+
+						"This is always an issue with the provider and should be reported to the provider developers.",
+				),
+			},
+		},
+		"unversioned": {
+			key:      private.Key[testSettings]{Name: "settings"},
+			data:     privatestate.EmptyProviderData(context.Background()),
+			value:    testSettings{Name: "test", Count: 1},
+			expected: []byte(`{"name":"test","count":1}`),
+		},
+		"versioned": {
+			key:      private.Key[testSettings]{Name: "settings", Version: 2},
+			data:     privatestate.EmptyProviderData(context.Background()),
+			value:    testSettings{Name: "test", Count: 1},
+			expected: []byte(`{"version":2,"value":{"name":"test","count":1}}`),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotDiags := testCase.key.Set(context.Background(), testCase.data, testCase.value)
+
+			if diff := cmp.Diff(gotDiags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if testCase.data == nil {
+				return
+			}
+
+			got, _ := testCase.data.GetKey(context.Background(), testCase.key.Name)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			// Values must round trip through Get.
+			value, diags := testCase.key.Get(context.Background(), testCase.data)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", diags)
+			}
+
+			if diff := cmp.Diff(value, &testCase.value); diff != "" {
+				t.Errorf("unexpected round trip difference: %s", diff)
+			}
+		})
+	}
+}
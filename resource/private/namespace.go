@@ -0,0 +1,66 @@
+package private
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// NamespaceSeparator is the separator between a Namespace and the key names
+// within it.
+const NamespaceSeparator = "/"
+
+// Namespace is a prefix for a group of private state keys, which prevents
+// collisions between keys managed by different parts of a provider, such as
+// shared libraries, and enables listing or removing all keys of the group.
+//
+// Namespaces must not begin with a period ('.'), which is reserved for
+// framework usage.
+type Namespace string
+
+// Delete removes all keys of the private state data within the namespace,
+// including keys of any nested namespaces.
+func (n Namespace) Delete(ctx context.Context, data Data) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, key := range n.Keys(ctx, data) {
+		diags.Append(data.DeleteKey(ctx, key)...)
+	}
+
+	return diags
+}
+
+// KeyName returns the private state key for the given name within the
+// namespace, such as for the Key type Name field.
+func (n Namespace) KeyName(name string) string {
+	return n.prefix() + name
+}
+
+// Keys returns the sorted keys of the private state data within the
+// namespace, including keys of any nested namespaces.
+func (n Namespace) Keys(ctx context.Context, data Data) []string {
+	if data == nil {
+		return nil
+	}
+
+	var keys []string
+
+	for _, key := range data.Keys(ctx) {
+		if strings.HasPrefix(key, n.prefix()) {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
+
+// Namespace returns a nested namespace with the given name.
+func (n Namespace) Namespace(name string) Namespace {
+	return Namespace(n.KeyName(name))
+}
+
+// prefix returns the prefix of all keys within the namespace.
+func (n Namespace) prefix() string {
+	return string(n) + NamespaceSeparator
+}
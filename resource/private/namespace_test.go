@@ -0,0 +1,121 @@
+package private_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/resource/private"
+)
+
+func TestNamespaceDelete(t *testing.T) {
+	t.Parallel()
+
+	data := privatestate.MustProviderData(context.Background(), privatestate.MustMarshalToJson(map[string][]byte{
+		"example/one":        []byte(`1`),
+		"example/nested/two": []byte(`2`),
+		"examples":           []byte(`3`),
+		"other/one":          []byte(`4`),
+	}))
+
+	diags := private.Namespace("example").Delete(context.Background(), data)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %v", diags)
+	}
+
+	expected := []string{"examples", "other/one"}
+
+	if diff := cmp.Diff(data.Keys(context.Background()), expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestNamespaceKeyName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		namespace private.Namespace
+		name      string
+		expected  string
+	}{
+		"namespace": {
+			namespace: private.Namespace("example"),
+			name:      "settings",
+			expected:  "example/settings",
+		},
+		"nested-namespace": {
+			namespace: private.Namespace("example").Namespace("nested"),
+			name:      "settings",
+			expected:  "example/nested/settings",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.namespace.KeyName(testCase.name)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNamespaceKeys(t *testing.T) {
+	t.Parallel()
+
+	data := privatestate.MustProviderData(context.Background(), privatestate.MustMarshalToJson(map[string][]byte{
+		"example/one":        []byte(`1`),
+		"example/nested/two": []byte(`2`),
+		"examples":           []byte(`3`),
+		"other/one":          []byte(`4`),
+	}))
+
+	testCases := map[string]struct {
+		namespace private.Namespace
+		data      private.Data
+		expected  []string
+	}{
+		"nil": {
+			namespace: private.Namespace("example"),
+			data:      nil,
+			expected:  nil,
+		},
+		"namespace": {
+			namespace: private.Namespace("example"),
+			data:      data,
+			expected:  []string{"example/nested/two", "example/one"},
+		},
+		"nested-namespace": {
+			namespace: private.Namespace("example").Namespace("nested"),
+			data:      data,
+			expected:  []string{"example/nested/two"},
+		},
+		"no-keys": {
+			namespace: private.Namespace("missing"),
+			data:      data,
+			expected:  nil,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.namespace.Keys(context.Background(), testCase.data)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
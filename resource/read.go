@@ -21,7 +21,8 @@ type ReadRequest struct {
 	// ReadResourceResponse.Private to prevent accidental private state data loss.
 	//
 	// Use the GetKey method to read data. Use the SetKey method on
-	// ReadResourceResponse.Private to update or remove a value.
+	// ReadResourceResponse.Private to update or remove a value. The resource/private
+	// package Key type provides typed access to values.
 	Private *privatestate.ProviderData
 
 	// ProviderMeta is metadata from the provider_meta block of the module.
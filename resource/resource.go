@@ -2,6 +2,8 @@ package resource
 
 import (
 	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/private"
 )
 
 // Resource represents an instance of a managed resource type. This is the core
@@ -16,6 +18,7 @@ import (
 //   - Plan Modification: Schema-based or entire plan
 //     via ResourceWithModifyPlan.
 //   - State Upgrades: ResourceWithUpgradeState
+//   - Private State Upgrades: ResourceWithPrivateStateMigrations
 //
 // Although not required, it is conventional for resources to implement the
 // ResourceWithImportState interface.
@@ -35,6 +38,11 @@ type Resource interface {
 	// Read is called when the provider must read resource values in order
 	// to update state. Planned state values should be read from the
 	// ReadRequest and new state values set on the ReadResponse.
+	//
+	// If the remote object is not found, add a resource not found diagnostic,
+	// such as with the ReadResponse.Diagnostics AddResourceNotFound method.
+	// The framework will remove the resource from state and return a warning
+	// diagnostic instead.
 	Read(context.Context, ReadRequest, *ReadResponse)
 
 	// Update is called to update the state of the resource. Config, planned
@@ -47,7 +55,9 @@ type Resource interface {
 	//
 	// If execution completes without error, the framework will automatically
 	// call DeleteResponse.State.RemoveResource(), so it can be omitted
-	// from provider logic.
+	// from provider logic. A resource not found diagnostic, such as from
+	// the DeleteResponse.Diagnostics AddResourceNotFound method, is treated
+	// as a successful deletion.
 	Delete(context.Context, DeleteRequest, *DeleteResponse)
 }
 
@@ -94,7 +104,9 @@ type ResourceWithImportState interface {
 	// method can properly refresh the full resource.
 	//
 	// If setting an attribute with the import identifier, it is recommended
-	// to use the ImportStatePassthroughID() call in this method.
+	// to use the ImportStatePassthroughID() call in this method. If the import
+	// identifier contains multiple values, such as project/region/name, use
+	// the ImportStateCompositeID() or ImportStateCompositeIDPattern() call.
 	ImportState(context.Context, ImportStateRequest, *ImportStateResponse)
 }
 
@@ -124,6 +136,50 @@ type ResourceWithModifyPlan interface {
 	ModifyPlan(context.Context, ModifyPlanRequest, *ModifyPlanResponse)
 }
 
+// ResourceWithPrivateStateMigrations is an interface type that extends
+// Resource to upgrade private state values saved with a prior private.Key
+// Version. Terraform does not send private state data with the
+// UpgradeResourceState RPC, so the framework migrates the values before
+// calling the Read method of the ReadResource RPC, which Terraform calls
+// after upgrading the resource state. Migrated values are saved with the
+// current version in the private state of the response.
+type ResourceWithPrivateStateMigrations interface {
+	Resource
+
+	// PrivateStateMigrations should return the private state keys to
+	// migrate, such as each private.Key with Migrations.
+	PrivateStateMigrations(context.Context) []private.Migrator
+}
+
+// ResourceWithReadAfterWrite is an interface type that extends Resource to
+// call the Read method automatically after the Create and Update methods in
+// the same ApplyResourceChange RPC. This replaces calling the same internal
+// read logic at the end of Create and Update.
+//
+// The Read method receives the state returned by Create or Update. Planned
+// values which were known are kept in the new state, as Terraform requires
+// them to be unchanged after apply, while unknown planned values are set
+// from the state returned by Read.
+//
+// If Read returns an error diagnostic or removes the resource with
+// State.RemoveResource, such as when the remote object is not found yet, the
+// state and private state returned by Create or Update are saved instead, so
+// the resource remains tracked by Terraform. When Read removes the resource,
+// a "Missing Resource State After Read" error diagnostic is returned, which
+// fails the apply rather than removing the newly created or updated resource.
+// Similarly, a diag.ResourceNotFoundDiagnostic returned by Read is replaced
+// by a single "Resource Not Found" error diagnostic.
+//
+// Implementing this interface is not necessary for import, as Terraform
+// automatically calls Read after the ImportState method.
+type ResourceWithReadAfterWrite interface {
+	Resource
+
+	// ReadAfterWrite should return true to enable calling Read after Create
+	// and Update.
+	ReadAfterWrite(context.Context) bool
+}
+
 // Optional interface on top of Resource that enables provider control over
 // the UpgradeResourceState RPC. This RPC is automatically called by Terraform
 // when the current Schema type Version field is greater than the stored state.
@@ -142,7 +198,8 @@ type ResourceWithUpgradeState interface {
 	// implementations. Only the specified state upgrader for the prior state
 	// version is called, rather than each version in between, so it must
 	// encapsulate all logic to convert the prior state to the current schema
-	// version.
+	// version, unless the StateUpgrader TargetVersion field is set to an
+	// intermediate version to chain state upgraders.
 	//
 	// Version keys begin at 0, which is the default schema version when
 	// undefined. The framework will return an error diagnostic should the
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
 	testtypes "github.com/hashicorp/terraform-plugin-framework/internal/testing/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -149,6 +150,78 @@ func TestBoolAttributeBoolValidators(t *testing.T) {
 	}
 }
 
+func TestBoolAttributeGetComputedDependencies(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.BoolAttribute
+		expected  path.Expressions
+	}{
+		"no-computed-dependencies": {
+			attribute: schema.BoolAttribute{},
+			expected:  nil,
+		},
+		"computed-dependencies": {
+			attribute: schema.BoolAttribute{
+				ComputedDependencies: path.Expressions{
+					path.MatchRoot("other"),
+				},
+			},
+			expected: path.Expressions{
+				path.MatchRoot("other"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.GetComputedDependencies()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestBoolAttributeGetComputedValuePolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.BoolAttribute
+		expected  schema.ComputedValuePolicy
+	}{
+		"unset": {
+			attribute: schema.BoolAttribute{},
+			expected:  schema.ComputedValuePolicyUnset,
+		},
+		"preserve": {
+			attribute: schema.BoolAttribute{
+				ComputedValuePolicy: schema.ComputedValuePolicyPreserve,
+			},
+			expected: schema.ComputedValuePolicyPreserve,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.GetComputedValuePolicy()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestBoolAttributeGetDeprecationMessage(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+)
+
+// ComputedValuePolicy determines how the value of a Computed attribute, which
+// is null in the configuration, is planned when the resource has changes. By
+// default, the framework marks these values as unknown ("known after apply")
+// so the provider can set a new value during apply.
+//
+// The policy is set with the ComputedValuePolicy field of the Schema and
+// attributes. Attributes without a policy inherit the policy of the nearest
+// parent nested attribute, otherwise the policy of the Schema.
+//
+// Setting a ComputedValuePolicyPreserve policy on an existing resource
+// changes its plans: updates which previously planned "(known after apply)"
+// for the affected values now plan the prior state value instead. The
+// resource Update method must then keep those values in the new state,
+// otherwise Terraform returns a "Provider produced inconsistent result after
+// apply" error. Resources without a policy are planned as before.
+//
+// Attributes with ComputedDependencies ignore the policy. Their prior state
+// value is kept unless a dependency changed, so adding ComputedDependencies
+// to an existing attribute has the same effect on plans as the preserve
+// policy when the dependencies are unchanged.
+type ComputedValuePolicy = fwschema.ComputedValuePolicy
+
+const (
+	// ComputedValuePolicyUnset inherits the policy of the parent nested
+	// attribute or the Schema. If no policy is set, values are volatile.
+	ComputedValuePolicyUnset = fwschema.ComputedValuePolicyUnset
+
+	// ComputedValuePolicyVolatile marks the value as unknown in the plan
+	// whenever the resource has changes. This is the default behavior.
+	ComputedValuePolicyVolatile = fwschema.ComputedValuePolicyVolatile
+
+	// ComputedValuePolicyPreserve keeps the prior state value in the plan,
+	// such as for identifiers and creation timestamps that do not change
+	// after creation. The value is still unknown when the resource is
+	// created or the value is new, such as a new nested attribute element.
+	// Plan modifiers can still set the planned value to unknown when
+	// necessary.
+	ComputedValuePolicyPreserve = fwschema.ComputedValuePolicyPreserve
+)
@@ -4,6 +4,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -14,6 +15,8 @@ import (
 // Ensure the implementation satisifies the desired interfaces.
 var (
 	_ Attribute                                   = Float64Attribute{}
+	_ fwxschema.AttributeWithComputedDependencies = Float64Attribute{}
+	_ fwxschema.AttributeWithComputedValuePolicy  = Float64Attribute{}
 	_ fwxschema.AttributeWithFloat64PlanModifiers = Float64Attribute{}
 	_ fwxschema.AttributeWithFloat64Validators    = Float64Attribute{}
 )
@@ -138,6 +141,24 @@ type Float64Attribute struct {
 	//
 	// Any errors will prevent further execution of this sequence or modifiers.
 	PlanModifiers []planmodifier.Float64
+
+	// ComputedValuePolicy determines how the value is planned when it is
+	// Computed, null in the configuration, and the resource has changes.
+	// Refer to the ComputedValuePolicy type documentation for details. If
+	// unset, the policy of the parent nested attribute or Schema is used.
+	ComputedValuePolicy ComputedValuePolicy
+
+	// ComputedDependencies defines the attributes which, when changed in the
+	// plan, cause the value to be unknown in the plan when it is Computed
+	// and null in the configuration. Otherwise, the prior state value is
+	// kept, regardless of the ComputedValuePolicy. Relative expressions, such
+	// as path.MatchRelative().AtParent().AtName("other_attribute"), are
+	// merged with the path of this attribute.
+	//
+	// This is a declarative alternative to resource-level plan modification
+	// for values, such as an endpoint, which change when other attributes,
+	// such as an engine version, change.
+	ComputedDependencies path.Expressions
 }
 
 // ApplyTerraform5AttributePathStep always returns an error as it is not
@@ -166,6 +187,16 @@ func (a Float64Attribute) Float64Validators() []validator.Float64 {
 	return a.Validators
 }
 
+// GetComputedDependencies returns the ComputedDependencies field value.
+func (a Float64Attribute) GetComputedDependencies() path.Expressions {
+	return a.ComputedDependencies
+}
+
+// GetComputedValuePolicy returns the ComputedValuePolicy field value.
+func (a Float64Attribute) GetComputedValuePolicy() ComputedValuePolicy {
+	return a.ComputedValuePolicy
+}
+
 // GetDeprecationMessage returns the DeprecationMessage field value.
 func (a Float64Attribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -148,6 +149,78 @@ func TestFloat64AttributeFloat64Validators(t *testing.T) {
 	}
 }
 
+func TestFloat64AttributeGetComputedDependencies(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.Float64Attribute
+		expected  path.Expressions
+	}{
+		"no-computed-dependencies": {
+			attribute: schema.Float64Attribute{},
+			expected:  nil,
+		},
+		"computed-dependencies": {
+			attribute: schema.Float64Attribute{
+				ComputedDependencies: path.Expressions{
+					path.MatchRoot("other"),
+				},
+			},
+			expected: path.Expressions{
+				path.MatchRoot("other"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.GetComputedDependencies()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFloat64AttributeGetComputedValuePolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.Float64Attribute
+		expected  schema.ComputedValuePolicy
+	}{
+		"unset": {
+			attribute: schema.Float64Attribute{},
+			expected:  schema.ComputedValuePolicyUnset,
+		},
+		"preserve": {
+			attribute: schema.Float64Attribute{
+				ComputedValuePolicy: schema.ComputedValuePolicyPreserve,
+			},
+			expected: schema.ComputedValuePolicyPreserve,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.GetComputedValuePolicy()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestFloat64AttributeGetDeprecationMessage(t *testing.T) {
 	t.Parallel()
 
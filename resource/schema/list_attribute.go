@@ -4,6 +4,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -13,9 +14,11 @@ import (
 
 // Ensure the implementation satisifies the desired interfaces.
 var (
-	_ Attribute                                = ListAttribute{}
-	_ fwxschema.AttributeWithListPlanModifiers = ListAttribute{}
-	_ fwxschema.AttributeWithListValidators    = ListAttribute{}
+	_ Attribute                                   = ListAttribute{}
+	_ fwxschema.AttributeWithComputedDependencies = ListAttribute{}
+	_ fwxschema.AttributeWithComputedValuePolicy  = ListAttribute{}
+	_ fwxschema.AttributeWithListPlanModifiers    = ListAttribute{}
+	_ fwxschema.AttributeWithListValidators       = ListAttribute{}
 )
 
 // ListAttribute represents a schema attribute that is a list with a single
@@ -146,6 +149,24 @@ type ListAttribute struct {
 	//
 	// Any errors will prevent further execution of this sequence or modifiers.
 	PlanModifiers []planmodifier.List
+
+	// ComputedValuePolicy determines how the value is planned when it is
+	// Computed, null in the configuration, and the resource has changes.
+	// Refer to the ComputedValuePolicy type documentation for details. If
+	// unset, the policy of the parent nested attribute or Schema is used.
+	ComputedValuePolicy ComputedValuePolicy
+
+	// ComputedDependencies defines the attributes which, when changed in the
+	// plan, cause the value to be unknown in the plan when it is Computed
+	// and null in the configuration. Otherwise, the prior state value is
+	// kept, regardless of the ComputedValuePolicy. Relative expressions, such
+	// as path.MatchRelative().AtParent().AtName("other_attribute"), are
+	// merged with the path of this attribute.
+	//
+	// This is a declarative alternative to resource-level plan modification
+	// for values, such as an endpoint, which change when other attributes,
+	// such as an engine version, change.
+	ComputedDependencies path.Expressions
 }
 
 // ApplyTerraform5AttributePathStep returns the result of stepping into a list
@@ -164,6 +185,16 @@ func (a ListAttribute) Equal(o fwschema.Attribute) bool {
 	return fwschema.AttributesEqual(a, o)
 }
 
+// GetComputedDependencies returns the ComputedDependencies field value.
+func (a ListAttribute) GetComputedDependencies() path.Expressions {
+	return a.ComputedDependencies
+}
+
+// GetComputedValuePolicy returns the ComputedValuePolicy field value.
+func (a ListAttribute) GetComputedValuePolicy() ComputedValuePolicy {
+	return a.ComputedValuePolicy
+}
+
 // GetDeprecationMessage returns the DeprecationMessage field value.
 func (a ListAttribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
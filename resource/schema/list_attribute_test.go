@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -80,6 +81,78 @@ func TestListAttributeApplyTerraform5AttributePathStep(t *testing.T) {
 	}
 }
 
+func TestListAttributeGetComputedDependencies(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.ListAttribute
+		expected  path.Expressions
+	}{
+		"no-computed-dependencies": {
+			attribute: schema.ListAttribute{},
+			expected:  nil,
+		},
+		"computed-dependencies": {
+			attribute: schema.ListAttribute{
+				ComputedDependencies: path.Expressions{
+					path.MatchRoot("other"),
+				},
+			},
+			expected: path.Expressions{
+				path.MatchRoot("other"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.GetComputedDependencies()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestListAttributeGetComputedValuePolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.ListAttribute
+		expected  schema.ComputedValuePolicy
+	}{
+		"unset": {
+			attribute: schema.ListAttribute{},
+			expected:  schema.ComputedValuePolicyUnset,
+		},
+		"preserve": {
+			attribute: schema.ListAttribute{
+				ComputedValuePolicy: schema.ComputedValuePolicyPreserve,
+			},
+			expected: schema.ComputedValuePolicyPreserve,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.GetComputedValuePolicy()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestListAttributeGetDeprecationMessage(t *testing.T) {
 	t.Parallel()
 
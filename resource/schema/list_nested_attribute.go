@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -15,9 +16,11 @@ import (
 
 // Ensure the implementation satisifies the desired interfaces.
 var (
-	_ NestedAttribute                          = ListNestedAttribute{}
-	_ fwxschema.AttributeWithListPlanModifiers = ListNestedAttribute{}
-	_ fwxschema.AttributeWithListValidators    = ListNestedAttribute{}
+	_ NestedAttribute                             = ListNestedAttribute{}
+	_ fwxschema.AttributeWithComputedDependencies = ListNestedAttribute{}
+	_ fwxschema.AttributeWithComputedValuePolicy  = ListNestedAttribute{}
+	_ fwxschema.AttributeWithListPlanModifiers    = ListNestedAttribute{}
+	_ fwxschema.AttributeWithListValidators       = ListNestedAttribute{}
 )
 
 // ListNestedAttribute represents an attribute that is a list of objects where
@@ -157,6 +160,25 @@ type ListNestedAttribute struct {
 	//
 	// Any errors will prevent further execution of this sequence or modifiers.
 	PlanModifiers []planmodifier.List
+
+	// ComputedValuePolicy determines how the value is planned when it is
+	// Computed, null in the configuration, and the resource has changes.
+	// Refer to the ComputedValuePolicy type documentation for details. If
+	// unset, the policy of the parent nested attribute or Schema is used.
+	// Nested attributes without their own policy inherit this policy.
+	ComputedValuePolicy ComputedValuePolicy
+
+	// ComputedDependencies defines the attributes which, when changed in the
+	// plan, cause the value to be unknown in the plan when it is Computed
+	// and null in the configuration. Otherwise, the prior state value is
+	// kept, regardless of the ComputedValuePolicy. Relative expressions, such
+	// as path.MatchRelative().AtParent().AtName("other_attribute"), are
+	// merged with the path of this attribute.
+	//
+	// This is a declarative alternative to resource-level plan modification
+	// for values, such as an endpoint, which change when other attributes,
+	// such as an engine version, change.
+	ComputedDependencies path.Expressions
 }
 
 // ApplyTerraform5AttributePathStep returns the Attributes field value if step
@@ -181,6 +203,16 @@ func (a ListNestedAttribute) Equal(o fwschema.Attribute) bool {
 	return fwschema.AttributesEqual(a, o)
 }
 
+// GetComputedDependencies returns the ComputedDependencies field value.
+func (a ListNestedAttribute) GetComputedDependencies() path.Expressions {
+	return a.ComputedDependencies
+}
+
+// GetComputedValuePolicy returns the ComputedValuePolicy field value.
+func (a ListNestedAttribute) GetComputedValuePolicy() ComputedValuePolicy {
+	return a.ComputedValuePolicy
+}
+
 // GetDeprecationMessage returns the DeprecationMessage field value.
 func (a ListNestedAttribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
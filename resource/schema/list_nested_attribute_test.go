@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -108,6 +109,78 @@ func TestListNestedAttributeApplyTerraform5AttributePathStep(t *testing.T) {
 	}
 }
 
+func TestListNestedAttributeGetComputedDependencies(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.ListNestedAttribute
+		expected  path.Expressions
+	}{
+		"no-computed-dependencies": {
+			attribute: schema.ListNestedAttribute{},
+			expected:  nil,
+		},
+		"computed-dependencies": {
+			attribute: schema.ListNestedAttribute{
+				ComputedDependencies: path.Expressions{
+					path.MatchRoot("other"),
+				},
+			},
+			expected: path.Expressions{
+				path.MatchRoot("other"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.GetComputedDependencies()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestListNestedAttributeGetComputedValuePolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attribute schema.ListNestedAttribute
+		expected  schema.ComputedValuePolicy
+	}{
+		"unset": {
+			attribute: schema.ListNestedAttribute{},
+			expected:  schema.ComputedValuePolicyUnset,
+		},
+		"preserve": {
+			attribute: schema.ListNestedAttribute{
+				ComputedValuePolicy: schema.ComputedValuePolicyPreserve,
+			},
+			expected: schema.ComputedValuePolicyPreserve,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.attribute.GetComputedValuePolicy()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestListNestedAttributeGetDeprecationMessage(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,335 @@
+package schema_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	testtypes "github.com/hashicorp/terraform-plugin-framework/internal/testing/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestFromModel(t *testing.T) {
+	t.Parallel()
+
+	type nestedModel struct {
+		Value types.Int64 `tfsdk:"value,required"`
+	}
+
+	testCases := map[string]struct {
+		model         any
+		opts          []schema.ModelOption
+		expected      schema.Schema
+		expectedDiags diag.Diagnostics
+	}{
+		"attributes": {
+			model: &struct {
+				ID       types.String               `tfsdk:"id,computed"`
+				Name     string                     `tfsdk:"name,required"`
+				Enabled  *bool                      `tfsdk:"enabled,optional,computed"`
+				Password types.String               `tfsdk:"password,optional,sensitive"`
+				Size     float64                    `tfsdk:"size,optional"`
+				Tags     []string                   `tfsdk:"tags,optional,set"`
+				Labels   map[string]types.String    `tfsdk:"labels,optional"`
+				Ports    [][]int64                  `tfsdk:"ports,optional"`
+				Address  map[string]map[string]bool `tfsdk:"address,optional"`
+			}{},
+			expected: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":       schema.StringAttribute{Computed: true},
+					"name":     schema.StringAttribute{Required: true},
+					"enabled":  schema.BoolAttribute{Optional: true, Computed: true},
+					"password": schema.StringAttribute{Optional: true, Sensitive: true},
+					"size":     schema.Float64Attribute{Optional: true},
+					"tags":     schema.SetAttribute{ElementType: types.StringType, Optional: true},
+					"labels":   schema.MapAttribute{ElementType: types.StringType, Optional: true},
+					"ports":    schema.ListAttribute{ElementType: types.ListType{ElemType: types.Int64Type}, Optional: true},
+					"address":  schema.MapAttribute{ElementType: types.MapType{ElemType: types.BoolType}, Optional: true},
+				},
+			},
+		},
+		"nested-attributes": {
+			model: struct {
+				List   []nestedModel          `tfsdk:"list,optional"`
+				Map    map[string]nestedModel `tfsdk:"map,optional"`
+				Set    []nestedModel          `tfsdk:"set,optional,set"`
+				Single *nestedModel           `tfsdk:"single,computed"`
+				Object []struct {
+					Value string `tfsdk:"value"`
+				} `tfsdk:"object,optional"`
+			}{},
+			opts: []schema.ModelOption{
+				schema.ModelRequired(path.Root("object").AtName("value")),
+			},
+			expected: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"list": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"value": schema.Int64Attribute{Required: true},
+							},
+						},
+						Optional: true,
+					},
+					"map": schema.MapNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"value": schema.Int64Attribute{Required: true},
+							},
+						},
+						Optional: true,
+					},
+					"set": schema.SetNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"value": schema.Int64Attribute{Required: true},
+							},
+						},
+						Optional: true,
+					},
+					"single": schema.SingleNestedAttribute{
+						Attributes: map[string]schema.Attribute{
+							"value": schema.Int64Attribute{Required: true},
+						},
+						Computed: true,
+					},
+					"object": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"value": schema.StringAttribute{Required: true},
+							},
+						},
+						Optional: true,
+					},
+				},
+			},
+		},
+		"options": {
+			model: struct {
+				Name   types.String `tfsdk:"name"`
+				Tags   types.List   `tfsdk:"tags"`
+				Secret string       `tfsdk:"secret,optional"`
+			}{},
+			opts: []schema.ModelOption{
+				schema.ModelComputed(path.Root("name")),
+				schema.ModelOptional(path.Root("name")),
+				schema.ModelDescription(path.Root("name"), "Name of the thing."),
+				schema.ModelMarkdownDescription(path.Root("name"), "Name of the *thing*."),
+				schema.ModelSensitive(path.Root("secret")),
+				schema.ModelAttribute(path.Root("tags"), schema.ListAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+				}),
+			},
+			expected: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Computed:            true,
+						Optional:            true,
+						Description:         "Name of the thing.",
+						MarkdownDescription: "Name of the *thing*.",
+					},
+					"secret": schema.StringAttribute{Optional: true, Sensitive: true},
+					"tags":   schema.ListAttribute{ElementType: types.StringType, Optional: true},
+				},
+			},
+		},
+		"custom-types": {
+			model: struct {
+				Custom customStringValue `tfsdk:"custom,optional"`
+				List   customListValue   `tfsdk:"list,optional"`
+			}{},
+			expected: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"custom": schema.StringAttribute{CustomType: customStringType{}, Optional: true},
+					"list": schema.ListAttribute{
+						ElementType: types.StringType,
+						CustomType:  customListType{ListType: types.ListType{ElemType: types.StringType}},
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"nil": {
+			model: nil,
+			expectedDiags: diag.Diagnostics{
+				invalidModelDiagnostic("model must be a struct or pointer to struct, got nil"),
+			},
+		},
+		"missing-requiredness": {
+			model: struct {
+				Name string `tfsdk:"name"`
+			}{},
+			expectedDiags: diag.Diagnostics{
+				invalidModelDiagnostic("name: one of required, optional, or computed must be set on Name"),
+			},
+		},
+		"unknown-option-path": {
+			model: struct {
+				Name string `tfsdk:"name,required"`
+			}{},
+			opts: []schema.ModelOption{
+				schema.ModelAttribute(path.Root("other"), schema.StringAttribute{Required: true}),
+			},
+			expectedDiags: diag.Diagnostics{
+				invalidModelDiagnostic("other: option path does not match a model field"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := schema.FromModel(context.Background(), testCase.model, testCase.opts...)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSchemaValidateModel(t *testing.T) {
+	t.Parallel()
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":   schema.StringAttribute{Computed: true},
+			"tags": schema.ListAttribute{ElementType: types.StringType, Optional: true},
+			"nested": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"value": schema.StringAttribute{Optional: true},
+				},
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"block": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"custom": schema.StringAttribute{CustomType: testtypes.StringType{}, Optional: true},
+					},
+				},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		model    any
+		expected diag.Diagnostics
+	}{
+		"valid": {
+			model: &struct {
+				ID     types.String `tfsdk:"id"`
+				Tags   []string     `tfsdk:"tags,optional"`
+				Nested *struct {
+					Value types.String `tfsdk:"value"`
+				} `tfsdk:"nested"`
+				Block []struct {
+					Custom testtypes.String `tfsdk:"custom"`
+				} `tfsdk:"block"`
+			}{},
+		},
+		"invalid": {
+			model: struct {
+				ID     types.Int64 `tfsdk:"id"`
+				Tags   []bool      `tfsdk:"tags"`
+				Nested struct{}    `tfsdk:"nested"`
+			}{},
+			expected: diag.Diagnostics{
+				mismatchDiagnostic("block: schema attribute has no model field"),
+				mismatchDiagnostic("id: model type basetypes.Int64Value cannot hold schema value type basetypes.StringValue"),
+				mismatchDiagnostic("nested.value: schema attribute has no model field"),
+				mismatchDiagnostic("tags: model type bool is incompatible with schema type tftypes.String"),
+			},
+		},
+		"nil": {
+			model: nil,
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Model",
+					"The model must be a struct or pointer to struct, got nil. This is always an error in the provider.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := s.ValidateModel(context.Background(), testCase.model)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+type customStringType struct {
+	basetypes.StringType
+}
+
+func (t customStringType) Equal(o attr.Type) bool {
+	_, ok := o.(customStringType)
+
+	return ok
+}
+
+type customStringValue struct {
+	basetypes.StringValue
+}
+
+func (v customStringValue) Type(_ context.Context) attr.Type {
+	return customStringType{}
+}
+
+type customListType struct {
+	types.ListType
+}
+
+func (t customListType) Equal(o attr.Type) bool {
+	other, ok := o.(customListType)
+
+	return ok && t.ListType.Equal(other.ListType)
+}
+
+type customListValue struct {
+	basetypes.ListValue
+}
+
+func (v customListValue) Type(_ context.Context) attr.Type {
+	return customListType{ListType: types.ListType{ElemType: types.StringType}}
+}
+
+func invalidModelDiagnostic(detail string) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid Schema Model",
+		"Unable to derive the schema from the model type. This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+detail,
+	)
+}
+
+func mismatchDiagnostic(detail string) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Schema Model Mismatch",
+		"The schema and its Go model type do not agree. This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+detail,
+	)
+}
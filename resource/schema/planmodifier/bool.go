@@ -56,7 +56,8 @@ type BoolRequest struct {
 	// attributes.
 	//
 	// Use the GetKey method to read data. Use the SetKey method on
-	// BoolResponse.Private to update or remove a value.
+	// BoolResponse.Private to update or remove a value. The resource/private
+	// package Key type provides typed access to values.
 	Private *privatestate.ProviderData
 }
 
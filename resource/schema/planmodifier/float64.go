@@ -56,7 +56,8 @@ type Float64Request struct {
 	// attributes.
 	//
 	// Use the GetKey method to read data. Use the SetKey method on
-	// Float64Response.Private to update or remove a value.
+	// Float64Response.Private to update or remove a value. The resource/private
+	// package Key type provides typed access to values.
 	Private *privatestate.ProviderData
 }
 
@@ -56,7 +56,8 @@ type ListRequest struct {
 	// attributes.
 	//
 	// Use the GetKey method to read data. Use the SetKey method on
-	// ListResponse.Private to update or remove a value.
+	// ListResponse.Private to update or remove a value. The resource/private
+	// package Key type provides typed access to values.
 	Private *privatestate.ProviderData
 }
 
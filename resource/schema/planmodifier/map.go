@@ -56,7 +56,8 @@ type MapRequest struct {
 	// attributes.
 	//
 	// Use the GetKey method to read data. Use the SetKey method on
-	// MapResponse.Private to update or remove a value.
+	// MapResponse.Private to update or remove a value. The resource/private
+	// package Key type provides typed access to values.
 	Private *privatestate.ProviderData
 }
 
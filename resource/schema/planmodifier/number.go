@@ -56,7 +56,8 @@ type NumberRequest struct {
 	// attributes.
 	//
 	// Use the GetKey method to read data. Use the SetKey method on
-	// NumberResponse.Private to update or remove a value.
+	// NumberResponse.Private to update or remove a value. The resource/private
+	// package Key type provides typed access to values.
 	Private *privatestate.ProviderData
 }
 
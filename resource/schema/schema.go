@@ -10,11 +10,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
 // Schema must satify the fwschema.Schema interface.
-var _ fwschema.Schema = Schema{}
+var (
+	_ fwschema.Schema                         = Schema{}
+	_ fwxschema.SchemaWithComputedValuePolicy = Schema{}
+	_ fwxschema.SchemaWithStateShapeDetection = Schema{}
+)
 
 // Schema defines the structure and value types of resource data. This type
 // is used as the resource.SchemaResponse type Schema field, which is
@@ -68,6 +73,35 @@ type Schema struct {
 	//
 	// Versions are conventionally only incremented by one each release.
 	Version int64
+
+	// ComputedValuePolicy determines how Computed attribute values, which are
+	// null in the configuration, are planned when the resource has changes.
+	// Attributes without their own policy inherit this policy. If unset, the
+	// values are marked as unknown, which is ComputedValuePolicyVolatile.
+	//
+	// Set this field to ComputedValuePolicyPreserve when most Computed
+	// attributes, such as identifiers and creation timestamps, do not change
+	// after creation, and set ComputedValuePolicyVolatile on the attributes
+	// which can change during any update.
+	ComputedValuePolicy ComputedValuePolicy
+
+	// DetectStateShapeChanges enables a development safeguard against
+	// changes which affect saved state, such as attribute type or nesting
+	// changes, without increasing the Version. When enabled, the framework
+	// saves the Version and hashes of the state-affecting parts of the
+	// schema in the resource private state and compares them during read
+	// and plan operations. The hashes are saved after create and update
+	// operations, and during read operations only if none were saved or the
+	// state was upgraded with a StateUpgrader.
+	//
+	// A warning diagnostic is returned when the type or nesting mode of a
+	// saved attribute differs while the Version is unchanged, or the Version
+	// was increased without a StateUpgrader for the saved version. Removed
+	// attributes are not reported, as they are dropped from saved state
+	// without a Version increase. Use the
+	// providerserver.ValidateResourceStateShape function to check saved
+	// private state in provider tests.
+	DetectStateShapeChanges bool
 }
 
 // ApplyTerraform5AttributePathStep applies the given AttributePathStep to the
@@ -100,6 +134,11 @@ func (s Schema) GetBlocks() map[string]fwschema.Block {
 	return schemaBlocks(s.Blocks)
 }
 
+// GetComputedValuePolicy returns the ComputedValuePolicy field value.
+func (s Schema) GetComputedValuePolicy() ComputedValuePolicy {
+	return s.ComputedValuePolicy
+}
+
 // GetDeprecationMessage returns the DeprecationMessage field value.
 func (s Schema) GetDeprecationMessage() string {
 	return s.DeprecationMessage
@@ -110,6 +149,11 @@ func (s Schema) GetDescription() string {
 	return s.Description
 }
 
+// GetDetectStateShapeChanges returns the DetectStateShapeChanges field value.
+func (s Schema) GetDetectStateShapeChanges() bool {
+	return s.DetectStateShapeChanges
+}
+
 // GetMarkdownDescription returns the MarkdownDescription field value.
 func (s Schema) GetMarkdownDescription() string {
 	return s.MarkdownDescription
@@ -135,7 +179,8 @@ func (s Schema) TypeAtTerraformPath(ctx context.Context, p *tftypes.AttributePat
 	return fwschema.SchemaTypeAtTerraformPath(ctx, s, p)
 }
 
-// Validate verifies that the schema is not using a reserved field name for a top-level attribute.
+// Validate verifies that the schema is not using a reserved field name for a top-level attribute,
+// that field names are valid, and that set nested key attributes exist.
 func (s Schema) Validate() diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -216,6 +261,10 @@ func validateAttributeFieldName(path path.Path, name string, attr fwschema.Attri
 
 			diags.Append(d...)
 		}
+
+		if aWithKeyAttributes, ok := attr.(fwxschema.AttributeWithKeyAttributes); ok {
+			diags.Append(validateKeyAttributes(path, aWithKeyAttributes.GetKeyAttributes(), attributes)...)
+		}
 	}
 
 	return diags
@@ -256,6 +305,43 @@ func validateBlockFieldName(path path.Path, name string, b fwschema.Block) diag.
 		diags.Append(d...)
 	}
 
+	if bWithKeyAttributes, ok := b.(fwxschema.BlockWithKeyAttributes); ok {
+		diags.Append(validateKeyAttributes(path, bWithKeyAttributes.GetKeyAttributes(), attributes)...)
+	}
+
+	return diags
+}
+
+// validateKeyAttributes verifies that the key attributes of a set nested
+// attribute or block are primitive attributes of the nested object, otherwise
+// no set elements would be correlated during plan modification.
+func validateKeyAttributes(path path.Path, keyAttributes []string, attributes map[string]fwschema.Attribute) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, name := range keyAttributes {
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddAttributeError(
+				path,
+				"Invalid Schema Key Attribute",
+				fmt.Sprintf("Key attribute %q is not an attribute of the nested object. This is always a problem with the provider and should be reported to the provider developer.", name),
+			)
+
+			continue
+		}
+
+		typ := attribute.GetType().TerraformType(context.Background())
+
+		if !typ.Is(tftypes.Bool) && !typ.Is(tftypes.Number) && !typ.Is(tftypes.String) {
+			diags.AddAttributeError(
+				path,
+				"Invalid Schema Key Attribute",
+				fmt.Sprintf("Key attribute %q must be a bool, number, or string attribute. This is always a problem with the provider and should be reported to the provider developer.", name),
+			)
+		}
+	}
+
 	return diags
 }
 
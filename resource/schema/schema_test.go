@@ -515,6 +515,40 @@ func TestSchemaGetBlocks(t *testing.T) {
 	}
 }
 
+func TestSchemaGetComputedValuePolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema   schema.Schema
+		expected schema.ComputedValuePolicy
+	}{
+		"unset": {
+			schema:   schema.Schema{},
+			expected: schema.ComputedValuePolicyUnset,
+		},
+		"preserve": {
+			schema: schema.Schema{
+				ComputedValuePolicy: schema.ComputedValuePolicyPreserve,
+			},
+			expected: schema.ComputedValuePolicyPreserve,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.schema.GetComputedValuePolicy()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestSchemaGetDeprecationMessage(t *testing.T) {
 	t.Parallel()
 
@@ -591,6 +625,40 @@ func TestSchemaGetDescription(t *testing.T) {
 	}
 }
 
+func TestSchemaGetDetectStateShapeChanges(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema   schema.Schema
+		expected bool
+	}{
+		"unset": {
+			schema:   schema.Schema{},
+			expected: false,
+		},
+		"enabled": {
+			schema: schema.Schema{
+				DetectStateShapeChanges: true,
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.schema.GetDetectStateShapeChanges()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestSchemaGetMarkdownDescription(t *testing.T) {
 	t.Parallel()
 
@@ -1114,6 +1182,89 @@ func TestSchemaValidate(t *testing.T) {
 				),
 			},
 		},
+		"set-nested-attribute-key-attributes": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"set_nested_attribute": schema.SetNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{},
+								"id":   schema.StringAttribute{},
+							},
+						},
+						KeyAttributes: []string{"name"},
+					},
+				},
+			},
+		},
+		"set-nested-attribute-key-attributes-unknown": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"set_nested_attribute": schema.SetNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{},
+							},
+						},
+						KeyAttributes: []string{"nmae"},
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("set_nested_attribute"),
+					"Invalid Schema Key Attribute",
+					`Key attribute "nmae" is not an attribute of the nested object. This is always a problem with the provider and should be reported to the provider developer.`,
+				),
+			},
+		},
+		"set-nested-attribute-key-attributes-non-primitive": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"set_nested_attribute": schema.SetNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"names": schema.ListAttribute{
+									ElementType: types.StringType,
+								},
+							},
+						},
+						KeyAttributes: []string{"names"},
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("set_nested_attribute"),
+					"Invalid Schema Key Attribute",
+					`Key attribute "names" must be a bool, number, or string attribute. This is always a problem with the provider and should be reported to the provider developer.`,
+				),
+			},
+		},
+		"set-nested-block-key-attributes-unknown": {
+			schema: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"set_nested_block": schema.SetNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{},
+							},
+							Blocks: map[string]schema.Block{
+								"nested": schema.SingleNestedBlock{},
+							},
+						},
+						KeyAttributes: []string{"name", "nested"},
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("set_nested_block"),
+					"Invalid Schema Key Attribute",
+					`Key attribute "nested" is not an attribute of the nested object. This is always a problem with the provider and should be reported to the provider developer.`,
+				),
+			},
+		},
 		"attribute-using-invalid-field-name": {
 			schema: schema.Schema{
 				Attributes: map[string]schema.Attribute{
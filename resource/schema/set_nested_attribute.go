@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -16,9 +17,12 @@ import (
 
 // Ensure the implementation satisifies the desired interfaces.
 var (
-	_ NestedAttribute                         = SetNestedAttribute{}
-	_ fwxschema.AttributeWithSetPlanModifiers = SetNestedAttribute{}
-	_ fwxschema.AttributeWithSetValidators    = SetNestedAttribute{}
+	_ NestedAttribute                             = SetNestedAttribute{}
+	_ fwxschema.AttributeWithComputedDependencies = SetNestedAttribute{}
+	_ fwxschema.AttributeWithComputedValuePolicy  = SetNestedAttribute{}
+	_ fwxschema.AttributeWithKeyAttributes        = SetNestedAttribute{}
+	_ fwxschema.AttributeWithSetPlanModifiers     = SetNestedAttribute{}
+	_ fwxschema.AttributeWithSetValidators        = SetNestedAttribute{}
 )
 
 // SetNestedAttribute represents an attribute that is a set of objects where
@@ -153,6 +157,41 @@ type SetNestedAttribute struct {
 	//
 	// Any errors will prevent further execution of this sequence or modifiers.
 	PlanModifiers []planmodifier.Set
+
+	// ComputedValuePolicy determines how the value is planned when it is
+	// Computed, null in the configuration, and the resource has changes.
+	// Refer to the ComputedValuePolicy type documentation for details. If
+	// unset, the policy of the parent nested attribute or Schema is used.
+	// Nested attributes without their own policy inherit this policy.
+	ComputedValuePolicy ComputedValuePolicy
+
+	// ComputedDependencies defines the attributes which, when changed in the
+	// plan, cause the value to be unknown in the plan when it is Computed
+	// and null in the configuration. Otherwise, the prior state value is
+	// kept, regardless of the ComputedValuePolicy. Relative expressions, such
+	// as path.MatchRelative().AtParent().AtName("other_attribute"), are
+	// merged with the path of this attribute.
+	//
+	// This is a declarative alternative to resource-level plan modification
+	// for values, such as an endpoint, which change when other attributes,
+	// such as an engine version, change.
+	ComputedDependencies path.Expressions
+
+	// KeyAttributes is the names of the NestedObject attributes which
+	// identify a set element, such as a name or an identifier which is
+	// Required in the configuration. Set elements are identified by their
+	// entire value, so a changed value for one attribute is otherwise a
+	// different element. Each name must be a bool, number, or string
+	// attribute of the NestedObject, otherwise the schema is invalid.
+	//
+	// When set, plan modifiers of the NestedObject receive the configuration
+	// and prior state elements with key attribute values equal to those of the
+	// planned element, which enables plan modifiers such as
+	// UseStateForUnknown to work with Computed attributes of set elements.
+	// Elements with null or unknown key attribute values, or without equal
+	// key attribute values, receive null configuration and prior state
+	// values. Otherwise, elements are correlated by their order in the set.
+	KeyAttributes []string
 }
 
 // ApplyTerraform5AttributePathStep returns the Attributes field value if step
@@ -177,6 +216,16 @@ func (a SetNestedAttribute) Equal(o fwschema.Attribute) bool {
 	return fwschema.AttributesEqual(a, o)
 }
 
+// GetComputedDependencies returns the ComputedDependencies field value.
+func (a SetNestedAttribute) GetComputedDependencies() path.Expressions {
+	return a.ComputedDependencies
+}
+
+// GetComputedValuePolicy returns the ComputedValuePolicy field value.
+func (a SetNestedAttribute) GetComputedValuePolicy() ComputedValuePolicy {
+	return a.ComputedValuePolicy
+}
+
 // GetDeprecationMessage returns the DeprecationMessage field value.
 func (a SetNestedAttribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
@@ -187,6 +236,11 @@ func (a SetNestedAttribute) GetDescription() string {
 	return a.Description
 }
 
+// GetKeyAttributes returns the KeyAttributes field value.
+func (a SetNestedAttribute) GetKeyAttributes() []string {
+	return a.KeyAttributes
+}
+
 // GetMarkdownDescription returns the MarkdownDescription field value.
 func (a SetNestedAttribute) GetMarkdownDescription() string {
 	return a.MarkdownDescription
@@ -16,6 +16,7 @@ import (
 // Ensure the implementation satisifies the desired interfaces.
 var (
 	_ Block                               = SetNestedBlock{}
+	_ fwxschema.BlockWithKeyAttributes    = SetNestedBlock{}
 	_ fwxschema.BlockWithSetPlanModifiers = SetNestedBlock{}
 	_ fwxschema.BlockWithSetValidators    = SetNestedBlock{}
 )
@@ -138,6 +139,22 @@ type SetNestedBlock struct {
 	//
 	// Any errors will prevent further execution of this sequence or modifiers.
 	PlanModifiers []planmodifier.Set
+
+	// KeyAttributes is the names of the NestedObject attributes which
+	// identify a set element, such as a name or an identifier which is
+	// Required in the configuration. Set elements are identified by their
+	// entire value, so a changed value for one attribute is otherwise a
+	// different element. Each name must be a bool, number, or string
+	// attribute of the NestedObject, otherwise the schema is invalid.
+	//
+	// When set, plan modifiers of the NestedObject receive the configuration
+	// and prior state elements with key attribute values equal to those of the
+	// planned element, which enables plan modifiers such as
+	// UseStateForUnknown to work with Computed attributes of set elements.
+	// Elements with null or unknown key attribute values, or without equal
+	// key attribute values, receive null configuration and prior state
+	// values. Otherwise, elements are correlated by their order in the set.
+	KeyAttributes []string
 }
 
 // ApplyTerraform5AttributePathStep returns the NestedObject field value if step
@@ -172,6 +189,11 @@ func (b SetNestedBlock) GetDescription() string {
 	return b.Description
 }
 
+// GetKeyAttributes returns the KeyAttributes field value.
+func (b SetNestedBlock) GetKeyAttributes() []string {
+	return b.KeyAttributes
+}
+
 // GetMarkdownDescription returns the MarkdownDescription field value.
 func (b SetNestedBlock) GetMarkdownDescription() string {
 	return b.MarkdownDescription
@@ -258,6 +258,40 @@ func TestSetNestedBlockGetDescription(t *testing.T) {
 	}
 }
 
+func TestSetNestedBlockGetKeyAttributes(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		block    schema.SetNestedBlock
+		expected []string
+	}{
+		"no-key-attributes": {
+			block:    schema.SetNestedBlock{},
+			expected: nil,
+		},
+		"key-attributes": {
+			block: schema.SetNestedBlock{
+				KeyAttributes: []string{"testattr"},
+			},
+			expected: []string{"testattr"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.block.GetKeyAttributes()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestSetNestedBlockGetMarkdownDescription(t *testing.T) {
 	t.Parallel()
 
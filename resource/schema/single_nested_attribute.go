@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema/fwxschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -16,9 +17,11 @@ import (
 
 // Ensure the implementation satisifies the desired interfaces.
 var (
-	_ NestedAttribute                            = SingleNestedAttribute{}
-	_ fwxschema.AttributeWithObjectPlanModifiers = SingleNestedAttribute{}
-	_ fwxschema.AttributeWithObjectValidators    = SingleNestedAttribute{}
+	_ NestedAttribute                             = SingleNestedAttribute{}
+	_ fwxschema.AttributeWithComputedDependencies = SingleNestedAttribute{}
+	_ fwxschema.AttributeWithComputedValuePolicy  = SingleNestedAttribute{}
+	_ fwxschema.AttributeWithObjectPlanModifiers  = SingleNestedAttribute{}
+	_ fwxschema.AttributeWithObjectValidators     = SingleNestedAttribute{}
 )
 
 // SingleNestedAttribute represents an attribute that is a single object where
@@ -152,6 +155,25 @@ type SingleNestedAttribute struct {
 	//
 	// Any errors will prevent further execution of this sequence or modifiers.
 	PlanModifiers []planmodifier.Object
+
+	// ComputedValuePolicy determines how the value is planned when it is
+	// Computed, null in the configuration, and the resource has changes.
+	// Refer to the ComputedValuePolicy type documentation for details. If
+	// unset, the policy of the parent nested attribute or Schema is used.
+	// Nested attributes without their own policy inherit this policy.
+	ComputedValuePolicy ComputedValuePolicy
+
+	// ComputedDependencies defines the attributes which, when changed in the
+	// plan, cause the value to be unknown in the plan when it is Computed
+	// and null in the configuration. Otherwise, the prior state value is
+	// kept, regardless of the ComputedValuePolicy. Relative expressions, such
+	// as path.MatchRelative().AtParent().AtName("other_attribute"), are
+	// merged with the path of this attribute.
+	//
+	// This is a declarative alternative to resource-level plan modification
+	// for values, such as an endpoint, which change when other attributes,
+	// such as an engine version, change.
+	ComputedDependencies path.Expressions
 }
 
 // ApplyTerraform5AttributePathStep returns the Attributes field value if step
@@ -187,6 +209,16 @@ func (a SingleNestedAttribute) GetAttributes() fwschema.UnderlyingAttributes {
 	return schemaAttributes(a.Attributes)
 }
 
+// GetComputedDependencies returns the ComputedDependencies field value.
+func (a SingleNestedAttribute) GetComputedDependencies() path.Expressions {
+	return a.ComputedDependencies
+}
+
+// GetComputedValuePolicy returns the ComputedValuePolicy field value.
+func (a SingleNestedAttribute) GetComputedValuePolicy() ComputedValuePolicy {
+	return a.ComputedValuePolicy
+}
+
 // GetDeprecationMessage returns the DeprecationMessage field value.
 func (a SingleNestedAttribute) GetDeprecationMessage() string {
 	return a.DeprecationMessage
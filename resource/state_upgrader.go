@@ -33,4 +33,29 @@ type StateUpgrader struct {
 	// The UpgradeStateResponse parameter should contain the upgraded
 	// state data and can be used to signal any logic warnings or errors.
 	StateUpgrader func(context.Context, UpgradeStateRequest, *UpgradeStateResponse)
+
+	// TargetVersion is the schema version of the state returned by the
+	// StateUpgrader. If unset or set to the current schema version, the
+	// StateUpgrader must upgrade the prior state to the current schema
+	// version.
+	//
+	// Setting this to an intermediate version enables chained state upgrades,
+	// so each StateUpgrader only needs to upgrade the state by one version,
+	// such as from version 0 to 1, instead of directly to the current schema
+	// version. After this StateUpgrader is called, the StateUpgrader for the
+	// target version is called with the upgraded state, and so on, until the
+	// state is upgraded to the current schema version. The StateUpgrader for
+	// the target version must set PriorSchema, which is used as the schema of
+	// the UpgradeStateResponse State field of this StateUpgrader. Errors
+	// during a chained upgrade identify the failing step, such as the
+	// upgrade from version 0 to 1.
+	//
+	// StateUpgraders which leave TargetVersion unset are unaffected by
+	// chaining, so existing implementations continue to upgrade directly to
+	// the current schema version. When increasing the schema version, an
+	// existing StateUpgrader can instead set TargetVersion to the prior
+	// current schema version, so only a StateUpgrader from that version to
+	// the new schema version needs to be added. That new StateUpgrader must
+	// set PriorSchema.
+	TargetVersion int64
 }
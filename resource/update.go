@@ -1,8 +1,12 @@
 package resource
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
 
@@ -32,10 +36,28 @@ type UpdateRequest struct {
 	// UpdateResponse.Private to prevent accidental private state data loss.
 	//
 	// Use the GetKey method to read data. Use the SetKey method on
-	// UpdateResponse.Private to update or remove a value.
+	// UpdateResponse.Private to update or remove a value. The resource/private
+	// package Key type provides typed access to values.
 	Private *privatestate.ProviderData
 }
 
+// HasChange returns true if the planned value at the given path differs from
+// the prior state value, including an unknown planned value. Use the DiffPlan
+// method of State to find all changes.
+func (r UpdateRequest) HasChange(ctx context.Context, p path.Path) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var stateValue, planValue attr.Value
+
+	diags.Append(r.State.GetAttribute(ctx, p, &stateValue)...)
+	diags.Append(r.Plan.GetAttribute(ctx, p, &planValue)...)
+
+	if diags.HasError() {
+		return false, diags
+	}
+
+	return !stateValue.Equal(planValue), diags
+}
+
 // UpdateResponse represents a response to an UpdateRequest. An
 // instance of this response struct is supplied as
 // an argument to the resource's Update function, in which the provider
@@ -21,6 +21,12 @@ func (c Config) Get(ctx context.Context, target interface{}) diag.Diagnostics {
 	return c.data().Get(ctx, target)
 }
 
+// GetWithOptions populates the struct passed as `target` with the entire
+// config, using `opts` to control how values are converted.
+func (c Config) GetWithOptions(ctx context.Context, target interface{}, opts GetOptions) diag.Diagnostics {
+	return c.data().GetWithOptions(ctx, target, opts.reflectOptions())
+}
+
 // GetAttribute retrieves the attribute or block found at `path` and populates
 // the `target` with the value. This method is intended for top level schema
 // attributes or blocks. Use `types` package methods or custom types to step
@@ -32,6 +38,13 @@ func (c Config) GetAttribute(ctx context.Context, path path.Path, target interfa
 	return c.data().GetAtPath(ctx, path, target)
 }
 
+// GetAttributeWithOptions retrieves the attribute or block found at `path`
+// and populates the `target` with the value, using `opts` to control how
+// values are converted. It otherwise behaves like GetAttribute.
+func (c Config) GetAttributeWithOptions(ctx context.Context, path path.Path, target interface{}, opts GetOptions) diag.Diagnostics {
+	return c.data().GetAtPathWithOptions(ctx, path, target, opts.reflectOptions())
+}
+
 // PathMatches returns all matching path.Paths from the given path.Expression.
 //
 // If a parent path is null or unknown, which would prevent a full expression
@@ -197,6 +197,129 @@ func TestConfigGetAttribute(t *testing.T) {
 	}
 }
 
+func TestConfigGetWithOptions(t *testing.T) {
+	t.Parallel()
+
+	config := tfsdk.Config{
+		Raw: tftypes.NewValue(
+			tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"id":   tftypes.String,
+					"name": tftypes.String,
+				},
+			},
+			map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, "test-id"),
+				"name": tftypes.NewValue(tftypes.String, nil),
+			},
+		),
+		Schema: testschema.Schema{
+			Attributes: map[string]fwschema.Attribute{
+				"id": testschema.Attribute{
+					Computed: true,
+					Type:     types.StringType,
+				},
+				"name": testschema.Attribute{
+					Optional: true,
+					Type:     types.StringType,
+				},
+			},
+		},
+	}
+
+	type model struct {
+		Name  string `tfsdk:"name"`
+		Extra string `tfsdk:"extra"`
+	}
+
+	testCases := map[string]struct {
+		opts          tfsdk.GetOptions
+		expected      model
+		expectedDiags diag.Diagnostics
+	}{
+		// Refer to internal/reflect tests for more exhaustive unit testing.
+		// These test cases are to ensure options are passed appropriately
+		// to the shared implementation.
+		"ignore-unmapped": {
+			opts: tfsdk.GetOptions{
+				IgnoreUnmappedAttributes: true,
+				IgnoreUnmappedFields:     true,
+				UnhandledNullAsEmpty:     true,
+			},
+			expected: model{},
+		},
+		"unhandled-null": {
+			opts: tfsdk.GetOptions{
+				IgnoreUnmappedAttributes: true,
+				IgnoreUnmappedFields:     true,
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("name"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Received null value, however the target type cannot handle null values. Use the corresponding `types` package type, a pointer type or a custom type that handles null values.\n\n"+
+						"Path: name\nTarget Type: string\nSuggested `types` Type: basetypes.StringValue\nSuggested Pointer Type: *string",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got model
+
+			diags := config.GetWithOptions(context.Background(), &got, testCase.opts)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestConfigGetAttributeWithOptions(t *testing.T) {
+	t.Parallel()
+
+	config := tfsdk.Config{
+		Raw: tftypes.NewValue(tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"name": tftypes.String,
+			},
+		}, map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, nil),
+		}),
+		Schema: testschema.Schema{
+			Attributes: map[string]fwschema.Attribute{
+				"name": testschema.Attribute{
+					Type:     types.StringType,
+					Optional: true,
+				},
+			},
+		},
+	}
+
+	got := "previous"
+
+	diags := config.GetAttributeWithOptions(context.Background(), path.Root("name"), &got, tfsdk.GetOptions{UnhandledNullAsEmpty: true})
+
+	if len(diags) > 0 {
+		t.Fatalf("unexpected diagnostics: %s", diags)
+	}
+
+	if got != "" {
+		t.Errorf("expected empty string, got %q", got)
+	}
+}
+
 func TestConfigPathMatches(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,49 @@
+package tfsdk
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+)
+
+// GetOptions controls how values are converted into Go types by the
+// GetWithOptions and GetAttributeWithOptions methods of Config, Plan, and
+// State, and by ValueAsWithOptions. The zero value is the behavior of the
+// methods without options.
+type GetOptions struct {
+	// UnhandledNullAsEmpty controls what happens when a null value needs
+	// to be stored in a Go type that has no way to preserve that
+	// distinction. When set to true, the type's empty value will be used.
+	// When set to false, an error will be returned.
+	UnhandledNullAsEmpty bool
+
+	// UnhandledUnknownAsEmpty controls what happens when an unknown value
+	// needs to be stored in a Go type that has no way to preserve that
+	// distinction. When set to true, the type's empty value will be used.
+	// When set to false, an error will be returned.
+	UnhandledUnknownAsEmpty bool
+
+	// AllowRoundingNumbers silently rounds numbers that don't fit
+	// perfectly in the Go types they're being stored in, rather than
+	// returning errors. Numbers will always be rounded towards 0.
+	AllowRoundingNumbers bool
+
+	// IgnoreUnmappedAttributes skips object attributes which have no
+	// struct field with a matching "tfsdk" tag, rather than returning
+	// errors. This allows a struct to model only the attributes it needs.
+	IgnoreUnmappedAttributes bool
+
+	// IgnoreUnmappedFields leaves struct fields whose "tfsdk" tag doesn't
+	// match an object attribute at their zero value, rather than returning
+	// errors. This allows a struct to be shared between objects which
+	// have only some of its attributes.
+	IgnoreUnmappedFields bool
+}
+
+func (o GetOptions) reflectOptions() reflect.Options {
+	return reflect.Options{
+		UnhandledNullAsEmpty:           o.UnhandledNullAsEmpty,
+		UnhandledUnknownAsEmpty:        o.UnhandledUnknownAsEmpty,
+		AllowRoundingNumbers:           o.AllowRoundingNumbers,
+		IgnoreUnmappedObjectAttributes: o.IgnoreUnmappedAttributes,
+		IgnoreUnmappedStructFields:     o.IgnoreUnmappedFields,
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -16,11 +17,25 @@ type Plan struct {
 	Schema fwschema.Schema
 }
 
+// Diff returns the differences from the plan to the given plan. Paths are
+// from the schema root. Known list, map, and object values are compared by
+// element or attribute, while set elements are compared by value, so a
+// changed set element is returned as a removed and an added element.
+func (p Plan) Diff(ctx context.Context, after Plan) ([]basetypes.ValueDiff, diag.Diagnostics) {
+	return p.data().Diff(ctx, after.data())
+}
+
 // Get populates the struct passed as `target` with the entire plan.
 func (p Plan) Get(ctx context.Context, target interface{}) diag.Diagnostics {
 	return p.data().Get(ctx, target)
 }
 
+// GetWithOptions populates the struct passed as `target` with the entire
+// plan, using `opts` to control how values are converted.
+func (p Plan) GetWithOptions(ctx context.Context, target interface{}, opts GetOptions) diag.Diagnostics {
+	return p.data().GetWithOptions(ctx, target, opts.reflectOptions())
+}
+
 // GetAttribute retrieves the attribute or block found at `path` and populates
 // the `target` with the value. This method is intended for top level schema
 // attributes or blocks. Use `types` package methods or custom types to step
@@ -32,6 +47,13 @@ func (p Plan) GetAttribute(ctx context.Context, path path.Path, target interface
 	return p.data().GetAtPath(ctx, path, target)
 }
 
+// GetAttributeWithOptions retrieves the attribute or block found at `path`
+// and populates the `target` with the value, using `opts` to control how
+// values are converted. It otherwise behaves like GetAttribute.
+func (p Plan) GetAttributeWithOptions(ctx context.Context, path path.Path, target interface{}, opts GetOptions) diag.Diagnostics {
+	return p.data().GetAtPathWithOptions(ctx, path, target, opts.reflectOptions())
+}
+
 // PathMatches returns all matching path.Paths from the given path.Expression.
 //
 // If a parent path is null or unknown, which would prevent a full expression
@@ -82,8 +104,8 @@ func (p *Plan) SetAttribute(ctx context.Context, path path.Path, val interface{}
 	return diags
 }
 
-func (p Plan) data() *fwschemadata.Data {
-	return &fwschemadata.Data{
+func (p Plan) data() fwschemadata.Data {
+	return fwschemadata.Data{
 		Description:    fwschemadata.DataDescriptionPlan,
 		Schema:         p.Schema,
 		TerraformValue: p.Raw,
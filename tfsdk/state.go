@@ -5,9 +5,11 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -17,11 +19,33 @@ type State struct {
 	Schema fwschema.Schema
 }
 
+// Diff returns the differences from the state to the given state, such as
+// the prior state and the new state of a resource in Read. Paths are from the
+// schema root. Known list, map, and object values are compared by element or
+// attribute, while set elements are compared by value, so a changed set
+// element is returned as a removed and an added element.
+func (s State) Diff(ctx context.Context, after State) ([]basetypes.ValueDiff, diag.Diagnostics) {
+	return s.data().Diff(ctx, after.data())
+}
+
+// DiffPlan returns the differences from the state to the given plan, such as
+// the prior state and the planned state of a resource in Update. It otherwise
+// behaves like Diff.
+func (s State) DiffPlan(ctx context.Context, plan Plan) ([]basetypes.ValueDiff, diag.Diagnostics) {
+	return s.data().Diff(ctx, plan.data())
+}
+
 // Get populates the struct passed as `target` with the entire state.
 func (s State) Get(ctx context.Context, target interface{}) diag.Diagnostics {
 	return s.data().Get(ctx, target)
 }
 
+// GetWithOptions populates the struct passed as `target` with the entire
+// state, using `opts` to control how values are converted.
+func (s State) GetWithOptions(ctx context.Context, target interface{}, opts GetOptions) diag.Diagnostics {
+	return s.data().GetWithOptions(ctx, target, opts.reflectOptions())
+}
+
 // GetAttribute retrieves the attribute or block found at `path` and populates
 // the `target` with the value. This method is intended for top level schema
 // attributes or blocks. Use `types` package methods or custom types to step
@@ -33,6 +57,13 @@ func (s State) GetAttribute(ctx context.Context, path path.Path, target interfac
 	return s.data().GetAtPath(ctx, path, target)
 }
 
+// GetAttributeWithOptions retrieves the attribute or block found at `path`
+// and populates the `target` with the value, using `opts` to control how
+// values are converted. It otherwise behaves like GetAttribute.
+func (s State) GetAttributeWithOptions(ctx context.Context, path path.Path, target interface{}, opts GetOptions) diag.Diagnostics {
+	return s.data().GetAtPathWithOptions(ctx, path, target, opts.reflectOptions())
+}
+
 // PathMatches returns all matching path.Paths from the given path.Expression.
 //
 // If a parent path is null or unknown, which would prevent a full expression
@@ -93,6 +124,118 @@ func (s *State) SetAttribute(ctx context.Context, path path.Path, val interface{
 	return diags
 }
 
+// SetFromJSON populates the entire state from JSON in the Terraform JSON state
+// representation of resource attributes, such as the "attributes" of a
+// resource instance in a state file or the "values" of a resource in the
+// output of terraform show -json. This is intended for loading test fixtures.
+// Refer to basetypes.ValueFromJSON for details. Terraform state cannot
+// contain unknown values, so an error diagnostic is returned if the JSON
+// has any.
+func (s *State) SetFromJSON(ctx context.Context, data []byte) diag.Diagnostics {
+	value, diags := basetypes.ValueFromJSON(ctx, data, s.Schema.Type())
+
+	if diags.HasError() {
+		return diags
+	}
+
+	raw, err := value.ToTerraformValue(ctx)
+
+	if err != nil {
+		diags.AddError(
+			"State Write Error",
+			"An unexpected error was encountered trying to write the state. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return diags
+	}
+
+	diags.Append(s.unknownValueDiagnostics(ctx, raw)...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	s.Raw = raw
+
+	return diags
+}
+
+// ToJSON returns the entire state as JSON in the Terraform JSON state
+// representation of resource attributes, which can be loaded with
+// SetFromJSON. Refer to basetypes.ValueToJSON for details. Terraform state
+// cannot contain unknown values, so an error diagnostic is returned if the
+// state has any.
+func (s State) ToJSON(ctx context.Context) ([]byte, diag.Diagnostics) {
+	diags := s.unknownValueDiagnostics(ctx, s.Raw)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	value, valueDiags := s.data().ValueAtPath(ctx, path.Empty())
+
+	diags.Append(valueDiags...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	result, jsonDiags := basetypes.ValueToJSON(ctx, value)
+
+	diags.Append(jsonDiags...)
+
+	return result, diags
+}
+
+// unknownValueDiagnostics returns an error diagnostic for the first unknown
+// value found in the given state value, as Terraform state cannot contain
+// unknown values.
+func (s State) unknownValueDiagnostics(ctx context.Context, raw tftypes.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var unknownPath *tftypes.AttributePath
+
+	_ = tftypes.Walk(raw, func(tfPath *tftypes.AttributePath, value tftypes.Value) (bool, error) {
+		if unknownPath != nil {
+			return false, nil
+		}
+
+		if !value.IsKnown() {
+			unknownPath = tfPath
+
+			return false, nil
+		}
+
+		return true, nil
+	})
+
+	if unknownPath == nil {
+		return diags
+	}
+
+	summary := "Unknown State Value"
+	detail := "The state contains an unknown value, however Terraform state cannot contain unknown values. " +
+		"This is always an error in the provider. Please report the following to the provider developer:\n\n" +
+		"Unknown values must be set to known or null values before the state is converted to or from JSON."
+
+	if len(unknownPath.Steps()) == 0 {
+		diags.AddError(summary, detail)
+
+		return diags
+	}
+
+	attributePath, pathDiags := fromtftypes.AttributePath(ctx, unknownPath, s.Schema)
+
+	if pathDiags.HasError() {
+		diags.AddError(summary, detail+"\n\nPath: "+unknownPath.String())
+
+		return diags
+	}
+
+	diags.AddAttributeError(attributePath, summary, detail)
+
+	return diags
+}
+
 // RemoveResource removes the entire resource from state.
 //
 // If a Resource type Delete method is completed without error, this is
@@ -14,12 +14,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 func TestStateGet(t *testing.T) {
 	t.Parallel()
 
+	type EmbeddedModel struct {
+		ID types.String `tfsdk:"id"`
+	}
+
 	testCases := map[string]struct {
 		state         tfsdk.State
 		target        any
@@ -59,6 +64,47 @@ func TestStateGet(t *testing.T) {
 				String: types.StringValue("test"),
 			},
 		},
+		"embedded": {
+			state: tfsdk.State{
+				Raw: tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"id":     tftypes.String,
+							"string": tftypes.String,
+						},
+					},
+					map[string]tftypes.Value{
+						"id":     tftypes.NewValue(tftypes.String, "test-id"),
+						"string": tftypes.NewValue(tftypes.String, "test"),
+					},
+				),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"id": testschema.Attribute{
+							Computed: true,
+							Type:     types.StringType,
+						},
+						"string": testschema.Attribute{
+							Optional: true,
+							Type:     types.StringType,
+						},
+					},
+				},
+			},
+			target: new(struct {
+				*EmbeddedModel
+				String types.String `tfsdk:"string"`
+			}),
+			expected: &struct {
+				*EmbeddedModel
+				String types.String `tfsdk:"string"`
+			}{
+				EmbeddedModel: &EmbeddedModel{
+					ID: types.StringValue("test-id"),
+				},
+				String: types.StringValue("test"),
+			},
+		},
 		"diagnostic": {
 			state: tfsdk.State{
 				Raw: tftypes.NewValue(
@@ -197,9 +243,136 @@ func TestStateGetAttribute(t *testing.T) {
 	}
 }
 
+func TestStateGetWithOptions(t *testing.T) {
+	t.Parallel()
+
+	state := tfsdk.State{
+		Raw: tftypes.NewValue(
+			tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"id":   tftypes.String,
+					"name": tftypes.String,
+				},
+			},
+			map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, "test-id"),
+				"name": tftypes.NewValue(tftypes.String, nil),
+			},
+		),
+		Schema: testschema.Schema{
+			Attributes: map[string]fwschema.Attribute{
+				"id": testschema.Attribute{
+					Computed: true,
+					Type:     types.StringType,
+				},
+				"name": testschema.Attribute{
+					Optional: true,
+					Type:     types.StringType,
+				},
+			},
+		},
+	}
+
+	type model struct {
+		Name  string `tfsdk:"name"`
+		Extra string `tfsdk:"extra"`
+	}
+
+	testCases := map[string]struct {
+		opts          tfsdk.GetOptions
+		expected      model
+		expectedDiags diag.Diagnostics
+	}{
+		// Refer to internal/reflect tests for more exhaustive unit testing.
+		// These test cases are to ensure options are passed appropriately
+		// to the shared implementation.
+		"ignore-unmapped": {
+			opts: tfsdk.GetOptions{
+				IgnoreUnmappedAttributes: true,
+				IgnoreUnmappedFields:     true,
+				UnhandledNullAsEmpty:     true,
+			},
+			expected: model{},
+		},
+		"unhandled-null": {
+			opts: tfsdk.GetOptions{
+				IgnoreUnmappedAttributes: true,
+				IgnoreUnmappedFields:     true,
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("name"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Received null value, however the target type cannot handle null values. Use the corresponding `types` package type, a pointer type or a custom type that handles null values.\n\n"+
+						"Path: name\nTarget Type: string\nSuggested `types` Type: basetypes.StringValue\nSuggested Pointer Type: *string",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got model
+
+			diags := state.GetWithOptions(context.Background(), &got, testCase.opts)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestStateGetAttributeWithOptions(t *testing.T) {
+	t.Parallel()
+
+	state := tfsdk.State{
+		Raw: tftypes.NewValue(tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"name": tftypes.String,
+			},
+		}, map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, nil),
+		}),
+		Schema: testschema.Schema{
+			Attributes: map[string]fwschema.Attribute{
+				"name": testschema.Attribute{
+					Type:     types.StringType,
+					Optional: true,
+				},
+			},
+		},
+	}
+
+	got := "previous"
+
+	diags := state.GetAttributeWithOptions(context.Background(), path.Root("name"), &got, tfsdk.GetOptions{UnhandledNullAsEmpty: true})
+
+	if len(diags) > 0 {
+		t.Fatalf("unexpected diagnostics: %s", diags)
+	}
+
+	if got != "" {
+		t.Errorf("expected empty string, got %q", got)
+	}
+}
+
 func TestStateSet(t *testing.T) {
 	t.Parallel()
 
+	type EmbeddedModel struct {
+		ID string `tfsdk:"id"`
+	}
+
 	type testCase struct {
 		state         tfsdk.State
 		val           interface{}
@@ -242,6 +415,49 @@ func TestStateSet(t *testing.T) {
 				"name": tftypes.NewValue(tftypes.String, "newvalue"),
 			}),
 		},
+		"embedded": {
+			state: tfsdk.State{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"id":   tftypes.String,
+						"name": tftypes.String,
+					},
+				}, map[string]tftypes.Value{
+					"id":   tftypes.NewValue(tftypes.String, "oldid"),
+					"name": tftypes.NewValue(tftypes.String, "oldvalue"),
+				}),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"id": testschema.Attribute{
+							Type:     types.StringType,
+							Computed: true,
+						},
+						"name": testschema.Attribute{
+							Type:     types.StringType,
+							Required: true,
+						},
+					},
+				},
+			},
+			val: struct {
+				EmbeddedModel
+				Name string `tfsdk:"name"`
+			}{
+				EmbeddedModel: EmbeddedModel{
+					ID: "newid",
+				},
+				Name: "newvalue",
+			},
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"id":   tftypes.String,
+					"name": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, "newid"),
+				"name": tftypes.NewValue(tftypes.String, "newvalue"),
+			}),
+		},
 		"diagnostics": {
 			state: tfsdk.State{
 				Raw: tftypes.Value{},
@@ -389,3 +605,324 @@ func TestStateSetAttribute(t *testing.T) {
 		})
 	}
 }
+
+func TestStateGetSet_typedCollections(t *testing.T) {
+	t.Parallel()
+
+	type model struct {
+		List basetypes.ListValueOf[types.String]                        `tfsdk:"list"`
+		Set  basetypes.SetValueOf[types.Int64]                          `tfsdk:"set"`
+		Map  basetypes.MapValueOf[types.Bool]                           `tfsdk:"map"`
+		Null basetypes.ListValueOf[types.String]                        `tfsdk:"null"`
+		Nest basetypes.ListValueOf[basetypes.ListValueOf[types.String]] `tfsdk:"nest"`
+	}
+
+	tfType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"list": tftypes.List{ElementType: tftypes.String},
+			"set":  tftypes.Set{ElementType: tftypes.Number},
+			"map":  tftypes.Map{ElementType: tftypes.Bool},
+			"null": tftypes.List{ElementType: tftypes.String},
+			"nest": tftypes.List{ElementType: tftypes.List{ElementType: tftypes.String}},
+		},
+	}
+
+	state := tfsdk.State{
+		Raw: tftypes.NewValue(tfType, map[string]tftypes.Value{
+			"list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "a"),
+				tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			"set": tftypes.NewValue(tftypes.Set{ElementType: tftypes.Number}, []tftypes.Value{
+				tftypes.NewValue(tftypes.Number, 1),
+			}),
+			"map": tftypes.NewValue(tftypes.Map{ElementType: tftypes.Bool}, map[string]tftypes.Value{
+				"key": tftypes.NewValue(tftypes.Bool, true),
+			}),
+			"null": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+			"nest": tftypes.NewValue(tftypes.List{ElementType: tftypes.List{ElementType: tftypes.String}}, []tftypes.Value{
+				tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{}),
+			}),
+		}),
+		Schema: testschema.Schema{
+			Attributes: map[string]fwschema.Attribute{
+				"list": testschema.Attribute{Type: types.ListType{ElemType: types.StringType}, Optional: true},
+				"set":  testschema.Attribute{Type: types.SetType{ElemType: types.Int64Type}, Optional: true},
+				"map":  testschema.Attribute{Type: types.MapType{ElemType: types.BoolType}, Optional: true},
+				"null": testschema.Attribute{Type: basetypes.ListTypeOf[types.String]{}, Optional: true},
+				"nest": testschema.Attribute{Type: types.ListType{ElemType: types.ListType{ElemType: types.StringType}}, Optional: true},
+			},
+		},
+	}
+
+	ctx := context.Background()
+	expected := model{
+		List: basetypes.NewListValueOfMust(ctx, []types.String{types.StringValue("a"), types.StringUnknown()}),
+		Set:  basetypes.NewSetValueOfMust(ctx, []types.Int64{types.Int64Value(1)}),
+		Map:  basetypes.NewMapValueOfMust(ctx, map[string]types.Bool{"key": types.BoolValue(true)}),
+		Nest: basetypes.NewListValueOfMust(ctx, []basetypes.ListValueOf[types.String]{
+			basetypes.NewListValueOfMust(ctx, []types.String{}),
+		}),
+	}
+
+	var got model
+
+	diags := state.Get(ctx, &got)
+
+	if len(diags) > 0 {
+		t.Fatalf("unexpected diagnostics: %s", diags)
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected value (+wanted, -got): %s", diff)
+	}
+
+	if diff := cmp.Diff(got.List.Elements(), []types.String{types.StringValue("a"), types.StringUnknown()}); diff != "" {
+		t.Errorf("unexpected elements (+wanted, -got): %s", diff)
+	}
+
+	newState := tfsdk.State{Schema: state.Schema, Raw: state.Raw}
+
+	diags = newState.Set(ctx, got)
+
+	if len(diags) > 0 {
+		t.Fatalf("unexpected diagnostics: %s", diags)
+	}
+
+	if diff := cmp.Diff(newState.Raw, state.Raw); diff != "" {
+		t.Errorf("unexpected state (+wanted, -got): %s", diff)
+	}
+
+	diags = newState.SetAttribute(ctx, path.Root("list"), basetypes.NewListValueOfNull[types.String]())
+
+	if len(diags) > 0 {
+		t.Fatalf("unexpected diagnostics: %s", diags)
+	}
+
+	var list basetypes.ListValueOf[types.String]
+
+	diags = newState.GetAttribute(ctx, path.Root("list"), &list)
+
+	if len(diags) > 0 {
+		t.Fatalf("unexpected diagnostics: %s", diags)
+	}
+
+	if !list.IsNull() {
+		t.Errorf("expected null list, got %s", list)
+	}
+}
+
+func TestStateDiffPlan(t *testing.T) {
+	t.Parallel()
+
+	testSchema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"name": testschema.Attribute{
+				Type:     types.StringType,
+				Required: true,
+			},
+			"tags": testschema.Attribute{
+				Type:     types.SetType{ElemType: types.StringType},
+				Optional: true,
+			},
+		},
+	}
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name": tftypes.String,
+			"tags": tftypes.Set{ElementType: tftypes.String},
+		},
+	}
+
+	state := tfsdk.State{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, "test"),
+			"tags": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "a"),
+				tftypes.NewValue(tftypes.String, "b"),
+			}),
+		}),
+	}
+	plan := tfsdk.Plan{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, "test"),
+			"tags": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "b"),
+				tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+		}),
+	}
+
+	got, diags := state.DiffPlan(context.Background(), plan)
+
+	if len(diags) > 0 {
+		t.Fatalf("unexpected diagnostics: %s", diags)
+	}
+
+	expected := []basetypes.ValueDiff{
+		{
+			Path:   path.Root("tags").AtSetValue(types.StringValue("a")),
+			Before: types.StringValue("a"),
+		},
+		{
+			Path:  path.Root("tags").AtSetValue(types.StringUnknown()),
+			After: types.StringUnknown(),
+		},
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	got, diags = state.Diff(context.Background(), state)
+
+	if len(diags) > 0 {
+		t.Fatalf("unexpected diagnostics: %s", diags)
+	}
+
+	if len(got) != 0 {
+		t.Errorf("expected no differences, got: %v", got)
+	}
+}
+
+func TestStateSetFromJSON(t *testing.T) {
+	t.Parallel()
+
+	state := tfsdk.State{
+		Schema: testschema.Schema{
+			Attributes: map[string]fwschema.Attribute{
+				"id": testschema.Attribute{
+					Type:     types.StringType,
+					Computed: true,
+				},
+				"nested": testschema.NestedAttribute{
+					NestedObject: testschema.NestedAttributeObject{
+						Attributes: map[string]fwschema.Attribute{
+							"count": testschema.Attribute{
+								Type:     types.Int64Type,
+								Optional: true,
+							},
+						},
+					},
+					NestingMode: fwschema.NestingModeSet,
+					Optional:    true,
+				},
+			},
+		},
+	}
+
+	nestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"count": tftypes.Number,
+		},
+	}
+	expected := tftypes.NewValue(
+		tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"id":     tftypes.String,
+				"nested": tftypes.Set{ElementType: nestedType},
+			},
+		},
+		map[string]tftypes.Value{
+			"id": tftypes.NewValue(tftypes.String, "test-id"),
+			"nested": tftypes.NewValue(tftypes.Set{ElementType: nestedType}, []tftypes.Value{
+				tftypes.NewValue(nestedType, map[string]tftypes.Value{
+					"count": tftypes.NewValue(tftypes.Number, 2),
+				}),
+			}),
+		},
+	)
+	data := `{"id":"test-id","nested":[{"count":2}]}`
+
+	diags := state.SetFromJSON(context.Background(), []byte(data))
+
+	if len(diags) > 0 {
+		t.Fatalf("unexpected diagnostics: %s", diags)
+	}
+
+	if diff := cmp.Diff(state.Raw, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	got, diags := state.ToJSON(context.Background())
+
+	if len(diags) > 0 {
+		t.Fatalf("unexpected diagnostics: %s", diags)
+	}
+
+	if diff := cmp.Diff(string(got), data); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestStateJSON_unknown(t *testing.T) {
+	t.Parallel()
+
+	schema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"id": testschema.Attribute{
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"tags": testschema.Attribute{
+				Type:     types.ListType{ElemType: types.StringType},
+				Optional: true,
+			},
+		},
+	}
+
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":   tftypes.String,
+			"tags": tftypes.List{ElementType: tftypes.String},
+		},
+	}
+
+	expectedDiags := diag.Diagnostics{
+		diag.NewAttributeErrorDiagnostic(
+			path.Root("tags").AtListIndex(1),
+			"Unknown State Value",
+			"The state contains an unknown value, however Terraform state cannot contain unknown values. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Unknown values must be set to known or null values before the state is converted to or from JSON.",
+		),
+	}
+
+	state := tfsdk.State{
+		Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"id": tftypes.NewValue(tftypes.String, "test-id"),
+			"tags": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "a"),
+				tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+		}),
+		Schema: schema,
+	}
+
+	got, diags := state.ToJSON(context.Background())
+
+	if got != nil {
+		t.Errorf("expected no JSON, got: %s", got)
+	}
+
+	if diff := cmp.Diff(diags, expectedDiags); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+
+	fromJSON := tfsdk.State{
+		Raw:    tftypes.NewValue(objectType, nil),
+		Schema: schema,
+	}
+
+	diags = fromJSON.SetFromJSON(context.Background(), []byte(`{"id":"test-id","tags":["a","74D93920-ED26-11E3-AC10-0800200C9A66"]}`))
+
+	if diff := cmp.Diff(diags, expectedDiags); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+
+	if !fromJSON.Raw.IsNull() {
+		t.Errorf("expected state to be unchanged, got: %s", fromJSON.Raw)
+	}
+}
@@ -14,6 +14,13 @@ import (
 //
 // This is achieved using reflection rules provided by the internal/reflect package.
 func ValueAs(ctx context.Context, val attr.Value, target interface{}) diag.Diagnostics {
+	return ValueAsWithOptions(ctx, val, target, GetOptions{})
+}
+
+// ValueAsWithOptions takes the attr.Value `val` and populates the Go value
+// `target` with its content, using `opts` to control how values are
+// converted.
+func ValueAsWithOptions(ctx context.Context, val attr.Value, target interface{}, opts GetOptions) diag.Diagnostics {
 	if reflect.IsGenericAttrValue(ctx, target) {
 		//nolint:forcetypeassert // Type assertion is guaranteed by the above `reflect.IsGenericAttrValue` function
 		*(target.(*attr.Value)) = val
@@ -24,5 +31,5 @@ func ValueAs(ctx context.Context, val attr.Value, target interface{}) diag.Diagn
 		return diag.Diagnostics{diag.NewErrorDiagnostic("Error converting value",
 			fmt.Sprintf("An unexpected error was encountered converting a %T to its equivalent Terraform representation. This is always a bug in the provider.\n\nError: %s", val, err))}
 	}
-	return reflect.Into(ctx, val.Type(ctx), raw, target, reflect.Options{}, path.Empty())
+	return reflect.Into(ctx, val.Type(ctx), raw, target, opts.reflectOptions(), path.Empty())
 }
@@ -381,3 +381,43 @@ func TestValueAs_generic(t *testing.T) {
 		t.Errorf("Expected target to be %v, got %v", val, target)
 	}
 }
+
+func TestValueAsWithOptions(t *testing.T) {
+	t.Parallel()
+
+	val := types.ObjectValueMust(
+		map[string]attr.Type{
+			"name":  types.StringType,
+			"size":  types.NumberType,
+			"extra": types.StringType,
+		},
+		map[string]attr.Value{
+			"name":  types.StringUnknown(),
+			"size":  types.NumberValue(big.NewFloat(1.5)),
+			"extra": types.StringValue("ignored"),
+		},
+	)
+
+	type model struct {
+		Name    string `tfsdk:"name"`
+		Size    int64  `tfsdk:"size"`
+		Missing string `tfsdk:"missing"`
+	}
+
+	var target model
+
+	diags := ValueAsWithOptions(context.Background(), val, &target, GetOptions{
+		UnhandledUnknownAsEmpty:  true,
+		AllowRoundingNumbers:     true,
+		IgnoreUnmappedAttributes: true,
+		IgnoreUnmappedFields:     true,
+	})
+
+	if len(diags) > 0 {
+		t.Fatalf("Unexpected diagnostics: %s", diags)
+	}
+
+	if diff := cmp.Diff(target, model{Size: 1}); diff != "" {
+		t.Errorf("Unexpected diff in results (-wanted, +got): %s", diff)
+	}
+}
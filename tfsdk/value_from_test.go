@@ -19,6 +19,11 @@ type person struct {
 	FullName types.Map    `tfsdk:"full_name"`
 }
 
+type employee struct {
+	person
+	Employer types.String `tfsdk:"employer"`
+}
+
 func TestValueFrom(t *testing.T) {
 	t.Parallel()
 
@@ -34,6 +39,14 @@ func TestValueFrom(t *testing.T) {
 		},
 	}
 
+	employeeAttrTypes := map[string]attr.Type{
+		"employer": types.StringType,
+	}
+
+	for name, attrType := range personAttrTypes {
+		employeeAttrTypes[name] = attrType
+	}
+
 	mrX := person{
 		Name:    types.StringValue("x"),
 		Age:     types.Int64Value(30),
@@ -146,6 +159,24 @@ func TestValueFrom(t *testing.T) {
 			target:   types.ObjectNull(personAttrTypes),
 			expected: expectedMrXObj,
 		},
+		"embedded-struct": {
+			val: employee{
+				person:   mrX,
+				Employer: types.StringValue("Wayne Enterprises"),
+			},
+			target: types.ObjectNull(employeeAttrTypes),
+			expected: types.ObjectValueMust(
+				employeeAttrTypes,
+				map[string]attr.Value{
+					"name":      expectedMrXObj.Attributes()["name"],
+					"age":       expectedMrXObj.Attributes()["age"],
+					"opted_in":  expectedMrXObj.Attributes()["opted_in"],
+					"address":   expectedMrXObj.Attributes()["address"],
+					"full_name": expectedMrXObj.Attributes()["full_name"],
+					"employer":  types.StringValue("Wayne Enterprises"),
+				},
+			),
+		},
 		"list": {
 			val: []person{mrX, mrsY},
 			target: types.ListNull(
@@ -0,0 +1,90 @@
+package basetypes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// elementTypeOf returns the element type of the generic collection types
+// with element value type T, which is the type of the zero value of T. An
+// error is returned if T is an interface type, such as attr.Value, or if the
+// type of its zero value is incomplete, such as the type of ObjectValue or
+// ListValue which are missing their attribute or element types.
+func elementTypeOf[T attr.Value](ctx context.Context) (attr.Type, error) {
+	var zero T
+
+	// T is an interface type, such as attr.Value itself, so there is no
+	// way to know the element type.
+	if attr.Value(zero) == nil {
+		return nil, fmt.Errorf("cannot derive element type from interface type %s, use a concrete value type such as StringValue", valueTypeName[T]())
+	}
+
+	elemType := zero.Type(ctx)
+
+	if !isCompleteType(elemType) {
+		return nil, fmt.Errorf("cannot derive element type from %s as the type of its zero value is missing element or attribute types, "+
+			"use a value type with a complete type such as ListValueOf[StringValue] or a custom value type", valueTypeName[T]())
+	}
+
+	return elemType, nil
+}
+
+// isCompleteType returns true if the type and all of its element and
+// attribute types are not nil.
+func isCompleteType(typ attr.Type) bool {
+	if typ == nil {
+		return false
+	}
+
+	if t, ok := typ.(attr.TypeWithElementType); ok {
+		return isCompleteType(t.ElementType())
+	}
+
+	if t, ok := typ.(attr.TypeWithElementTypes); ok {
+		for _, elemType := range t.ElementTypes() {
+			if !isCompleteType(elemType) {
+				return false
+			}
+		}
+	}
+
+	if t, ok := typ.(attr.TypeWithAttributeTypes); ok {
+		attrTypes := t.AttributeTypes()
+
+		if len(attrTypes) == 0 {
+			return false
+		}
+
+		for _, attrType := range attrTypes {
+			if !isCompleteType(attrType) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// valueTypeName returns the Go type name of T, such as basetypes.StringValue.
+func valueTypeName[T attr.Value]() string {
+	return strings.TrimPrefix(fmt.Sprintf("%T", (*T)(nil)), "*")
+}
+
+// diagsString returns the diagnostics as a string suitable for panics.
+func diagsString(diags diag.Diagnostics) string {
+	diagsStrings := make([]string, 0, len(diags))
+
+	for _, diagnostic := range diags {
+		diagsStrings = append(diagsStrings, fmt.Sprintf(
+			"%s | %s | %s",
+			diagnostic.Severity(),
+			diagnostic.Summary(),
+			diagnostic.Detail()))
+	}
+
+	return strings.Join(diagsStrings, "\n")
+}
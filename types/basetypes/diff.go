@@ -0,0 +1,218 @@
+package basetypes
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// ValueDiff is a difference between two values found by Diff.
+type ValueDiff struct {
+	// Path is the path of the changed value, relative to the compared
+	// values.
+	Path path.Path
+
+	// Before is the value before the change. It is nil if the value was
+	// added, such as a new list element, map key, or set element.
+	Before attr.Value
+
+	// After is the value after the change. It is nil if the value was
+	// removed, such as a removed list element, map key, or set element.
+	After attr.Value
+}
+
+// Diff returns the differences between two values. Known list, map, and
+// object values of the same kind are compared by element or attribute, so
+// the differences are for the deepest changed values. Set elements are
+// compared by value, so each difference is an added or removed element with
+// the element value as its path. Other values, including null and unknown
+// values, are compared as a whole with their Equal method.
+//
+// Map keys and object attribute names are compared in sorted order. Equal
+// values return no differences.
+func Diff(ctx context.Context, before, after attr.Value) ([]ValueDiff, diag.Diagnostics) {
+	return diff(ctx, path.Empty(), before, after)
+}
+
+// diff is the recursive implementation of Diff.
+func diff(ctx context.Context, valuePath path.Path, before, after attr.Value) ([]ValueDiff, diag.Diagnostics) {
+	if valuesEqual(before, after) {
+		return nil, nil
+	}
+
+	wholeDiff := []ValueDiff{
+		{
+			Path:   valuePath,
+			Before: before,
+			After:  after,
+		},
+	}
+
+	if before == nil || after == nil {
+		return wholeDiff, nil
+	}
+
+	if before.IsNull() || before.IsUnknown() || after.IsNull() || after.IsUnknown() {
+		return wholeDiff, nil
+	}
+
+	var diffs []ValueDiff
+	var diags diag.Diagnostics
+
+	switch b := before.(type) {
+	case ListValuable:
+		a, ok := after.(ListValuable)
+
+		if !ok {
+			return wholeDiff, nil
+		}
+
+		beforeList, beforeDiags := b.ToListValue(ctx)
+		afterList, afterDiags := a.ToListValue(ctx)
+
+		diags.Append(beforeDiags...)
+		diags.Append(afterDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		beforeElements := beforeList.Elements()
+		afterElements := afterList.Elements()
+
+		for index := 0; index < len(beforeElements) || index < len(afterElements); index++ {
+			var beforeElement, afterElement attr.Value
+
+			if index < len(beforeElements) {
+				beforeElement = beforeElements[index]
+			}
+
+			if index < len(afterElements) {
+				afterElement = afterElements[index]
+			}
+
+			elementDiffs, elementDiags := diff(ctx, valuePath.AtListIndex(index), beforeElement, afterElement)
+
+			diags.Append(elementDiags...)
+			diffs = append(diffs, elementDiffs...)
+		}
+	case MapValuable:
+		a, ok := after.(MapValuable)
+
+		if !ok {
+			return wholeDiff, nil
+		}
+
+		beforeMap, beforeDiags := b.ToMapValue(ctx)
+		afterMap, afterDiags := a.ToMapValue(ctx)
+
+		diags.Append(beforeDiags...)
+		diags.Append(afterDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		elementDiffs, elementDiags := diffMaps(ctx, valuePath, beforeMap.Elements(), afterMap.Elements(), false)
+
+		diags.Append(elementDiags...)
+		diffs = append(diffs, elementDiffs...)
+	case ObjectValuable:
+		a, ok := after.(ObjectValuable)
+
+		if !ok {
+			return wholeDiff, nil
+		}
+
+		beforeObject, beforeDiags := b.ToObjectValue(ctx)
+		afterObject, afterDiags := a.ToObjectValue(ctx)
+
+		diags.Append(beforeDiags...)
+		diags.Append(afterDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		attributeDiffs, attributeDiags := diffMaps(ctx, valuePath, beforeObject.Attributes(), afterObject.Attributes(), true)
+
+		diags.Append(attributeDiags...)
+		diffs = append(diffs, attributeDiffs...)
+	case SetValuable:
+		a, ok := after.(SetValuable)
+
+		if !ok {
+			return wholeDiff, nil
+		}
+
+		beforeSet, beforeDiags := b.ToSetValue(ctx)
+		afterSet, afterDiags := a.ToSetValue(ctx)
+
+		diags.Append(beforeDiags...)
+		diags.Append(afterDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		for _, element := range beforeSet.Elements() {
+			if !afterSet.contains(element) {
+				diffs = append(diffs, ValueDiff{Path: valuePath.AtSetValue(element), Before: element})
+			}
+		}
+
+		for _, element := range afterSet.Elements() {
+			if !beforeSet.contains(element) {
+				diffs = append(diffs, ValueDiff{Path: valuePath.AtSetValue(element), After: element})
+			}
+		}
+	default:
+		return wholeDiff, nil
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	// The values are not equal, such as different value types with the same
+	// elements, so ensure the difference is not lost.
+	if len(diffs) == 0 {
+		return wholeDiff, diags
+	}
+
+	return diffs, diags
+}
+
+// diffMaps returns the differences between map elements or object
+// attributes.
+func diffMaps(ctx context.Context, valuePath path.Path, before, after map[string]attr.Value, object bool) ([]ValueDiff, diag.Diagnostics) {
+	var diffs []ValueDiff
+	var diags diag.Diagnostics
+
+	keys := make(map[string]attr.Value, len(before)+len(after))
+
+	for key := range before {
+		keys[key] = nil
+	}
+
+	for key := range after {
+		keys[key] = nil
+	}
+
+	for _, key := range sortedKeys(keys) {
+		keyPath := valuePath.AtMapKey(key)
+
+		if object {
+			keyPath = valuePath.AtName(key)
+		}
+
+		keyDiffs, keyDiags := diff(ctx, keyPath, before[key], after[key])
+
+		diags.Append(keyDiags...)
+		diffs = append(diffs, keyDiffs...)
+	}
+
+	return diffs, diags
+}
@@ -0,0 +1,187 @@
+package basetypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		before   attr.Value
+		after    attr.Value
+		expected []ValueDiff
+	}{
+		"equal": {
+			before:   walkTestValue(),
+			after:    walkTestValue(),
+			expected: nil,
+		},
+		"primitive": {
+			before: NewStringValue("a"),
+			after:  NewStringValue("b"),
+			expected: []ValueDiff{
+				{
+					Path:   path.Empty(),
+					Before: NewStringValue("a"),
+					After:  NewStringValue("b"),
+				},
+			},
+		},
+		"null-to-known": {
+			before: NewListNull(StringType{}),
+			after:  NewListValueMust(StringType{}, []attr.Value{NewStringValue("a")}),
+			expected: []ValueDiff{
+				{
+					Path:   path.Empty(),
+					Before: NewListNull(StringType{}),
+					After:  NewListValueMust(StringType{}, []attr.Value{NewStringValue("a")}),
+				},
+			},
+		},
+		"list": {
+			before: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("a"),
+				NewStringValue("b"),
+			}),
+			after: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("a"),
+				NewStringValue("c"),
+				NewStringValue("d"),
+			}),
+			expected: []ValueDiff{
+				{
+					Path:   path.Empty().AtListIndex(1),
+					Before: NewStringValue("b"),
+					After:  NewStringValue("c"),
+				},
+				{
+					Path:  path.Empty().AtListIndex(2),
+					After: NewStringValue("d"),
+				},
+			},
+		},
+		"map": {
+			before: NewMapValueMust(StringType{}, map[string]attr.Value{
+				"a": NewStringValue("a"),
+				"b": NewStringValue("b"),
+			}),
+			after: NewMapValueMust(StringType{}, map[string]attr.Value{
+				"b": NewStringValue("b"),
+				"c": NewStringValue("c"),
+			}),
+			expected: []ValueDiff{
+				{
+					Path:   path.Empty().AtMapKey("a"),
+					Before: NewStringValue("a"),
+				},
+				{
+					Path:  path.Empty().AtMapKey("c"),
+					After: NewStringValue("c"),
+				},
+			},
+		},
+		"set": {
+			before: NewSetValueMust(StringType{}, []attr.Value{
+				NewStringValue("a"),
+				NewStringValue("b"),
+			}),
+			after: NewSetValueMust(StringType{}, []attr.Value{
+				NewStringValue("b"),
+				NewStringValue("c"),
+			}),
+			expected: []ValueDiff{
+				{
+					Path:   path.Empty().AtSetValue(NewStringValue("a")),
+					Before: NewStringValue("a"),
+				},
+				{
+					Path:  path.Empty().AtSetValue(NewStringValue("c")),
+					After: NewStringValue("c"),
+				},
+			},
+		},
+		"set-reordered": {
+			before: NewSetValueMust(StringType{}, []attr.Value{
+				NewStringValue("a"),
+				NewStringValue("b"),
+			}),
+			after: NewSetValueMust(StringType{}, []attr.Value{
+				NewStringValue("b"),
+				NewStringValue("a"),
+			}),
+			expected: nil,
+		},
+		"nested": {
+			before: walkTestValue(),
+			after: NewObjectValueMust(
+				walkTestValue().AttributeTypes(context.Background()),
+				map[string]attr.Value{
+					"list": walkTestValue().Attributes()["list"],
+					"map":  walkTestValue().Attributes()["map"],
+					"object": NewObjectValueMust(
+						map[string]attr.Type{
+							"string": StringType{},
+						},
+						map[string]attr.Value{
+							"string": NewStringUnknown(),
+						},
+					),
+					"set": walkTestValue().Attributes()["set"],
+				},
+			),
+			expected: []ValueDiff{
+				{
+					Path:   path.Root("object").AtName("string"),
+					Before: NewStringValue("c"),
+					After:  NewStringUnknown(),
+				},
+			},
+		},
+		"different-value-types": {
+			before: NewListValueMust(StringType{}, []attr.Value{NewStringValue("a")}),
+			after:  NewListValueOfMust(context.Background(), []StringValue{NewStringValue("a")}),
+			expected: []ValueDiff{
+				{
+					Path:   path.Empty(),
+					Before: NewListValueMust(StringType{}, []attr.Value{NewStringValue("a")}),
+					After:  NewListValueOfMust(context.Background(), []StringValue{NewStringValue("a")}),
+				},
+			},
+		},
+		"different-kinds": {
+			before: NewListValueMust(StringType{}, []attr.Value{NewStringValue("a")}),
+			after:  NewSetValueMust(StringType{}, []attr.Value{NewStringValue("a")}),
+			expected: []ValueDiff{
+				{
+					Path:   path.Empty(),
+					Before: NewListValueMust(StringType{}, []attr.Value{NewStringValue("a")}),
+					After:  NewSetValueMust(StringType{}, []attr.Value{NewStringValue("a")}),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := Diff(context.Background(), testCase.before, testCase.after)
+
+			if len(diags) > 0 {
+				t.Fatalf("unexpected diagnostics: %s", diags)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected result (+expected, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,381 @@
+package basetypes
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// unknownJSONValue is the JSON string which represents an unknown value in
+// ValueToJSON and ValueFromJSON. It is the same marker Terraform uses for
+// unknown values in legacy flatmap and shimmed representations.
+const unknownJSONValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+// ValueToJSON returns the JSON encoding of the value, consistent with the
+// Terraform JSON state representation. Lists, sets, and tuples are encoded as
+// arrays, maps and objects as objects, numbers without loss of precision, and
+// null values as null. Unknown values, which Terraform state cannot contain,
+// are encoded as the string Terraform uses to mark unknown values in legacy
+// representations, which ValueFromJSON decodes as unknown values.
+func ValueToJSON(ctx context.Context, value attr.Value) ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tfValue, err := value.ToTerraformValue(ctx)
+
+	if err != nil {
+		diags.AddError(
+			"JSON Encoding Error",
+			"An unexpected error was encountered converting the value to its Terraform representation. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	jsonValue, err := tftypesValueToJSON(path.Empty(), tfValue)
+
+	if err == nil {
+		var result []byte
+
+		result, err = json.Marshal(jsonValue)
+
+		if err == nil {
+			return result, diags
+		}
+	}
+
+	diags.AddError(
+		"JSON Encoding Error",
+		"An unexpected error was encountered encoding the value as JSON. "+
+			"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+			"Error: "+err.Error(),
+	)
+
+	return nil, diags
+}
+
+// ValueFromJSON returns the value of the given type decoded from JSON in the
+// format of ValueToJSON, such as Terraform JSON state. The value is created
+// with the ValueFromTerraform method of the type, so custom types are
+// supported. Missing object attributes are decoded as null values, while
+// unexpected object attributes return an error diagnostic.
+func ValueFromJSON(ctx context.Context, data []byte, typ attr.Type) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var jsonValue any
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	err := decoder.Decode(&jsonValue)
+
+	if err == nil && decoder.More() {
+		err = fmt.Errorf("unexpected data after JSON value")
+	}
+
+	var tfValue tftypes.Value
+
+	if err == nil {
+		tfValue, err = tftypesValueFromJSON(path.Empty(), jsonValue, typ.TerraformType(ctx))
+	}
+
+	if err != nil {
+		diags.AddError(
+			"JSON Decoding Error",
+			"An unexpected error was encountered decoding the JSON value. "+
+				"Verify the JSON matches the expected type.\n\n"+
+				"Type: "+typ.String()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	value, err := typ.ValueFromTerraform(ctx, tfValue)
+
+	if err != nil {
+		diags.AddError(
+			"JSON Decoding Error",
+			"An unexpected error was encountered converting the decoded JSON value. "+
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+				"Type: "+typ.String()+"\n"+
+				"Error: "+err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	return value, diags
+}
+
+// tftypesValueToJSON returns the value as a Go value which encoding/json
+// marshals in the ValueToJSON format.
+func tftypesValueToJSON(valuePath path.Path, value tftypes.Value) (any, error) {
+	if !value.IsKnown() {
+		return unknownJSONValue, nil
+	}
+
+	if value.IsNull() {
+		return nil, nil
+	}
+
+	typ := value.Type()
+
+	switch {
+	case typ.Is(tftypes.String):
+		var s string
+
+		err := value.As(&s)
+
+		return s, jsonPathError(valuePath, err)
+	case typ.Is(tftypes.Number):
+		n := new(big.Float)
+
+		if err := value.As(&n); err != nil {
+			return nil, jsonPathError(valuePath, err)
+		}
+
+		if n.IsInf() {
+			return nil, jsonPathError(valuePath, fmt.Errorf("cannot encode infinite number"))
+		}
+
+		return json.Number(n.Text('f', -1)), nil
+	case typ.Is(tftypes.Bool):
+		var b bool
+
+		err := value.As(&b)
+
+		return b, jsonPathError(valuePath, err)
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+
+		if err := value.As(&elements); err != nil {
+			return nil, jsonPathError(valuePath, err)
+		}
+
+		result := make([]any, 0, len(elements))
+
+		for index, element := range elements {
+			elementPath := valuePath.AtListIndex(index)
+
+			// Set element values are not available as attr.Value here, so
+			// errors are reported at the set.
+			if typ.Is(tftypes.Set{}) {
+				elementPath = valuePath
+			}
+
+			jsonElement, err := tftypesValueToJSON(elementPath, element)
+
+			if err != nil {
+				return nil, err
+			}
+
+			result = append(result, jsonElement)
+		}
+
+		return result, nil
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		var elements map[string]tftypes.Value
+
+		if err := value.As(&elements); err != nil {
+			return nil, jsonPathError(valuePath, err)
+		}
+
+		result := make(map[string]any, len(elements))
+
+		for key, element := range elements {
+			elementPath := valuePath.AtMapKey(key)
+
+			if typ.Is(tftypes.Object{}) {
+				elementPath = valuePath.AtName(key)
+			}
+
+			jsonElement, err := tftypesValueToJSON(elementPath, element)
+
+			if err != nil {
+				return nil, err
+			}
+
+			result[key] = jsonElement
+		}
+
+		return result, nil
+	default:
+		return nil, jsonPathError(valuePath, fmt.Errorf("unsupported type %s", typ))
+	}
+}
+
+// tftypesValueFromJSON returns the tftypes.Value of the given type from a Go
+// value decoded by encoding/json with numbers as json.Number.
+func tftypesValueFromJSON(valuePath path.Path, jsonValue any, typ tftypes.Type) (tftypes.Value, error) {
+	if jsonValue == nil {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	if s, ok := jsonValue.(string); ok && s == unknownJSONValue {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	switch {
+	case typ.Is(tftypes.String):
+		s, ok := jsonValue.(string)
+
+		if !ok {
+			return tftypes.Value{}, jsonTypeError(valuePath, "string", jsonValue)
+		}
+
+		return tftypes.NewValue(typ, s), nil
+	case typ.Is(tftypes.Number):
+		n, ok := jsonValue.(json.Number)
+
+		if !ok {
+			return tftypes.Value{}, jsonTypeError(valuePath, "number", jsonValue)
+		}
+
+		f, _, err := big.ParseFloat(n.String(), 10, 512, big.ToNearestEven)
+
+		if err != nil {
+			return tftypes.Value{}, jsonPathError(valuePath, err)
+		}
+
+		return tftypes.NewValue(typ, f), nil
+	case typ.Is(tftypes.Bool):
+		b, ok := jsonValue.(bool)
+
+		if !ok {
+			return tftypes.Value{}, jsonTypeError(valuePath, "bool", jsonValue)
+		}
+
+		return tftypes.NewValue(typ, b), nil
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		jsonElements, ok := jsonValue.([]any)
+
+		if !ok {
+			return tftypes.Value{}, jsonTypeError(valuePath, "array", jsonValue)
+		}
+
+		elements := make([]tftypes.Value, 0, len(jsonElements))
+
+		for index, jsonElement := range jsonElements {
+			var elementType tftypes.Type
+
+			switch t := typ.(type) {
+			case tftypes.List:
+				elementType = t.ElementType
+			case tftypes.Set:
+				elementType = t.ElementType
+			case tftypes.Tuple:
+				if index >= len(t.ElementTypes) {
+					return tftypes.Value{}, jsonPathError(valuePath, fmt.Errorf("expected %d tuple elements, got %d", len(t.ElementTypes), len(jsonElements)))
+				}
+
+				elementType = t.ElementTypes[index]
+			}
+
+			element, err := tftypesValueFromJSON(valuePath.AtListIndex(index), jsonElement, elementType)
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			elements = append(elements, element)
+		}
+
+		if t, ok := typ.(tftypes.Tuple); ok && len(elements) != len(t.ElementTypes) {
+			return tftypes.Value{}, jsonPathError(valuePath, fmt.Errorf("expected %d tuple elements, got %d", len(t.ElementTypes), len(elements)))
+		}
+
+		return tftypes.NewValue(typ, elements), nil
+	case typ.Is(tftypes.Map{}):
+		jsonElements, ok := jsonValue.(map[string]any)
+
+		if !ok {
+			return tftypes.Value{}, jsonTypeError(valuePath, "object", jsonValue)
+		}
+
+		//nolint:forcetypeassert // Is() guarantees the type
+		elementType := typ.(tftypes.Map).ElementType
+		elements := make(map[string]tftypes.Value, len(jsonElements))
+
+		for key, jsonElement := range jsonElements {
+			element, err := tftypesValueFromJSON(valuePath.AtMapKey(key), jsonElement, elementType)
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			elements[key] = element
+		}
+
+		return tftypes.NewValue(typ, elements), nil
+	case typ.Is(tftypes.Object{}):
+		jsonAttributes, ok := jsonValue.(map[string]any)
+
+		if !ok {
+			return tftypes.Value{}, jsonTypeError(valuePath, "object", jsonValue)
+		}
+
+		//nolint:forcetypeassert // Is() guarantees the type
+		attributeTypes := typ.(tftypes.Object).AttributeTypes
+
+		for name := range jsonAttributes {
+			if _, ok := attributeTypes[name]; !ok {
+				return tftypes.Value{}, jsonPathError(valuePath, fmt.Errorf("unexpected attribute %q", name))
+			}
+		}
+
+		attributes := make(map[string]tftypes.Value, len(attributeTypes))
+
+		for name, attributeType := range attributeTypes {
+			attribute, err := tftypesValueFromJSON(valuePath.AtName(name), jsonAttributes[name], attributeType)
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			attributes[name] = attribute
+		}
+
+		return tftypes.NewValue(typ, attributes), nil
+	default:
+		return tftypes.Value{}, jsonPathError(valuePath, fmt.Errorf("unsupported type %s", typ))
+	}
+}
+
+// jsonTypeError returns an error for a JSON value of the wrong type.
+func jsonTypeError(valuePath path.Path, expected string, jsonValue any) error {
+	var got string
+
+	switch jsonValue.(type) {
+	case string:
+		got = "string"
+	case json.Number:
+		got = "number"
+	case bool:
+		got = "bool"
+	case []any:
+		got = "array"
+	case map[string]any:
+		got = "object"
+	}
+
+	return jsonPathError(valuePath, fmt.Errorf("expected %s, got %s", expected, got))
+}
+
+// jsonPathError returns the error prefixed with the path, if the path is not
+// empty. Returns nil if the error is nil.
+func jsonPathError(valuePath path.Path, err error) error {
+	if err == nil || len(valuePath.Steps()) == 0 {
+		return err
+	}
+
+	return fmt.Errorf("%s: %w", valuePath, err)
+}
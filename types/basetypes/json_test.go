@@ -0,0 +1,184 @@
+package basetypes
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestValueToJSON(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    attr.Value
+		expected string
+	}{
+		"nested": {
+			value:    walkTestValue(),
+			expected: `{"list":["a","74D93920-ED26-11E3-AC10-0800200C9A66"],"map":{"a":"a","b":"b"},"object":{"string":"c"},"set":["d"]}`,
+		},
+		"null": {
+			value:    NewObjectNull(map[string]attr.Type{"string": StringType{}}),
+			expected: `null`,
+		},
+		"number": {
+			value:    NewNumberValue(big.NewFloat(1.5)),
+			expected: `1.5`,
+		},
+		"number-large": {
+			value:    NewNumberValue(new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(30), nil))),
+			expected: `1000000000000000000000000000000`,
+		},
+		"bool": {
+			value:    NewBoolValue(true),
+			expected: `true`,
+		},
+		"ListValueOf": {
+			value:    NewListValueOfMust(context.Background(), []Int64Value{NewInt64Value(1), NewInt64Null()}),
+			expected: `[1,null]`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := ValueToJSON(context.Background(), testCase.value)
+
+			if len(diags) > 0 {
+				t.Fatalf("unexpected diagnostics: %s", diags)
+			}
+
+			if diff := cmp.Diff(string(got), testCase.expected); diff != "" {
+				t.Errorf("unexpected result (+expected, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestValueFromJSON(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		data          string
+		typ           attr.Type
+		expected      attr.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"nested": {
+			data:     `{"list":["a","74D93920-ED26-11E3-AC10-0800200C9A66"],"map":{"a":"a","b":"b"},"object":{"string":"c"},"set":["d"]}`,
+			typ:      walkTestValue().Type(context.Background()),
+			expected: walkTestValue(),
+		},
+		"missing-attribute": {
+			data: `{"string":"a"}`,
+			typ: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"bool":   BoolType{},
+					"string": StringType{},
+				},
+			},
+			expected: NewObjectValueMust(
+				map[string]attr.Type{
+					"bool":   BoolType{},
+					"string": StringType{},
+				},
+				map[string]attr.Value{
+					"bool":   NewBoolNull(),
+					"string": NewStringValue("a"),
+				},
+			),
+		},
+		"unknown": {
+			data:     `"74D93920-ED26-11E3-AC10-0800200C9A66"`,
+			typ:      ListType{ElemType: StringType{}},
+			expected: NewListUnknown(StringType{}),
+		},
+		"null": {
+			data:     `null`,
+			typ:      MapType{ElemType: StringType{}},
+			expected: NewMapNull(StringType{}),
+		},
+		"number": {
+			data:     `1.5`,
+			typ:      NumberType{},
+			expected: NewNumberValue(big.NewFloat(1.5)),
+		},
+		"ListTypeOf": {
+			data:     `[1,null]`,
+			typ:      ListTypeOf[Int64Value]{},
+			expected: NewListValueOfMust(context.Background(), []Int64Value{NewInt64Value(1), NewInt64Null()}),
+		},
+		"unexpected-attribute": {
+			data: `{"other":"a"}`,
+			typ: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"string": StringType{},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"JSON Decoding Error",
+					"An unexpected error was encountered decoding the JSON value. "+
+						"Verify the JSON matches the expected type.\n\n"+
+						"Type: types.ObjectType[\"string\":basetypes.StringType]\n"+
+						"Error: unexpected attribute \"other\"",
+				),
+			},
+		},
+		"wrong-type": {
+			data: `{"list":[true]}`,
+			typ: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"list": ListType{ElemType: StringType{}},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"JSON Decoding Error",
+					"An unexpected error was encountered decoding the JSON value. "+
+						"Verify the JSON matches the expected type.\n\n"+
+						"Type: types.ObjectType[\"list\":types.ListType[basetypes.StringType]]\n"+
+						"Error: list[0]: expected string, got bool",
+				),
+			},
+		},
+		"trailing-data": {
+			data: `"a" "b"`,
+			typ:  StringType{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"JSON Decoding Error",
+					"An unexpected error was encountered decoding the JSON value. "+
+						"Verify the JSON matches the expected type.\n\n"+
+						"Type: basetypes.StringType\n"+
+						"Error: unexpected data after JSON value",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := ValueFromJSON(context.Background(), []byte(testCase.data), testCase.typ)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+expected, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected result (+expected, -got): %s", diff)
+			}
+		})
+	}
+}
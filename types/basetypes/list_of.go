@@ -0,0 +1,333 @@
+package basetypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+var (
+	_ ListTypable                = ListTypeOf[StringValue]{}
+	_ xattr.TypeWithValidate     = ListTypeOf[StringValue]{}
+	_ ListValuable               = ListValueOf[StringValue]{}
+	_ xattr.ValueWithDerivedType = ListValueOf[StringValue]{}
+)
+
+// ListTypeOf is a ListType whose element type is derived from the element
+// value type T, producing ListValueOf[T] values. T must be a concrete value
+// type whose zero value returns its complete type from its Type method, such
+// as StringValue or another generic collection value type. Other value types,
+// such as attr.Value or ObjectValue, cause errors when creating, converting,
+// or validating values.
+type ListTypeOf[T attr.Value] struct{}
+
+// listType returns the equivalent ListType, or an error if the element type
+// cannot be derived from T.
+func (l ListTypeOf[T]) listType(ctx context.Context) (ListType, error) {
+	elemType, err := elementTypeOf[T](ctx)
+
+	return ListType{ElemType: elemType}, err
+}
+
+// ElementType returns the attr.Type elements will be created from, which is
+// nil if the element type cannot be derived from T.
+func (l ListTypeOf[T]) ElementType() attr.Type {
+	elemType, _ := elementTypeOf[T](context.Background())
+
+	return elemType
+}
+
+// WithElementType returns a ListType with the element type set to `typ`.
+// This is `l` if `typ` is the derived element type.
+func (l ListTypeOf[T]) WithElementType(typ attr.Type) attr.TypeWithElementType {
+	if typ.Equal(l.ElementType()) {
+		return l
+	}
+
+	return ListType{ElemType: typ}
+}
+
+// TerraformType returns the tftypes.Type that should be used to represent
+// this type. If the element type cannot be derived from T, the element type
+// is tftypes.DynamicPseudoType and Validate returns the error.
+func (l ListTypeOf[T]) TerraformType(ctx context.Context) tftypes.Type {
+	listType, err := l.listType(ctx)
+	if err != nil {
+		return tftypes.List{ElementType: tftypes.DynamicPseudoType}
+	}
+
+	return listType.TerraformType(ctx)
+}
+
+// ValueFromTerraform returns a ListValueOf[T] given a tftypes.Value.
+func (l ListTypeOf[T]) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	listType, err := l.listType(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := listType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	//nolint:forcetypeassert // ListType.ValueFromTerraform always returns ListValue
+	return listValueOf[T](value.(ListValue))
+}
+
+// Equal returns true if `o` is also a ListTypeOf[T].
+func (l ListTypeOf[T]) Equal(o attr.Type) bool {
+	_, ok := o.(ListTypeOf[T])
+
+	return ok
+}
+
+// ApplyTerraform5AttributePathStep applies the given AttributePathStep to the
+// list.
+func (l ListTypeOf[T]) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
+	listType, err := l.listType(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	return listType.ApplyTerraform5AttributePathStep(step)
+}
+
+// String returns a human-friendly description of the ListTypeOf.
+func (l ListTypeOf[T]) String() string {
+	elemType := l.ElementType()
+	if elemType == nil {
+		return "types.ListTypeOf[" + valueTypeName[T]() + "]"
+	}
+
+	return "types.ListTypeOf[" + elemType.String() + "]"
+}
+
+// Validate validates all elements of the list that are of type
+// xattr.TypeWithValidate.
+func (l ListTypeOf[T]) Validate(ctx context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	listType, err := l.listType(ctx)
+	if err != nil {
+		return diag.Diagnostics{
+			diag.NewAttributeErrorDiagnostic(
+				path,
+				"List Type Validation Error",
+				"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			),
+		}
+	}
+
+	return listType.Validate(ctx, in, path)
+}
+
+// ValueType returns the Value type.
+func (l ListTypeOf[T]) ValueType(_ context.Context) attr.Value {
+	return ListValueOf[T]{}
+}
+
+// ValueFromList returns a ListValueOf[T] given a List, which must have the
+// derived element type.
+func (l ListTypeOf[T]) ValueFromList(ctx context.Context, list ListValue) (ListValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if _, err := elementTypeOf[T](ctx); err != nil {
+		diags.AddError(
+			"List Conversion Error",
+			"An unexpected error was encountered trying to convert the list. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return NewListValueOfUnknown[T](), diags
+	}
+
+	value, err := listValueOf[T](list)
+	if err != nil {
+		diags.AddError(
+			"List Conversion Error",
+			"An unexpected error was encountered trying to convert the list. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return NewListValueOfUnknown[T](), diags
+	}
+
+	return value, diags
+}
+
+// NewListValueOfNull creates a ListValueOf[T] with a null value. This is the
+// same as the zero value.
+func NewListValueOfNull[T attr.Value]() ListValueOf[T] {
+	return ListValueOf[T]{}
+}
+
+// NewListValueOfUnknown creates a ListValueOf[T] with an unknown value.
+func NewListValueOfUnknown[T attr.Value]() ListValueOf[T] {
+	elemType, _ := elementTypeOf[T](context.Background())
+
+	return ListValueOf[T]{
+		list: NewListUnknown(elemType),
+	}
+}
+
+// NewListValueOf creates a ListValueOf[T] with a known value. Access the value
+// via the Elements method.
+func NewListValueOf[T attr.Value](ctx context.Context, elements []T) (ListValueOf[T], diag.Diagnostics) {
+	elemType, err := elementTypeOf[T](ctx)
+	if err != nil {
+		return NewListValueOfUnknown[T](), diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"Invalid List Element Type",
+				"While creating a List value, the element type could not be derived from the element value type. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+err.Error(),
+			),
+		}
+	}
+
+	attrElements := make([]attr.Value, 0, len(elements))
+
+	for _, element := range elements {
+		attrElements = append(attrElements, element)
+	}
+
+	list, diags := NewListValue(elemType, attrElements)
+
+	if diags.HasError() {
+		return NewListValueOfUnknown[T](), diags
+	}
+
+	return ListValueOf[T]{list: list}, diags
+}
+
+// NewListValueOfMust creates a ListValueOf[T] with a known value, converting
+// any diagnostics into a panic at runtime.
+//
+// This creation function is only recommended to create values which will not
+// potentially affect practitioners, such as testing, or exhaustively tested
+// provider logic.
+func NewListValueOfMust[T attr.Value](ctx context.Context, elements []T) ListValueOf[T] {
+	list, diags := NewListValueOf(ctx, elements)
+
+	if diags.HasError() {
+		panic("NewListValueOfMust received error(s): " + diagsString(diags))
+	}
+
+	return list
+}
+
+// ListValueOf is a list value whose elements are all of the value type T.
+// Unlike ListValue, its element type is derived from T, so its zero value is
+// a usable null value and it can be used as a model struct field without
+// converting elements with ElementsAs. Its attr.Type is ListTypeOf[T], however
+// it can also be read from schema attributes of the equivalent ListType.
+type ListValueOf[T attr.Value] struct {
+	// list holds the elements. Its element type is nil in the zero value.
+	list ListValue
+}
+
+// listValueOf returns `list` as a ListValueOf[T], returning an error if the
+// elements are not of type T.
+func listValueOf[T attr.Value](list ListValue) (ListValueOf[T], error) {
+	for idx, element := range list.elements {
+		if _, ok := element.(T); !ok {
+			var zero T
+			return ListValueOf[T]{}, fmt.Errorf("list element %d is %T, not %T", idx, element, zero)
+		}
+	}
+
+	return ListValueOf[T]{list: list}, nil
+}
+
+// listValue returns the underlying ListValue with its element type set.
+func (l ListValueOf[T]) listValue(ctx context.Context) ListValue {
+	if l.list.elementType == nil {
+		l.list.elementType, _ = elementTypeOf[T](ctx)
+	}
+
+	return l.list
+}
+
+// Elements returns a copy of the elements of the list.
+func (l ListValueOf[T]) Elements() []T {
+	result := make([]T, 0, len(l.list.elements))
+
+	for _, element := range l.list.elements {
+		//nolint:forcetypeassert // Element types are checked on creation
+		result = append(result, element.(T))
+	}
+
+	return result
+}
+
+// ElementType returns the element type for the list, which is nil if the
+// element type cannot be derived from T.
+func (l ListValueOf[T]) ElementType(ctx context.Context) attr.Type {
+	elemType, _ := elementTypeOf[T](ctx)
+
+	return elemType
+}
+
+// Type returns a ListTypeOf[T].
+func (l ListValueOf[T]) Type(_ context.Context) attr.Type {
+	return ListTypeOf[T]{}
+}
+
+// DerivedType returns a ListTypeOf[T].
+func (l ListValueOf[T]) DerivedType(ctx context.Context) attr.Type {
+	return l.Type(ctx)
+}
+
+// ToTerraformValue returns the data contained in the list as a
+// tftypes.Value.
+func (l ListValueOf[T]) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	if _, err := elementTypeOf[T](ctx); err != nil {
+		return tftypes.NewValue(l.Type(ctx).TerraformType(ctx), tftypes.UnknownValue), err
+	}
+
+	return l.listValue(ctx).ToTerraformValue(ctx)
+}
+
+// Equal returns true if `o` is a ListValueOf[T] with the same elements.
+func (l ListValueOf[T]) Equal(o attr.Value) bool {
+	other, ok := o.(ListValueOf[T])
+
+	if !ok {
+		return false
+	}
+
+	ctx := context.Background()
+
+	return l.listValue(ctx).Equal(other.listValue(ctx))
+}
+
+// IsNull returns true if the list represents a null value.
+func (l ListValueOf[T]) IsNull() bool {
+	return l.list.IsNull()
+}
+
+// IsUnknown returns true if the list represents a currently unknown value.
+func (l ListValueOf[T]) IsUnknown() bool {
+	return l.list.IsUnknown()
+}
+
+// String returns a human-readable representation of the list value.
+// The string returned here is not protected by any compatibility guarantees,
+// and is intended for logging and error reporting.
+func (l ListValueOf[T]) String() string {
+	return l.list.String()
+}
+
+// ToListValue returns the equivalent ListValue.
+func (l ListValueOf[T]) ToListValue(ctx context.Context) (ListValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if _, err := elementTypeOf[T](ctx); err != nil {
+		diags.AddError(
+			"List Conversion Error",
+			"An unexpected error was encountered trying to convert the list. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+	}
+
+	return l.listValue(ctx), diags
+}
@@ -0,0 +1,449 @@
+package basetypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestListTypeOfTerraformType(t *testing.T) {
+	t.Parallel()
+
+	got := ListTypeOf[ListValueOf[StringValue]]{}.TerraformType(context.Background())
+	expected := tftypes.List{
+		ElementType: tftypes.List{
+			ElementType: tftypes.String,
+		},
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected result (+expected, -got): %s", diff)
+	}
+}
+
+func TestListTypeOfValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input       tftypes.Value
+		expected    attr.Value
+		expectedErr string
+	}{
+		"known": {
+			input: tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "hello"),
+				tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			expected: NewListValueOfMust(context.Background(), []StringValue{
+				NewStringValue("hello"),
+				NewStringUnknown(),
+			}),
+		},
+		"null": {
+			input:    tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+			expected: NewListValueOfNull[StringValue](),
+		},
+		"unknown": {
+			input:    tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tftypes.UnknownValue),
+			expected: NewListValueOfUnknown[StringValue](),
+		},
+		"wrong-type": {
+			input:       tftypes.NewValue(tftypes.List{ElementType: tftypes.Bool}, nil),
+			expectedErr: "can't use tftypes.List[tftypes.Bool]<null> as value of List with ElementType basetypes.StringType, can only use tftypes.String values",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ListTypeOf[StringValue]{}.ValueFromTerraform(context.Background(), testCase.input)
+
+			if err != nil {
+				if testCase.expectedErr == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if err.Error() != testCase.expectedErr {
+					t.Fatalf("expected error %q, got %q", testCase.expectedErr, err.Error())
+				}
+
+				return
+			}
+
+			if testCase.expectedErr != "" {
+				t.Fatalf("expected error %q, got none", testCase.expectedErr)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected result (+expected, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestListTypeOfEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		other    attr.Type
+		expected bool
+	}{
+		"same": {
+			other:    ListTypeOf[StringValue]{},
+			expected: true,
+		},
+		"different-element-type": {
+			other:    ListTypeOf[BoolValue]{},
+			expected: false,
+		},
+		"list-type": {
+			other:    ListType{ElemType: StringType{}},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := ListTypeOf[StringValue]{}.Equal(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestListTypeOfValueFromList(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         ListValue
+		expected      ListValuable
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			input:    NewListValueMust(StringType{}, []attr.Value{NewStringValue("hello")}),
+			expected: NewListValueOfMust(context.Background(), []StringValue{NewStringValue("hello")}),
+		},
+		"wrong-element-type": {
+			input:    NewListValueMust(BoolType{}, []attr.Value{NewBoolValue(true)}),
+			expected: NewListValueOfUnknown[StringValue](),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"List Conversion Error",
+					"An unexpected error was encountered trying to convert the list. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"list element 0 is basetypes.BoolValue, not basetypes.StringValue",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := ListTypeOf[StringValue]{}.ValueFromList(context.Background(), testCase.input)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+expected, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected result (+expected, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewListValueOf(t *testing.T) {
+	t.Parallel()
+
+	got, diags := NewListValueOf(context.Background(), []ListValueOf[StringValue]{
+		NewListValueOfMust(context.Background(), []StringValue{NewStringValue("hello")}),
+		NewListValueOfNull[StringValue](),
+	})
+
+	if len(diags) > 0 {
+		t.Fatalf("unexpected diagnostics: %s", diags)
+	}
+
+	expected := NewListValueMust(ListTypeOf[StringValue]{}, []attr.Value{
+		NewListValueOfMust(context.Background(), []StringValue{NewStringValue("hello")}),
+		NewListValueOfNull[StringValue](),
+	})
+
+	list, _ := got.ToListValue(context.Background())
+
+	if diff := cmp.Diff(list, expected); diff != "" {
+		t.Errorf("unexpected result (+expected, -got): %s", diff)
+	}
+}
+
+func TestListValueOfElements(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    ListValueOf[StringValue]
+		expected []StringValue
+	}{
+		"known": {
+			input:    NewListValueOfMust(context.Background(), []StringValue{NewStringValue("hello"), NewStringNull()}),
+			expected: []StringValue{NewStringValue("hello"), NewStringNull()},
+		},
+		"null": {
+			input:    NewListValueOfNull[StringValue](),
+			expected: []StringValue{},
+		},
+		"unknown": {
+			input:    NewListValueOfUnknown[StringValue](),
+			expected: []StringValue{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.Elements()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected result (+expected, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestListValueOfToTerraformValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    ListValueOf[StringValue]
+		expected tftypes.Value
+	}{
+		"known": {
+			input: NewListValueOfMust(context.Background(), []StringValue{NewStringValue("hello")}),
+			expected: tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "hello"),
+			}),
+		},
+		"zero": {
+			input:    ListValueOf[StringValue]{},
+			expected: tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+		},
+		"unknown": {
+			input:    NewListValueOfUnknown[StringValue](),
+			expected: tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tftypes.UnknownValue),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.input.ToTerraformValue(context.Background())
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected result (+expected, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestListValueOfEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    ListValueOf[StringValue]
+		other    attr.Value
+		expected bool
+	}{
+		"equal": {
+			input:    NewListValueOfMust(context.Background(), []StringValue{NewStringValue("hello")}),
+			other:    NewListValueOfMust(context.Background(), []StringValue{NewStringValue("hello")}),
+			expected: true,
+		},
+		"different-elements": {
+			input:    NewListValueOfMust(context.Background(), []StringValue{NewStringValue("hello")}),
+			other:    NewListValueOfMust(context.Background(), []StringValue{NewStringValue("world")}),
+			expected: false,
+		},
+		"zero-null": {
+			input:    ListValueOf[StringValue]{},
+			other:    NewListValueOfNull[StringValue](),
+			expected: true,
+		},
+		"list-value": {
+			input:    NewListValueOfMust(context.Background(), []StringValue{NewStringValue("hello")}),
+			other:    NewListValueMust(StringType{}, []attr.Value{NewStringValue("hello")}),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.Equal(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestListValueOfType(t *testing.T) {
+	t.Parallel()
+
+	got := ListValueOf[StringValue]{}.Type(context.Background())
+
+	if diff := cmp.Diff(got, ListTypeOf[StringValue]{}); diff != "" {
+		t.Errorf("unexpected result (+expected, -got): %s", diff)
+	}
+
+	if got.String() != "types.ListTypeOf[basetypes.StringType]" {
+		t.Errorf("unexpected string: %s", got.String())
+	}
+}
+
+func TestNewListValueOf_invalidElementType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		newValue func() diag.Diagnostics
+		expected diag.Diagnostics
+	}{
+		"interface": {
+			newValue: func() diag.Diagnostics {
+				_, diags := NewListValueOf[attr.Value](context.Background(), nil)
+				return diags
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid List Element Type",
+					"While creating a List value, the element type could not be derived from the element value type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"cannot derive element type from interface type attr.Value, use a concrete value type such as StringValue",
+				),
+			},
+		},
+		"object": {
+			newValue: func() diag.Diagnostics {
+				_, diags := NewListValueOf(context.Background(), []ObjectValue{})
+				return diags
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid List Element Type",
+					"While creating a List value, the element type could not be derived from the element value type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"cannot derive element type from basetypes.ObjectValue as the type of its zero value is missing element or attribute types, "+
+						"use a value type with a complete type such as ListValueOf[StringValue] or a custom value type",
+				),
+			},
+		},
+		"list": {
+			newValue: func() diag.Diagnostics {
+				_, diags := NewListValueOf(context.Background(), []ListValue{})
+				return diags
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid List Element Type",
+					"While creating a List value, the element type could not be derived from the element value type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"cannot derive element type from basetypes.ListValue as the type of its zero value is missing element or attribute types, "+
+						"use a value type with a complete type such as ListValueOf[StringValue] or a custom value type",
+				),
+			},
+		},
+		"nested-list-of-map": {
+			newValue: func() diag.Diagnostics {
+				_, diags := NewListValueOf(context.Background(), []ListValueOf[MapValue]{})
+				return diags
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid List Element Type",
+					"While creating a List value, the element type could not be derived from the element value type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"cannot derive element type from basetypes.ListValueOf[github.com/hashicorp/terraform-plugin-framework/types/basetypes.MapValue] as the type of its zero value is missing element or attribute types, "+
+						"use a value type with a complete type such as ListValueOf[StringValue] or a custom value type",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.newValue()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diagnostics (+expected, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestListTypeOf_invalidElementType(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	typ := ListValueOf[ObjectValue]{}.Type(ctx)
+
+	if got := typ.(ListTypeOf[ObjectValue]).ElementType(); got != nil {
+		t.Errorf("expected nil element type, got: %s", got)
+	}
+
+	if got := typ.String(); got != "types.ListTypeOf[basetypes.ObjectValue]" {
+		t.Errorf("unexpected string: %s", got)
+	}
+
+	expectedType := tftypes.List{ElementType: tftypes.DynamicPseudoType}
+
+	if got := typ.TerraformType(ctx); !got.Equal(expectedType) {
+		t.Errorf("expected %s, got: %s", expectedType, got)
+	}
+
+	diags := ListTypeOf[attr.Value]{}.Validate(ctx, tftypes.NewValue(expectedType, nil), path.Root("test"))
+
+	if !diags.HasError() {
+		t.Error("expected validation error")
+	}
+
+	if _, err := (ListValueOf[ObjectValue]{}).ToTerraformValue(ctx); err == nil {
+		t.Error("expected ToTerraformValue error")
+	}
+
+	if _, diags := (ListValueOf[attr.Value]{}).ToListValue(ctx); !diags.HasError() {
+		t.Error("expected ToListValue error")
+	}
+}
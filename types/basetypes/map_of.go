@@ -0,0 +1,333 @@
+package basetypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+var (
+	_ MapTypable                 = MapTypeOf[StringValue]{}
+	_ xattr.TypeWithValidate     = MapTypeOf[StringValue]{}
+	_ MapValuable                = MapValueOf[StringValue]{}
+	_ xattr.ValueWithDerivedType = MapValueOf[StringValue]{}
+)
+
+// MapTypeOf is a MapType whose element type is derived from the element
+// value type T, producing MapValueOf[T] values. T must be a concrete value
+// type whose zero value returns its complete type from its Type method, such
+// as StringValue or another generic collection value type. Other value types,
+// such as attr.Value or ObjectValue, cause errors when creating, converting,
+// or validating values.
+type MapTypeOf[T attr.Value] struct{}
+
+// mapType returns the equivalent MapType, or an error if the element type
+// cannot be derived from T.
+func (m MapTypeOf[T]) mapType(ctx context.Context) (MapType, error) {
+	elemType, err := elementTypeOf[T](ctx)
+
+	return MapType{ElemType: elemType}, err
+}
+
+// ElementType returns the attr.Type elements will be created from, which is
+// nil if the element type cannot be derived from T.
+func (m MapTypeOf[T]) ElementType() attr.Type {
+	elemType, _ := elementTypeOf[T](context.Background())
+
+	return elemType
+}
+
+// WithElementType returns a MapType with the element type set to `typ`.
+// This is `m` if `typ` is the derived element type.
+func (m MapTypeOf[T]) WithElementType(typ attr.Type) attr.TypeWithElementType {
+	if typ.Equal(m.ElementType()) {
+		return m
+	}
+
+	return MapType{ElemType: typ}
+}
+
+// TerraformType returns the tftypes.Type that should be used to represent
+// this type. If the element type cannot be derived from T, the element type
+// is tftypes.DynamicPseudoType and Validate returns the error.
+func (m MapTypeOf[T]) TerraformType(ctx context.Context) tftypes.Type {
+	mapType, err := m.mapType(ctx)
+	if err != nil {
+		return tftypes.Map{ElementType: tftypes.DynamicPseudoType}
+	}
+
+	return mapType.TerraformType(ctx)
+}
+
+// ValueFromTerraform returns a MapValueOf[T] given a tftypes.Value.
+func (m MapTypeOf[T]) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	mapType, err := m.mapType(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := mapType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	//nolint:forcetypeassert // MapType.ValueFromTerraform always returns MapValue
+	return mapValueOf[T](value.(MapValue))
+}
+
+// Equal returns true if `o` is also a MapTypeOf[T].
+func (m MapTypeOf[T]) Equal(o attr.Type) bool {
+	_, ok := o.(MapTypeOf[T])
+
+	return ok
+}
+
+// ApplyTerraform5AttributePathStep applies the given AttributePathStep to the
+// map.
+func (m MapTypeOf[T]) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
+	mapType, err := m.mapType(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	return mapType.ApplyTerraform5AttributePathStep(step)
+}
+
+// String returns a human-friendly description of the MapTypeOf.
+func (m MapTypeOf[T]) String() string {
+	elemType := m.ElementType()
+	if elemType == nil {
+		return "types.MapTypeOf[" + valueTypeName[T]() + "]"
+	}
+
+	return "types.MapTypeOf[" + elemType.String() + "]"
+}
+
+// Validate validates all elements of the map that are of type
+// xattr.TypeWithValidate.
+func (m MapTypeOf[T]) Validate(ctx context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	mapType, err := m.mapType(ctx)
+	if err != nil {
+		return diag.Diagnostics{
+			diag.NewAttributeErrorDiagnostic(
+				path,
+				"Map Type Validation Error",
+				"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			),
+		}
+	}
+
+	return mapType.Validate(ctx, in, path)
+}
+
+// ValueType returns the Value type.
+func (m MapTypeOf[T]) ValueType(_ context.Context) attr.Value {
+	return MapValueOf[T]{}
+}
+
+// ValueFromMap returns a MapValueOf[T] given a Map, which must have the
+// derived element type.
+func (m MapTypeOf[T]) ValueFromMap(ctx context.Context, ma MapValue) (MapValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if _, err := elementTypeOf[T](ctx); err != nil {
+		diags.AddError(
+			"Map Conversion Error",
+			"An unexpected error was encountered trying to convert the map. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return NewMapValueOfUnknown[T](), diags
+	}
+
+	value, err := mapValueOf[T](ma)
+	if err != nil {
+		diags.AddError(
+			"Map Conversion Error",
+			"An unexpected error was encountered trying to convert the map. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return NewMapValueOfUnknown[T](), diags
+	}
+
+	return value, diags
+}
+
+// NewMapValueOfNull creates a MapValueOf[T] with a null value. This is the
+// same as the zero value.
+func NewMapValueOfNull[T attr.Value]() MapValueOf[T] {
+	return MapValueOf[T]{}
+}
+
+// NewMapValueOfUnknown creates a MapValueOf[T] with an unknown value.
+func NewMapValueOfUnknown[T attr.Value]() MapValueOf[T] {
+	elemType, _ := elementTypeOf[T](context.Background())
+
+	return MapValueOf[T]{
+		values: NewMapUnknown(elemType),
+	}
+}
+
+// NewMapValueOf creates a MapValueOf[T] with a known value. Access the value
+// via the Elements method.
+func NewMapValueOf[T attr.Value](ctx context.Context, elements map[string]T) (MapValueOf[T], diag.Diagnostics) {
+	elemType, err := elementTypeOf[T](ctx)
+	if err != nil {
+		return NewMapValueOfUnknown[T](), diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"Invalid Map Element Type",
+				"While creating a Map value, the element type could not be derived from the element value type. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+err.Error(),
+			),
+		}
+	}
+
+	attrElements := make(map[string]attr.Value, len(elements))
+
+	for key, element := range elements {
+		attrElements[key] = element
+	}
+
+	values, diags := NewMapValue(elemType, attrElements)
+
+	if diags.HasError() {
+		return NewMapValueOfUnknown[T](), diags
+	}
+
+	return MapValueOf[T]{values: values}, diags
+}
+
+// NewMapValueOfMust creates a MapValueOf[T] with a known value, converting
+// any diagnostics into a panic at runtime.
+//
+// This creation function is only recommended to create values which will not
+// potentially affect practitioners, such as testing, or exhaustively tested
+// provider logic.
+func NewMapValueOfMust[T attr.Value](ctx context.Context, elements map[string]T) MapValueOf[T] {
+	values, diags := NewMapValueOf(ctx, elements)
+
+	if diags.HasError() {
+		panic("NewMapValueOfMust received error(s): " + diagsString(diags))
+	}
+
+	return values
+}
+
+// MapValueOf is a map value whose elements are all of the value type T.
+// Unlike MapValue, its element type is derived from T, so its zero value is
+// a usable null value and it can be used as a model struct field without
+// converting elements with ElementsAs. Its attr.Type is MapTypeOf[T], however
+// it can also be read from schema attributes of the equivalent MapType.
+type MapValueOf[T attr.Value] struct {
+	// values holds the elements. Its element type is nil in the zero value.
+	values MapValue
+}
+
+// mapValueOf returns `values` as a MapValueOf[T], returning an error if the
+// elements are not of type T.
+func mapValueOf[T attr.Value](values MapValue) (MapValueOf[T], error) {
+	for key, element := range values.elements {
+		if _, ok := element.(T); !ok {
+			var zero T
+			return MapValueOf[T]{}, fmt.Errorf("map element %q is %T, not %T", key, element, zero)
+		}
+	}
+
+	return MapValueOf[T]{values: values}, nil
+}
+
+// mapValue returns the underlying MapValue with its element type set.
+func (m MapValueOf[T]) mapValue(ctx context.Context) MapValue {
+	if m.values.elementType == nil {
+		m.values.elementType, _ = elementTypeOf[T](ctx)
+	}
+
+	return m.values
+}
+
+// Elements returns a copy of the elements of the map.
+func (m MapValueOf[T]) Elements() map[string]T {
+	result := make(map[string]T, len(m.values.elements))
+
+	for key, element := range m.values.elements {
+		//nolint:forcetypeassert // Element types are checked on creation
+		result[key] = element.(T)
+	}
+
+	return result
+}
+
+// ElementType returns the element type for the map, which is nil if the
+// element type cannot be derived from T.
+func (m MapValueOf[T]) ElementType(ctx context.Context) attr.Type {
+	elemType, _ := elementTypeOf[T](ctx)
+
+	return elemType
+}
+
+// Type returns a MapTypeOf[T].
+func (m MapValueOf[T]) Type(_ context.Context) attr.Type {
+	return MapTypeOf[T]{}
+}
+
+// DerivedType returns a MapTypeOf[T].
+func (m MapValueOf[T]) DerivedType(ctx context.Context) attr.Type {
+	return m.Type(ctx)
+}
+
+// ToTerraformValue returns the data contained in the map as a
+// tftypes.Value.
+func (m MapValueOf[T]) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	if _, err := elementTypeOf[T](ctx); err != nil {
+		return tftypes.NewValue(m.Type(ctx).TerraformType(ctx), tftypes.UnknownValue), err
+	}
+
+	return m.mapValue(ctx).ToTerraformValue(ctx)
+}
+
+// Equal returns true if `o` is a MapValueOf[T] with the same elements.
+func (m MapValueOf[T]) Equal(o attr.Value) bool {
+	other, ok := o.(MapValueOf[T])
+
+	if !ok {
+		return false
+	}
+
+	ctx := context.Background()
+
+	return m.mapValue(ctx).Equal(other.mapValue(ctx))
+}
+
+// IsNull returns true if the map represents a null value.
+func (m MapValueOf[T]) IsNull() bool {
+	return m.values.IsNull()
+}
+
+// IsUnknown returns true if the map represents a currently unknown value.
+func (m MapValueOf[T]) IsUnknown() bool {
+	return m.values.IsUnknown()
+}
+
+// String returns a human-readable representation of the map value.
+// The string returned here is not protected by any compatibility guarantees,
+// and is intended for logging and error reporting.
+func (m MapValueOf[T]) String() string {
+	return m.values.String()
+}
+
+// ToMapValue returns the equivalent MapValue.
+func (m MapValueOf[T]) ToMapValue(ctx context.Context) (MapValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if _, err := elementTypeOf[T](ctx); err != nil {
+		diags.AddError(
+			"Map Conversion Error",
+			"An unexpected error was encountered trying to convert the map. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+	}
+
+	return m.mapValue(ctx), diags
+}
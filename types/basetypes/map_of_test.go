@@ -0,0 +1,141 @@
+package basetypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestMapTypeOfValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    tftypes.Value
+		expected attr.Value
+	}{
+		"known": {
+			input: tftypes.NewValue(tftypes.Map{ElementType: tftypes.Bool}, map[string]tftypes.Value{
+				"a": tftypes.NewValue(tftypes.Bool, true),
+				"b": tftypes.NewValue(tftypes.Bool, nil),
+			}),
+			expected: NewMapValueOfMust(context.Background(), map[string]BoolValue{
+				"a": NewBoolValue(true),
+				"b": NewBoolNull(),
+			}),
+		},
+		"null": {
+			input:    tftypes.NewValue(tftypes.Map{ElementType: tftypes.Bool}, nil),
+			expected: NewMapValueOfNull[BoolValue](),
+		},
+		"unknown": {
+			input:    tftypes.NewValue(tftypes.Map{ElementType: tftypes.Bool}, tftypes.UnknownValue),
+			expected: NewMapValueOfUnknown[BoolValue](),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := MapTypeOf[BoolValue]{}.ValueFromTerraform(context.Background(), testCase.input)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected result (+expected, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestMapTypeOfValueFromMap(t *testing.T) {
+	t.Parallel()
+
+	_, diags := MapTypeOf[BoolValue]{}.ValueFromMap(context.Background(), NewMapValueMust(StringType{}, map[string]attr.Value{
+		"a": NewStringValue("true"),
+	}))
+
+	expected := diag.Diagnostics{
+		diag.NewErrorDiagnostic(
+			"Map Conversion Error",
+			"An unexpected error was encountered trying to convert the map. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"map element \"a\" is basetypes.StringValue, not basetypes.BoolValue",
+		),
+	}
+
+	if diff := cmp.Diff(diags, expected); diff != "" {
+		t.Errorf("unexpected diagnostics (+expected, -got): %s", diff)
+	}
+}
+
+func TestMapValueOfElements(t *testing.T) {
+	t.Parallel()
+
+	got := NewMapValueOfMust(context.Background(), map[string]BoolValue{"a": NewBoolValue(true)}).Elements()
+
+	if diff := cmp.Diff(got, map[string]BoolValue{"a": NewBoolValue(true)}); diff != "" {
+		t.Errorf("unexpected result (+expected, -got): %s", diff)
+	}
+}
+
+func TestMapValueOfEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    MapValueOf[BoolValue]
+		other    attr.Value
+		expected bool
+	}{
+		"equal": {
+			input:    NewMapValueOfMust(context.Background(), map[string]BoolValue{"a": NewBoolValue(true)}),
+			other:    NewMapValueOfMust(context.Background(), map[string]BoolValue{"a": NewBoolValue(true)}),
+			expected: true,
+		},
+		"different-keys": {
+			input:    NewMapValueOfMust(context.Background(), map[string]BoolValue{"a": NewBoolValue(true)}),
+			other:    NewMapValueOfMust(context.Background(), map[string]BoolValue{"b": NewBoolValue(true)}),
+			expected: false,
+		},
+		"zero-null": {
+			input:    MapValueOf[BoolValue]{},
+			other:    NewMapValueOfNull[BoolValue](),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.Equal(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestNewMapValueOf_invalidElementType(t *testing.T) {
+	t.Parallel()
+
+	_, diags := NewMapValueOf[attr.Value](context.Background(), nil)
+
+	if !diags.HasError() {
+		t.Fatal("expected error diagnostics")
+	}
+
+	if got := (MapTypeOf[attr.Value]{}).ElementType(); got != nil {
+		t.Errorf("expected nil element type, got: %s", got)
+	}
+}
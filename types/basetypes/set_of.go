@@ -0,0 +1,333 @@
+package basetypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+var (
+	_ SetTypable                 = SetTypeOf[StringValue]{}
+	_ xattr.TypeWithValidate     = SetTypeOf[StringValue]{}
+	_ SetValuable                = SetValueOf[StringValue]{}
+	_ xattr.ValueWithDerivedType = SetValueOf[StringValue]{}
+)
+
+// SetTypeOf is a SetType whose element type is derived from the element
+// value type T, producing SetValueOf[T] values. T must be a concrete value
+// type whose zero value returns its complete type from its Type method, such
+// as StringValue or another generic collection value type. Other value types,
+// such as attr.Value or ObjectValue, cause errors when creating, converting,
+// or validating values.
+type SetTypeOf[T attr.Value] struct{}
+
+// setType returns the equivalent SetType, or an error if the element type
+// cannot be derived from T.
+func (st SetTypeOf[T]) setType(ctx context.Context) (SetType, error) {
+	elemType, err := elementTypeOf[T](ctx)
+
+	return SetType{ElemType: elemType}, err
+}
+
+// ElementType returns the attr.Type elements will be created from, which is
+// nil if the element type cannot be derived from T.
+func (st SetTypeOf[T]) ElementType() attr.Type {
+	elemType, _ := elementTypeOf[T](context.Background())
+
+	return elemType
+}
+
+// WithElementType returns a SetType with the element type set to `typ`.
+// This is `st` if `typ` is the derived element type.
+func (st SetTypeOf[T]) WithElementType(typ attr.Type) attr.TypeWithElementType {
+	if typ.Equal(st.ElementType()) {
+		return st
+	}
+
+	return SetType{ElemType: typ}
+}
+
+// TerraformType returns the tftypes.Type that should be used to represent
+// this type. If the element type cannot be derived from T, the element type
+// is tftypes.DynamicPseudoType and Validate returns the error.
+func (st SetTypeOf[T]) TerraformType(ctx context.Context) tftypes.Type {
+	setType, err := st.setType(ctx)
+	if err != nil {
+		return tftypes.Set{ElementType: tftypes.DynamicPseudoType}
+	}
+
+	return setType.TerraformType(ctx)
+}
+
+// ValueFromTerraform returns a SetValueOf[T] given a tftypes.Value.
+func (st SetTypeOf[T]) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	setType, err := st.setType(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := setType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	//nolint:forcetypeassert // SetType.ValueFromTerraform always returns SetValue
+	return setValueOf[T](value.(SetValue))
+}
+
+// Equal returns true if `o` is also a SetTypeOf[T].
+func (st SetTypeOf[T]) Equal(o attr.Type) bool {
+	_, ok := o.(SetTypeOf[T])
+
+	return ok
+}
+
+// ApplyTerraform5AttributePathStep applies the given AttributePathStep to the
+// set.
+func (st SetTypeOf[T]) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
+	setType, err := st.setType(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	return setType.ApplyTerraform5AttributePathStep(step)
+}
+
+// String returns a human-friendly description of the SetTypeOf.
+func (st SetTypeOf[T]) String() string {
+	elemType := st.ElementType()
+	if elemType == nil {
+		return "types.SetTypeOf[" + valueTypeName[T]() + "]"
+	}
+
+	return "types.SetTypeOf[" + elemType.String() + "]"
+}
+
+// Validate validates all elements of the set that are of type
+// xattr.TypeWithValidate.
+func (st SetTypeOf[T]) Validate(ctx context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	setType, err := st.setType(ctx)
+	if err != nil {
+		return diag.Diagnostics{
+			diag.NewAttributeErrorDiagnostic(
+				path,
+				"Set Type Validation Error",
+				"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			),
+		}
+	}
+
+	return setType.Validate(ctx, in, path)
+}
+
+// ValueType returns the Value type.
+func (st SetTypeOf[T]) ValueType(_ context.Context) attr.Value {
+	return SetValueOf[T]{}
+}
+
+// ValueFromSet returns a SetValueOf[T] given a Set, which must have the
+// derived element type.
+func (st SetTypeOf[T]) ValueFromSet(ctx context.Context, set SetValue) (SetValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if _, err := elementTypeOf[T](ctx); err != nil {
+		diags.AddError(
+			"Set Conversion Error",
+			"An unexpected error was encountered trying to convert the set. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return NewSetValueOfUnknown[T](), diags
+	}
+
+	value, err := setValueOf[T](set)
+	if err != nil {
+		diags.AddError(
+			"Set Conversion Error",
+			"An unexpected error was encountered trying to convert the set. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return NewSetValueOfUnknown[T](), diags
+	}
+
+	return value, diags
+}
+
+// NewSetValueOfNull creates a SetValueOf[T] with a null value. This is the
+// same as the zero value.
+func NewSetValueOfNull[T attr.Value]() SetValueOf[T] {
+	return SetValueOf[T]{}
+}
+
+// NewSetValueOfUnknown creates a SetValueOf[T] with an unknown value.
+func NewSetValueOfUnknown[T attr.Value]() SetValueOf[T] {
+	elemType, _ := elementTypeOf[T](context.Background())
+
+	return SetValueOf[T]{
+		set: NewSetUnknown(elemType),
+	}
+}
+
+// NewSetValueOf creates a SetValueOf[T] with a known value. Access the value
+// via the Elements method.
+func NewSetValueOf[T attr.Value](ctx context.Context, elements []T) (SetValueOf[T], diag.Diagnostics) {
+	elemType, err := elementTypeOf[T](ctx)
+	if err != nil {
+		return NewSetValueOfUnknown[T](), diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"Invalid Set Element Type",
+				"While creating a Set value, the element type could not be derived from the element value type. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+err.Error(),
+			),
+		}
+	}
+
+	attrElements := make([]attr.Value, 0, len(elements))
+
+	for _, element := range elements {
+		attrElements = append(attrElements, element)
+	}
+
+	set, diags := NewSetValue(elemType, attrElements)
+
+	if diags.HasError() {
+		return NewSetValueOfUnknown[T](), diags
+	}
+
+	return SetValueOf[T]{set: set}, diags
+}
+
+// NewSetValueOfMust creates a SetValueOf[T] with a known value, converting
+// any diagnostics into a panic at runtime.
+//
+// This creation function is only recommended to create values which will not
+// potentially affect practitioners, such as testing, or exhaustively tested
+// provider logic.
+func NewSetValueOfMust[T attr.Value](ctx context.Context, elements []T) SetValueOf[T] {
+	set, diags := NewSetValueOf(ctx, elements)
+
+	if diags.HasError() {
+		panic("NewSetValueOfMust received error(s): " + diagsString(diags))
+	}
+
+	return set
+}
+
+// SetValueOf is a set value whose elements are all of the value type T.
+// Unlike SetValue, its element type is derived from T, so its zero value is
+// a usable null value and it can be used as a model struct field without
+// converting elements with ElementsAs. Its attr.Type is SetTypeOf[T], however
+// it can also be read from schema attributes of the equivalent SetType.
+type SetValueOf[T attr.Value] struct {
+	// set holds the elements. Its element type is nil in the zero value.
+	set SetValue
+}
+
+// setValueOf returns `set` as a SetValueOf[T], returning an error if the
+// elements are not of type T.
+func setValueOf[T attr.Value](set SetValue) (SetValueOf[T], error) {
+	for idx, element := range set.elements {
+		if _, ok := element.(T); !ok {
+			var zero T
+			return SetValueOf[T]{}, fmt.Errorf("set element %d is %T, not %T", idx, element, zero)
+		}
+	}
+
+	return SetValueOf[T]{set: set}, nil
+}
+
+// setValue returns the underlying SetValue with its element type set.
+func (s SetValueOf[T]) setValue(ctx context.Context) SetValue {
+	if s.set.elementType == nil {
+		s.set.elementType, _ = elementTypeOf[T](ctx)
+	}
+
+	return s.set
+}
+
+// Elements returns a copy of the elements of the set.
+func (s SetValueOf[T]) Elements() []T {
+	result := make([]T, 0, len(s.set.elements))
+
+	for _, element := range s.set.elements {
+		//nolint:forcetypeassert // Element types are checked on creation
+		result = append(result, element.(T))
+	}
+
+	return result
+}
+
+// ElementType returns the element type for the set, which is nil if the
+// element type cannot be derived from T.
+func (s SetValueOf[T]) ElementType(ctx context.Context) attr.Type {
+	elemType, _ := elementTypeOf[T](ctx)
+
+	return elemType
+}
+
+// Type returns a SetTypeOf[T].
+func (s SetValueOf[T]) Type(_ context.Context) attr.Type {
+	return SetTypeOf[T]{}
+}
+
+// DerivedType returns a SetTypeOf[T].
+func (s SetValueOf[T]) DerivedType(ctx context.Context) attr.Type {
+	return s.Type(ctx)
+}
+
+// ToTerraformValue returns the data contained in the set as a
+// tftypes.Value.
+func (s SetValueOf[T]) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	if _, err := elementTypeOf[T](ctx); err != nil {
+		return tftypes.NewValue(s.Type(ctx).TerraformType(ctx), tftypes.UnknownValue), err
+	}
+
+	return s.setValue(ctx).ToTerraformValue(ctx)
+}
+
+// Equal returns true if `o` is a SetValueOf[T] with the same elements.
+func (s SetValueOf[T]) Equal(o attr.Value) bool {
+	other, ok := o.(SetValueOf[T])
+
+	if !ok {
+		return false
+	}
+
+	ctx := context.Background()
+
+	return s.setValue(ctx).Equal(other.setValue(ctx))
+}
+
+// IsNull returns true if the set represents a null value.
+func (s SetValueOf[T]) IsNull() bool {
+	return s.set.IsNull()
+}
+
+// IsUnknown returns true if the set represents a currently unknown value.
+func (s SetValueOf[T]) IsUnknown() bool {
+	return s.set.IsUnknown()
+}
+
+// String returns a human-readable representation of the set value.
+// The string returned here is not protected by any compatibility guarantees,
+// and is intended for logging and error reporting.
+func (s SetValueOf[T]) String() string {
+	return s.set.String()
+}
+
+// ToSetValue returns the equivalent SetValue.
+func (s SetValueOf[T]) ToSetValue(ctx context.Context) (SetValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if _, err := elementTypeOf[T](ctx); err != nil {
+		diags.AddError(
+			"Set Conversion Error",
+			"An unexpected error was encountered trying to convert the set. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+	}
+
+	return s.setValue(ctx), diags
+}
@@ -0,0 +1,136 @@
+package basetypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSetTypeOfValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    tftypes.Value
+		expected attr.Value
+	}{
+		"known": {
+			input: tftypes.NewValue(tftypes.Set{ElementType: tftypes.Number}, []tftypes.Value{
+				tftypes.NewValue(tftypes.Number, 1),
+				tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+			}),
+			expected: NewSetValueOfMust(context.Background(), []Int64Value{
+				NewInt64Value(1),
+				NewInt64Unknown(),
+			}),
+		},
+		"null": {
+			input:    tftypes.NewValue(tftypes.Set{ElementType: tftypes.Number}, nil),
+			expected: NewSetValueOfNull[Int64Value](),
+		},
+		"unknown": {
+			input:    tftypes.NewValue(tftypes.Set{ElementType: tftypes.Number}, tftypes.UnknownValue),
+			expected: NewSetValueOfUnknown[Int64Value](),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := SetTypeOf[Int64Value]{}.ValueFromTerraform(context.Background(), testCase.input)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected result (+expected, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSetValueOfElements(t *testing.T) {
+	t.Parallel()
+
+	got := NewSetValueOfMust(context.Background(), []StringValue{NewStringValue("hello")}).Elements()
+
+	if diff := cmp.Diff(got, []StringValue{NewStringValue("hello")}); diff != "" {
+		t.Errorf("unexpected result (+expected, -got): %s", diff)
+	}
+}
+
+func TestSetValueOfEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    SetValueOf[StringValue]
+		other    attr.Value
+		expected bool
+	}{
+		"equal-unordered": {
+			input:    NewSetValueOfMust(context.Background(), []StringValue{NewStringValue("a"), NewStringValue("b")}),
+			other:    NewSetValueOfMust(context.Background(), []StringValue{NewStringValue("b"), NewStringValue("a")}),
+			expected: true,
+		},
+		"zero-null": {
+			input:    SetValueOf[StringValue]{},
+			other:    NewSetValueOfNull[StringValue](),
+			expected: true,
+		},
+		"set-value": {
+			input:    NewSetValueOfMust(context.Background(), []StringValue{NewStringValue("a")}),
+			other:    NewSetValueMust(StringType{}, []attr.Value{NewStringValue("a")}),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.Equal(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestSetValueOfToTerraformValue(t *testing.T) {
+	t.Parallel()
+
+	got, err := SetValueOf[StringValue]{}.ToTerraformValue(context.Background())
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil)
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected result (+expected, -got): %s", diff)
+	}
+}
+
+func TestNewSetValueOf_invalidElementType(t *testing.T) {
+	t.Parallel()
+
+	_, diags := NewSetValueOf(context.Background(), []ObjectValue{})
+
+	if !diags.HasError() {
+		t.Fatal("expected error diagnostics")
+	}
+
+	if got := (SetTypeOf[ObjectValue]{}).ElementType(); got != nil {
+		t.Errorf("expected nil element type, got: %s", got)
+	}
+}
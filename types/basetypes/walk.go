@@ -0,0 +1,412 @@
+package basetypes
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// WalkFunc is called by Walk for each value, with the path of the value
+// relative to the walked value. Returning false skips the elements or
+// attributes of the value. Returning error diagnostics stops the walk.
+type WalkFunc func(path.Path, attr.Value) (bool, diag.Diagnostics)
+
+// TransformFunc is called by Transform for each value, with the path of the
+// value relative to the transformed value, and returns the value to use in
+// its place. Returning error diagnostics stops the transform.
+type TransformFunc func(path.Path, attr.Value) (attr.Value, diag.Diagnostics)
+
+// Walk calls walkFn for the given value and then, depth-first, for each
+// element or attribute of known list, map, object, and set values, including
+// custom value types implementing ListValuable, MapValuable, ObjectValuable,
+// or SetValuable. The given value has an empty path. Map keys and object
+// attribute names are walked in sorted order.
+//
+// Walk is the attr.Value equivalent of tftypes.Walk.
+func Walk(ctx context.Context, value attr.Value, walkFn WalkFunc) diag.Diagnostics {
+	return walk(ctx, path.Empty(), value, walkFn)
+}
+
+// walk is the recursive implementation of Walk.
+func walk(ctx context.Context, valuePath path.Path, value attr.Value, walkFn WalkFunc) diag.Diagnostics {
+	walkChildren, diags := walkFn(valuePath, value)
+
+	if diags.HasError() || !walkChildren {
+		return diags
+	}
+
+	children, childrenDiags := valueChildren(ctx, valuePath, value)
+
+	diags.Append(childrenDiags...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	for _, child := range children {
+		diags.Append(walk(ctx, child.path, child.value, walkFn)...)
+
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// Transform returns a copy of the given value, where each value is replaced
+// by the result of calling transformFn. Values are transformed depth-first,
+// so transformFn is called with lists, maps, objects, and sets after their
+// elements or attributes have been transformed. Custom value types are
+// rebuilt with the ValueFromList, ValueFromMap, ValueFromObject, or
+// ValueFromSet method of their type, while values without any changed
+// elements or attributes are left as-is. The given value has an empty path and
+// is never modified.
+//
+// Element values must continue to match the element type of their collection,
+// and attribute values the attribute type of their object, otherwise an error
+// diagnostic is returned.
+//
+// Transform is the attr.Value equivalent of tftypes.Transform.
+func Transform(ctx context.Context, value attr.Value, transformFn TransformFunc) (attr.Value, diag.Diagnostics) {
+	return transform(ctx, path.Empty(), value, transformFn)
+}
+
+// transform is the recursive implementation of Transform.
+func transform(ctx context.Context, valuePath path.Path, value attr.Value, transformFn TransformFunc) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if value == nil || value.IsNull() || value.IsUnknown() {
+		return transformFn(valuePath, value)
+	}
+
+	switch v := value.(type) {
+	case ListValuable:
+		list, listDiags := v.ToListValue(ctx)
+
+		diags.Append(listDiags...)
+
+		if diags.HasError() {
+			return value, diags
+		}
+
+		elements, changed, elementsDiags := transformSlice(ctx, valuePath, list.Elements(), false, transformFn)
+
+		diags.Append(elementsDiags...)
+
+		if diags.HasError() || !changed {
+			break
+		}
+
+		newList, newListDiags := NewListValue(list.ElementType(ctx), elements)
+
+		diags.Append(newListDiags...)
+
+		if diags.HasError() {
+			return value, diags
+		}
+
+		value = newList
+
+		if typ, ok := v.Type(ctx).(ListTypable); ok {
+			var valueDiags diag.Diagnostics
+
+			value, valueDiags = typ.ValueFromList(ctx, newList)
+
+			diags.Append(valueDiags...)
+		}
+	case MapValuable:
+		m, mapDiags := v.ToMapValue(ctx)
+
+		diags.Append(mapDiags...)
+
+		if diags.HasError() {
+			return value, diags
+		}
+
+		elements, changed, elementsDiags := transformMap(ctx, valuePath, m.Elements(), false, transformFn)
+
+		diags.Append(elementsDiags...)
+
+		if diags.HasError() || !changed {
+			break
+		}
+
+		newMap, newMapDiags := NewMapValue(m.ElementType(ctx), elements)
+
+		diags.Append(newMapDiags...)
+
+		if diags.HasError() {
+			return value, diags
+		}
+
+		value = newMap
+
+		if typ, ok := v.Type(ctx).(MapTypable); ok {
+			var valueDiags diag.Diagnostics
+
+			value, valueDiags = typ.ValueFromMap(ctx, newMap)
+
+			diags.Append(valueDiags...)
+		}
+	case ObjectValuable:
+		object, objectDiags := v.ToObjectValue(ctx)
+
+		diags.Append(objectDiags...)
+
+		if diags.HasError() {
+			return value, diags
+		}
+
+		attributes, changed, attributesDiags := transformMap(ctx, valuePath, object.Attributes(), true, transformFn)
+
+		diags.Append(attributesDiags...)
+
+		if diags.HasError() || !changed {
+			break
+		}
+
+		newObject, newObjectDiags := NewObjectValue(object.AttributeTypes(ctx), attributes)
+
+		diags.Append(newObjectDiags...)
+
+		if diags.HasError() {
+			return value, diags
+		}
+
+		value = newObject
+
+		if typ, ok := v.Type(ctx).(ObjectTypable); ok {
+			var valueDiags diag.Diagnostics
+
+			value, valueDiags = typ.ValueFromObject(ctx, newObject)
+
+			diags.Append(valueDiags...)
+		}
+	case SetValuable:
+		set, setDiags := v.ToSetValue(ctx)
+
+		diags.Append(setDiags...)
+
+		if diags.HasError() {
+			return value, diags
+		}
+
+		elements, changed, elementsDiags := transformSlice(ctx, valuePath, set.Elements(), true, transformFn)
+
+		diags.Append(elementsDiags...)
+
+		if diags.HasError() || !changed {
+			break
+		}
+
+		newSet, newSetDiags := NewSetValue(set.ElementType(ctx), elements)
+
+		diags.Append(newSetDiags...)
+
+		if diags.HasError() {
+			return value, diags
+		}
+
+		value = newSet
+
+		if typ, ok := v.Type(ctx).(SetTypable); ok {
+			var valueDiags diag.Diagnostics
+
+			value, valueDiags = typ.ValueFromSet(ctx, newSet)
+
+			diags.Append(valueDiags...)
+		}
+	}
+
+	if diags.HasError() {
+		return value, diags
+	}
+
+	result, resultDiags := transformFn(valuePath, value)
+
+	diags.Append(resultDiags...)
+
+	return result, diags
+}
+
+// transformSlice transforms list or set elements, returning the transformed
+// elements and whether any element changed.
+func transformSlice(ctx context.Context, valuePath path.Path, elements []attr.Value, set bool, transformFn TransformFunc) ([]attr.Value, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var changed bool
+
+	result := make([]attr.Value, 0, len(elements))
+
+	for index, element := range elements {
+		elementPath := valuePath.AtListIndex(index)
+
+		if set {
+			elementPath = valuePath.AtSetValue(element)
+		}
+
+		newElement, elementDiags := transform(ctx, elementPath, element, transformFn)
+
+		diags.Append(elementDiags...)
+
+		if diags.HasError() {
+			return nil, false, diags
+		}
+
+		changed = changed || !valuesEqual(element, newElement)
+
+		result = append(result, newElement)
+	}
+
+	return result, changed, diags
+}
+
+// transformMap transforms map elements or object attributes, returning the
+// transformed values and whether any value changed.
+func transformMap(ctx context.Context, valuePath path.Path, values map[string]attr.Value, object bool, transformFn TransformFunc) (map[string]attr.Value, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var changed bool
+
+	result := make(map[string]attr.Value, len(values))
+
+	for _, key := range sortedKeys(values) {
+		elementPath := valuePath.AtMapKey(key)
+
+		if object {
+			elementPath = valuePath.AtName(key)
+		}
+
+		newValue, valueDiags := transform(ctx, elementPath, values[key], transformFn)
+
+		diags.Append(valueDiags...)
+
+		if diags.HasError() {
+			return nil, false, diags
+		}
+
+		changed = changed || !valuesEqual(values[key], newValue)
+
+		result[key] = newValue
+	}
+
+	return result, changed, diags
+}
+
+// IsFullyKnown returns true if the given value is known and, for list, map,
+// object, and set values, all elements or attributes are fully known. Values
+// which cannot be converted while walking are not considered fully known.
+func IsFullyKnown(ctx context.Context, value attr.Value) bool {
+	fullyKnown := true
+
+	diags := Walk(ctx, value, func(_ path.Path, v attr.Value) (bool, diag.Diagnostics) {
+		if v != nil && v.IsUnknown() {
+			fullyKnown = false
+		}
+
+		return fullyKnown, nil
+	})
+
+	return fullyKnown && !diags.HasError()
+}
+
+// UnknownPaths returns the paths, relative to the given value, of all unknown
+// values within the given value. An unknown value returns an empty path.
+func UnknownPaths(ctx context.Context, value attr.Value) (path.Paths, diag.Diagnostics) {
+	var paths path.Paths
+
+	diags := Walk(ctx, value, func(p path.Path, v attr.Value) (bool, diag.Diagnostics) {
+		if v != nil && v.IsUnknown() {
+			paths = append(paths, p)
+
+			return false, nil
+		}
+
+		return true, nil
+	})
+
+	return paths, diags
+}
+
+// walkChild is an element or attribute of a value with its path.
+type walkChild struct {
+	path  path.Path
+	value attr.Value
+}
+
+// valueChildren returns the elements or attributes of known list, map,
+// object, and set values, in walk order.
+func valueChildren(ctx context.Context, valuePath path.Path, value attr.Value) ([]walkChild, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if value == nil || value.IsNull() || value.IsUnknown() {
+		return nil, diags
+	}
+
+	var children []walkChild
+
+	switch v := value.(type) {
+	case ListValuable:
+		list, listDiags := v.ToListValue(ctx)
+
+		diags.Append(listDiags...)
+
+		for index, element := range list.Elements() {
+			children = append(children, walkChild{path: valuePath.AtListIndex(index), value: element})
+		}
+	case MapValuable:
+		m, mapDiags := v.ToMapValue(ctx)
+
+		diags.Append(mapDiags...)
+
+		elements := m.Elements()
+
+		for _, key := range sortedKeys(elements) {
+			children = append(children, walkChild{path: valuePath.AtMapKey(key), value: elements[key]})
+		}
+	case ObjectValuable:
+		object, objectDiags := v.ToObjectValue(ctx)
+
+		diags.Append(objectDiags...)
+
+		attributes := object.Attributes()
+
+		for _, name := range sortedKeys(attributes) {
+			children = append(children, walkChild{path: valuePath.AtName(name), value: attributes[name]})
+		}
+	case SetValuable:
+		set, setDiags := v.ToSetValue(ctx)
+
+		diags.Append(setDiags...)
+
+		for _, element := range set.Elements() {
+			children = append(children, walkChild{path: valuePath.AtSetValue(element), value: element})
+		}
+	}
+
+	return children, diags
+}
+
+// sortedKeys returns the keys of the map in sorted order.
+func sortedKeys(values map[string]attr.Value) []string {
+	keys := make([]string, 0, len(values))
+
+	for key := range values {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// valuesEqual returns true if both values are nil or equal.
+func valuesEqual(a, b attr.Value) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	return a.Equal(b)
+}
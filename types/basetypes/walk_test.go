@@ -0,0 +1,365 @@
+package basetypes
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// walkTestValue returns an object with nested list, map, object, and set
+// values.
+func walkTestValue() ObjectValue {
+	return NewObjectValueMust(
+		map[string]attr.Type{
+			"list": ListType{ElemType: StringType{}},
+			"map":  MapType{ElemType: StringType{}},
+			"object": ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"string": StringType{},
+				},
+			},
+			"set": SetType{ElemType: StringType{}},
+		},
+		map[string]attr.Value{
+			"list": NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("a"),
+				NewStringUnknown(),
+			}),
+			"map": NewMapValueMust(StringType{}, map[string]attr.Value{
+				"b": NewStringValue("b"),
+				"a": NewStringValue("a"),
+			}),
+			"object": NewObjectValueMust(
+				map[string]attr.Type{
+					"string": StringType{},
+				},
+				map[string]attr.Value{
+					"string": NewStringValue("c"),
+				},
+			),
+			"set": NewSetValueMust(StringType{}, []attr.Value{
+				NewStringValue("d"),
+			}),
+		},
+	)
+}
+
+func TestWalk(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         attr.Value
+		skip          path.Expression
+		stop          path.Expression
+		expected      path.Paths
+		expectedDiags diag.Diagnostics
+	}{
+		"nested": {
+			value: walkTestValue(),
+			expected: path.Paths{
+				path.Empty(),
+				path.Root("list"),
+				path.Root("list").AtListIndex(0),
+				path.Root("list").AtListIndex(1),
+				path.Root("map"),
+				path.Root("map").AtMapKey("a"),
+				path.Root("map").AtMapKey("b"),
+				path.Root("object"),
+				path.Root("object").AtName("string"),
+				path.Root("set"),
+				path.Root("set").AtSetValue(NewStringValue("d")),
+			},
+		},
+		"skip": {
+			value: walkTestValue(),
+			skip:  path.MatchRoot("map"),
+			expected: path.Paths{
+				path.Empty(),
+				path.Root("list"),
+				path.Root("list").AtListIndex(0),
+				path.Root("list").AtListIndex(1),
+				path.Root("map"),
+				path.Root("object"),
+				path.Root("object").AtName("string"),
+				path.Root("set"),
+				path.Root("set").AtSetValue(NewStringValue("d")),
+			},
+		},
+		"stop": {
+			value: walkTestValue(),
+			stop:  path.MatchRoot("list").AtListIndex(0),
+			expected: path.Paths{
+				path.Empty(),
+				path.Root("list"),
+				path.Root("list").AtListIndex(0),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Test Error", "test"),
+			},
+		},
+		"null": {
+			value: NewListNull(StringType{}),
+			expected: path.Paths{
+				path.Empty(),
+			},
+		},
+		"unknown": {
+			value: NewObjectUnknown(map[string]attr.Type{"string": StringType{}}),
+			expected: path.Paths{
+				path.Empty(),
+			},
+		},
+		"ListValueOf": {
+			value: NewListValueOfMust(context.Background(), []StringValue{NewStringValue("a")}),
+			expected: path.Paths{
+				path.Empty(),
+				path.Empty().AtListIndex(0),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got path.Paths
+
+			diags := Walk(context.Background(), testCase.value, func(p path.Path, _ attr.Value) (bool, diag.Diagnostics) {
+				got = append(got, p)
+
+				if testCase.stop.Matches(p) {
+					return false, diag.Diagnostics{diag.NewErrorDiagnostic("Test Error", "test")}
+				}
+
+				return !testCase.skip.Matches(p), nil
+			})
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+expected, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected paths (+expected, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestTransform(t *testing.T) {
+	t.Parallel()
+
+	upper := func(_ path.Path, value attr.Value) (attr.Value, diag.Diagnostics) {
+		if s, ok := value.(StringValue); ok && !s.IsNull() && !s.IsUnknown() {
+			return NewStringValue(strings.ToUpper(s.ValueString())), nil
+		}
+
+		return value, nil
+	}
+
+	testCases := map[string]struct {
+		value         attr.Value
+		transformFn   TransformFunc
+		expected      attr.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"nested": {
+			value:       walkTestValue(),
+			transformFn: upper,
+			expected: NewObjectValueMust(
+				walkTestValue().AttributeTypes(context.Background()),
+				map[string]attr.Value{
+					"list": NewListValueMust(StringType{}, []attr.Value{
+						NewStringValue("A"),
+						NewStringUnknown(),
+					}),
+					"map": NewMapValueMust(StringType{}, map[string]attr.Value{
+						"b": NewStringValue("B"),
+						"a": NewStringValue("A"),
+					}),
+					"object": NewObjectValueMust(
+						map[string]attr.Type{
+							"string": StringType{},
+						},
+						map[string]attr.Value{
+							"string": NewStringValue("C"),
+						},
+					),
+					"set": NewSetValueMust(StringType{}, []attr.Value{
+						NewStringValue("D"),
+					}),
+				},
+			),
+		},
+		"ListValueOf": {
+			value:       NewListValueOfMust(context.Background(), []StringValue{NewStringValue("a")}),
+			transformFn: upper,
+			expected:    NewListValueOfMust(context.Background(), []StringValue{NewStringValue("A")}),
+		},
+		"unknown-to-null": {
+			value: walkTestValue(),
+			transformFn: func(_ path.Path, value attr.Value) (attr.Value, diag.Diagnostics) {
+				if value.IsUnknown() {
+					return NewStringNull(), nil
+				}
+
+				return value, nil
+			},
+			expected: NewObjectValueMust(
+				walkTestValue().AttributeTypes(context.Background()),
+				map[string]attr.Value{
+					"list": NewListValueMust(StringType{}, []attr.Value{
+						NewStringValue("a"),
+						NewStringNull(),
+					}),
+					"map":    walkTestValue().Attributes()["map"],
+					"object": walkTestValue().Attributes()["object"],
+					"set":    walkTestValue().Attributes()["set"],
+				},
+			),
+		},
+		"parent-after-children": {
+			value: NewListValueMust(StringType{}, []attr.Value{NewStringValue("a")}),
+			transformFn: func(p path.Path, value attr.Value) (attr.Value, diag.Diagnostics) {
+				list, ok := value.(ListValue)
+
+				if ok {
+					// The list elements were already transformed.
+					return NewListValueMust(StringType{}, append(list.Elements(), NewStringValue("c"))), nil
+				}
+
+				return NewStringValue("b"), nil
+			},
+			expected: NewListValueMust(StringType{}, []attr.Value{NewStringValue("b"), NewStringValue("c")}),
+		},
+		"element-type-mismatch": {
+			value: NewListValueMust(StringType{}, []attr.Value{NewStringValue("a")}),
+			transformFn: func(p path.Path, value attr.Value) (attr.Value, diag.Diagnostics) {
+				if p.Equal(path.Empty()) {
+					return value, nil
+				}
+
+				return NewBoolValue(true), nil
+			},
+			expected: NewListValueMust(StringType{}, []attr.Value{NewStringValue("a")}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid List Element Type",
+					"While creating a List value, an invalid element was detected. "+
+						"A List must use the single, given element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"List Element Type: basetypes.StringType\n"+
+						"List Index (0) Element Type: basetypes.BoolType",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := Transform(context.Background(), testCase.value, testCase.transformFn)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+expected, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected result (+expected, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIsFullyKnown(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    attr.Value
+		expected bool
+	}{
+		"known": {
+			value:    NewStringValue("test"),
+			expected: true,
+		},
+		"null": {
+			value:    NewStringNull(),
+			expected: true,
+		},
+		"unknown": {
+			value:    NewStringUnknown(),
+			expected: false,
+		},
+		"nested-unknown": {
+			value:    walkTestValue(),
+			expected: false,
+		},
+		"nested-known": {
+			value:    walkTestValue().Attributes()["map"],
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := IsFullyKnown(context.Background(), testCase.value)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestUnknownPaths(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    attr.Value
+		expected path.Paths
+	}{
+		"known": {
+			value:    NewStringValue("test"),
+			expected: nil,
+		},
+		"unknown": {
+			value:    NewListUnknown(StringType{}),
+			expected: path.Paths{path.Empty()},
+		},
+		"nested": {
+			value:    walkTestValue(),
+			expected: path.Paths{path.Root("list").AtListIndex(1)},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := UnknownPaths(context.Background(), testCase.value)
+
+			if len(diags) > 0 {
+				t.Fatalf("unexpected diagnostics: %s", diags)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected result (+expected, -got): %s", diff)
+			}
+		})
+	}
+}